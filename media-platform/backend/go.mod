@@ -20,7 +20,7 @@ require (
 
 require (
 	github.com/golang-jwt/jwt/v4 v4.5.2
-	golang.org/x/net v0.40.0 // indirect
+	golang.org/x/net v0.40.0
 	golang.org/x/sys v0.33.0 // indirect
 	golang.org/x/text v0.25.0 // indirect
 )
@@ -0,0 +1,222 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"media-platform/internal/adapter/presenter"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/usecase/dto"
+	"media-platform/internal/usecase/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AnnotationController はコンテンツ本文の注釈に関するHTTPハンドラを提供します
+type AnnotationController struct {
+	annotationService   *service.AnnotationService
+	annotationPresenter *presenter.AnnotationPresenter
+}
+
+// NewAnnotationController は新しいAnnotationControllerのインスタンスを生成します
+func NewAnnotationController(
+	annotationService *service.AnnotationService,
+	annotationPresenter *presenter.AnnotationPresenter,
+) *AnnotationController {
+	return &AnnotationController{
+		annotationService:   annotationService,
+		annotationPresenter: annotationPresenter,
+	}
+}
+
+// GetAnnotations はコンテンツの注釈一覧を取得するハンドラです
+// GET /api/contents/:contentId/annotations?include_orphaned=true
+func (ctrl *AnnotationController) GetAnnotations(c echo.Context) error {
+	contentID, err := strconv.ParseInt(c.Param("contentId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツIDです",
+		})
+	}
+
+	includeOrphaned := c.QueryParam("include_orphaned") == "true"
+
+	listDTO, err := ctrl.annotationService.GetAnnotations(c.Request().Context(), contentID, includeOrphaned)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.annotationPresenter.ToHTTPAnnotationListResponse(listDTO),
+	})
+}
+
+// CreateAnnotation はコンテンツの段落に注釈を追加するハンドラです
+// POST /api/contents/:contentId/annotations
+func (ctrl *AnnotationController) CreateAnnotation(c echo.Context) error {
+	contentID, err := strconv.ParseInt(c.Param("contentId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツIDです",
+		})
+	}
+
+	userID, userRole, err := ctrl.getUserInfoFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	var req dto.CreateAnnotationRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	annotationDTO, err := ctrl.annotationService.CreateAnnotation(c.Request().Context(), contentID, userID, userRole, &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"annotation": ctrl.annotationPresenter.ToHTTPAnnotationResponse(annotationDTO),
+		},
+	})
+}
+
+// UpdateAnnotation は注釈の本文を更新するハンドラです
+// PUT /api/annotations/:id
+func (ctrl *AnnotationController) UpdateAnnotation(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効な注釈IDです",
+		})
+	}
+
+	userID, userRole, err := ctrl.getUserInfoFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	var req dto.UpdateAnnotationRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	annotationDTO, err := ctrl.annotationService.UpdateAnnotation(c.Request().Context(), id, userID, userRole, &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"annotation": ctrl.annotationPresenter.ToHTTPAnnotationResponse(annotationDTO),
+		},
+	})
+}
+
+// DeleteAnnotation は注釈を削除するハンドラです
+// DELETE /api/annotations/:id
+func (ctrl *AnnotationController) DeleteAnnotation(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効な注釈IDです",
+		})
+	}
+
+	userID, userRole, err := ctrl.getUserInfoFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if err := ctrl.annotationService.DeleteAnnotation(c.Request().Context(), id, userID, userRole); err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ========== ヘルパーメソッド ==========
+
+// getUserInfoFromContext はコンテキストからユーザーIDとロールを取得します
+func (ctrl *AnnotationController) getUserInfoFromContext(c echo.Context) (int64, string, error) {
+	var userID int64
+	switch v := c.Get("user_id").(type) {
+	case float64:
+		userID = int64(v)
+	case int64:
+		userID = v
+	case int:
+		userID = int64(v)
+	case nil:
+		return 0, "", domainErrors.NewValidationError("認証が必要です")
+	default:
+		return 0, "", domainErrors.NewValidationError("ユーザー情報の取得に失敗しました")
+	}
+
+	role, ok := c.Get("role").(string)
+	if !ok {
+		role = "user" // デフォルト値
+	}
+
+	return userID, role, nil
+}
+
+// handleError はエラーを適切なHTTPステータスコードでレスポンスします
+func (ctrl *AnnotationController) handleError(c echo.Context, err error) error {
+	if domainErrors.IsValidationError(err) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsNotFoundError(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsConflictError(err) {
+		return c.JSON(http.StatusConflict, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsPermissionError(err) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+		"status": "error",
+		"error":  "内部サーバーエラーが発生しました",
+	})
+}
@@ -41,8 +41,14 @@ func (ctrl *CommentController) GetComment(c echo.Context) error {
 		})
 	}
 
+	// 未ログインの場合はユーザーID 0 として扱う（承認待ちのコメントの表示判定に使用）
+	userID, userRole, err := ctrl.extractCurrentUserInfo(c)
+	if err != nil {
+		userID, userRole = 0, ""
+	}
+
 	// UseCaseからコメントを取得
-	commentDTO, err := ctrl.commentService.GetCommentByID(c.Request().Context(), id)
+	commentDTO, err := ctrl.commentService.GetCommentByID(c.Request().Context(), id, userID, userRole)
 	if err != nil {
 		return ctrl.handleError(c, err)
 	}
@@ -59,7 +65,7 @@ func (ctrl *CommentController) GetComment(c echo.Context) error {
 }
 
 // GetCommentsByContent はコンテンツに関連するコメント一覧を取得します
-// GET /api/contents/:contentId/comments
+// GET /api/contents/:contentId/comments?sort=newest|oldest|top|controversial
 func (ctrl *CommentController) GetCommentsByContent(c echo.Context) error {
 	contentID, err := ctrl.extractIDFromPath(c, "contentId")
 	if err != nil {
@@ -71,8 +77,15 @@ func (ctrl *CommentController) GetCommentsByContent(c echo.Context) error {
 
 	limit, offset := ctrl.extractPaginationParams(c)
 
+	// 未ログインの場合はユーザーID 0 として扱う（承認待ちのコメントの表示判定に使用）
+	userID, userRole, err := ctrl.extractCurrentUserInfo(c)
+	if err != nil {
+		userID, userRole = 0, ""
+	}
+
 	// UseCaseからコメント一覧を取得
-	commentListDTO, err := ctrl.commentService.GetCommentsByContent(c.Request().Context(), contentID, limit, offset)
+	sort := c.QueryParam("sort")
+	commentListDTO, err := ctrl.commentService.GetCommentsByContent(c.Request().Context(), contentID, userID, userRole, sort, limit, offset)
 	if err != nil {
 		return ctrl.handleError(c, err)
 	}
@@ -95,8 +108,69 @@ func (ctrl *CommentController) GetCommentsByContent(c echo.Context) error {
 	})
 }
 
+// GetCommentTree はコンテンツのコメントをツリー構造で取得します
+// GET /api/contents/:contentId/comments/tree?depth=3&limit=10&replies_limit=5&cursor=...
+// 打ち切られた枝の続きは parent_id と replies_cursor を指定して取得します
+func (ctrl *CommentController) GetCommentTree(c echo.Context) error {
+	contentID, err := ctrl.extractIDFromPath(c, "contentId")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツIDです",
+		})
+	}
+
+	req := &dto.CommentTreeRequest{
+		Cursor: c.QueryParam("cursor"),
+		Depth:  -1, // 未指定の場合はデフォルトの階層数
+	}
+
+	if parentIDStr := c.QueryParam("parent_id"); parentIDStr != "" {
+		parentID, err := strconv.ParseInt(parentIDStr, 10, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"status": "error",
+				"error":  "無効な親コメントIDです",
+			})
+		}
+		req.ParentID = &parentID
+	}
+	if depthStr := c.QueryParam("depth"); depthStr != "" {
+		if d, err := strconv.Atoi(depthStr); err == nil && d >= 0 {
+			req.Depth = d
+		}
+	}
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
+			req.Limit = l
+		}
+	}
+	if repliesLimitStr := c.QueryParam("replies_limit"); repliesLimitStr != "" {
+		if l, err := strconv.Atoi(repliesLimitStr); err == nil && l > 0 {
+			req.RepliesLimit = l
+		}
+	}
+
+	// 未ログインの場合はユーザーID 0 として扱う（承認待ちのコメントの表示判定に使用）
+	userID, userRole, err := ctrl.extractCurrentUserInfo(c)
+	if err != nil {
+		userID, userRole = 0, ""
+	}
+
+	// UseCaseからコメントツリーを取得
+	treeDTO, err := ctrl.commentService.GetCommentTree(c.Request().Context(), contentID, userID, userRole, req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.commentPresenter.ToHTTPCommentTreeResponse(treeDTO),
+	})
+}
+
 // GetReplies はコメントに対する返信を取得します
-// GET /api/comments/parent/:parentId/replies
+// GET /api/comments/parent/:parentId/replies?sort=oldest|newest|top|controversial
 func (ctrl *CommentController) GetReplies(c echo.Context) error {
 	parentID, err := ctrl.extractIDFromPath(c, "parentId")
 	if err != nil {
@@ -108,8 +182,15 @@ func (ctrl *CommentController) GetReplies(c echo.Context) error {
 
 	limit, offset := ctrl.extractPaginationParams(c)
 
+	// 未ログインの場合はユーザーID 0 として扱う（承認待ちのコメントの表示判定に使用）
+	userID, userRole, err := ctrl.extractCurrentUserInfo(c)
+	if err != nil {
+		userID, userRole = 0, ""
+	}
+
 	// UseCaseから返信を取得
-	replyDTOs, err := ctrl.commentService.GetReplies(c.Request().Context(), parentID, limit, offset)
+	sort := c.QueryParam("sort")
+	replyDTOs, err := ctrl.commentService.GetReplies(c.Request().Context(), parentID, userID, userRole, sort, limit, offset)
 	if err != nil {
 		return ctrl.handleError(c, err)
 	}
@@ -130,11 +211,208 @@ func (ctrl *CommentController) GetReplies(c echo.Context) error {
 	})
 }
 
+// PinComment はコメントをコンテンツの先頭にピン留めします（コンテンツの投稿者のみ）
+// PUT /api/contents/:contentId/comments/pin
+func (ctrl *CommentController) PinComment(c echo.Context) error {
+	contentID, err := ctrl.extractIDFromPath(c, "contentId")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツIDです",
+		})
+	}
+
+	userID, userRole, err := ctrl.extractCurrentUserInfo(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  "認証が必要です",
+		})
+	}
+
+	var req dto.PinCommentRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+	if err := req.Validate(); err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	commentDTO, err := ctrl.commentService.PinComment(c.Request().Context(), contentID, req.CommentID, userID, userRole)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"comment": ctrl.commentPresenter.ToHTTPCommentResponse(commentDTO),
+			"message": "コメントをピン留めしました",
+		},
+	})
+}
+
+// UnpinComment はコンテンツのピン留めを解除します（コンテンツの投稿者のみ）
+// DELETE /api/contents/:contentId/comments/pin
+func (ctrl *CommentController) UnpinComment(c echo.Context) error {
+	contentID, err := ctrl.extractIDFromPath(c, "contentId")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツIDです",
+		})
+	}
+
+	userID, userRole, err := ctrl.extractCurrentUserInfo(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  "認証が必要です",
+		})
+	}
+
+	if err := ctrl.commentService.UnpinComment(c.Request().Context(), contentID, userID, userRole); err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetPendingComments はコンテンツの承認待ちのコメントを取得します（コンテンツの投稿者のみ）
+// GET /api/contents/:contentId/comments/pending
+func (ctrl *CommentController) GetPendingComments(c echo.Context) error {
+	contentID, err := ctrl.extractIDFromPath(c, "contentId")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツIDです",
+		})
+	}
+
+	userID, userRole, err := ctrl.extractCurrentUserInfo(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  "認証が必要です",
+		})
+	}
+
+	limit, offset := ctrl.extractPaginationParams(c)
+
+	commentListDTO, err := ctrl.commentService.GetPendingComments(c.Request().Context(), contentID, userID, userRole, limit, offset)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"comments": ctrl.commentPresenter.ToHTTPCommentResponseList(commentListDTO.Comments),
+			"pagination": map[string]interface{}{
+				"total":    commentListDTO.TotalCount,
+				"limit":    limit,
+				"offset":   offset,
+				"has_more": commentListDTO.HasMore,
+			},
+			"content_id": contentID,
+		},
+	})
+}
+
+// ApproveComment は承認待ちのコメントを承認します（コンテンツの投稿者のみ）
+// POST /api/comments/:id/approve
+func (ctrl *CommentController) ApproveComment(c echo.Context) error {
+	id, err := ctrl.extractIDFromPath(c, "id")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコメントIDです",
+		})
+	}
+
+	userID, userRole, err := ctrl.extractCurrentUserInfo(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  "認証が必要です",
+		})
+	}
+
+	commentDTO, err := ctrl.commentService.ApproveComment(c.Request().Context(), id, userID, userRole)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"comment": ctrl.commentPresenter.ToHTTPCommentResponse(commentDTO),
+		},
+	})
+}
+
+// RejectComment は承認待ちのコメントを却下して削除します（コンテンツの投稿者のみ）
+// POST /api/comments/:id/reject
+func (ctrl *CommentController) RejectComment(c echo.Context) error {
+	id, err := ctrl.extractIDFromPath(c, "id")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコメントIDです",
+		})
+	}
+
+	userID, userRole, err := ctrl.extractCurrentUserInfo(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  "認証が必要です",
+		})
+	}
+
+	if err := ctrl.commentService.RejectComment(c.Request().Context(), id, userID, userRole); err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetCommentRevisions はコメントの編集履歴を取得します
+// GET /api/comments/:id/revisions （投稿者と管理者、または履歴が公開設定の場合は誰でも）
+func (ctrl *CommentController) GetCommentRevisions(c echo.Context) error {
+	id, err := ctrl.extractIDFromPath(c, "id")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコメントIDです",
+		})
+	}
+
+	// 未ログインの場合はユーザーID 0 として扱う
+	userID, userRole, err := ctrl.extractCurrentUserInfo(c)
+	if err != nil {
+		userID, userRole = 0, ""
+	}
+
+	revisionsDTO, err := ctrl.commentService.GetCommentRevisions(c.Request().Context(), id, userID, userRole)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.commentPresenter.ToHTTPCommentRevisionListResponse(revisionsDTO),
+	})
+}
+
 // CreateComment は新しいコメントを作成します
 // POST /api/comments
 func (ctrl *CommentController) CreateComment(c echo.Context) error {
 	// 認証情報の取得
-	userID, err := ctrl.extractCurrentUserID(c)
+	userID, userRole, err := ctrl.extractCurrentUserInfo(c)
 	if err != nil {
 		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
 			"status": "error",
@@ -161,7 +439,7 @@ func (ctrl *CommentController) CreateComment(c echo.Context) error {
 	}
 
 	// UseCaseでコメントを作成
-	commentDTO, err := ctrl.commentService.CreateComment(c.Request().Context(), userID, req)
+	commentDTO, err := ctrl.commentService.CreateComment(c.Request().Context(), userID, userRole, req)
 	if err != nil {
 		return ctrl.handleError(c, err)
 	}
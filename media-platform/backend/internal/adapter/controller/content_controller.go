@@ -5,6 +5,7 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"media-platform/internal/adapter/presenter"
 	domainErrors "media-platform/internal/domain/errors"
@@ -594,6 +595,20 @@ func (ctrl *ContentController) DeleteContent(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+// ReconcileCounters はいいね数・コメント数を再集計するハンドラです（管理者用）
+// POST /api/admin/contents/reconcile-counters
+func (ctrl *ContentController) ReconcileCounters(c echo.Context) error {
+	result, err := ctrl.contentService.ReconcileCounters(c.Request().Context())
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   result,
+	})
+}
+
 // ========== ヘルパーメソッド ==========
 
 // parseContentQuery はリクエストからContentQueryを作成します
@@ -650,6 +665,21 @@ func (ctrl *ContentController) parseContentQuery(c echo.Context) *dto.ContentQue
 		query.SortOrder = &sortOrder
 	}
 
+	if contentType := c.QueryParam("type"); contentType != "" {
+		query.Type = &contentType
+	}
+
+	// 種類別の構造化項目による絞り込み（例: ?type=ゲーム&meta.platform=Switch）
+	for key, values := range c.QueryParams() {
+		if !strings.HasPrefix(key, "meta.") || len(values) == 0 {
+			continue
+		}
+		if query.Metadata == nil {
+			query.Metadata = make(map[string]string)
+		}
+		query.Metadata[strings.TrimPrefix(key, "meta.")] = values[0]
+	}
+
 	return query
 }
 
@@ -0,0 +1,305 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"media-platform/internal/adapter/presenter"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/usecase/dto"
+	"media-platform/internal/usecase/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ContentTypeController はコンテンツタイプに関するHTTPハンドラを提供します
+type ContentTypeController struct {
+	contentTypeService   *service.ContentTypeService
+	contentTypePresenter *presenter.ContentTypePresenter
+}
+
+// NewContentTypeController は新しいContentTypeControllerのインスタンスを生成します
+func NewContentTypeController(
+	contentTypeService *service.ContentTypeService,
+	contentTypePresenter *presenter.ContentTypePresenter,
+) *ContentTypeController {
+	return &ContentTypeController{
+		contentTypeService:   contentTypeService,
+		contentTypePresenter: contentTypePresenter,
+	}
+}
+
+// GetContentTypes はコンテンツタイプ一覧を取得するハンドラです
+// GET /api/content-types?include_inactive=true
+func (ctrl *ContentTypeController) GetContentTypes(c echo.Context) error {
+	includeInactive := c.QueryParam("include_inactive") == "true"
+
+	contentTypeDTOs, err := ctrl.contentTypeService.GetContentTypes(c.Request().Context(), includeInactive)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"content_types": ctrl.contentTypePresenter.ToHTTPContentTypeResponseList(contentTypeDTOs),
+		},
+	})
+}
+
+// GetContentType はコンテンツタイプを取得するハンドラです
+// GET /api/content-types/:id
+func (ctrl *ContentTypeController) GetContentType(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツタイプIDです",
+		})
+	}
+
+	contentTypeDTO, err := ctrl.contentTypeService.GetContentType(c.Request().Context(), id)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"content_type": ctrl.contentTypePresenter.ToHTTPContentTypeResponse(contentTypeDTO),
+		},
+	})
+}
+
+// CreateContentType はコンテンツタイプを作成するハンドラです（管理者用）
+// POST /api/content-types
+func (ctrl *ContentTypeController) CreateContentType(c echo.Context) error {
+	var req dto.CreateContentTypeRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	contentTypeDTO, err := ctrl.contentTypeService.CreateContentType(c.Request().Context(), &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"content_type": ctrl.contentTypePresenter.ToHTTPContentTypeResponse(contentTypeDTO),
+		},
+	})
+}
+
+// UpdateContentType はコンテンツタイプを更新するハンドラです（管理者用）
+// PUT /api/content-types/:id
+func (ctrl *ContentTypeController) UpdateContentType(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツタイプIDです",
+		})
+	}
+
+	var req dto.UpdateContentTypeRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	contentTypeDTO, err := ctrl.contentTypeService.UpdateContentType(c.Request().Context(), id, &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"content_type": ctrl.contentTypePresenter.ToHTTPContentTypeResponse(contentTypeDTO),
+		},
+	})
+}
+
+// DeleteContentType はコンテンツタイプを削除するハンドラです（管理者用）
+// DELETE /api/content-types/:id
+func (ctrl *ContentTypeController) DeleteContentType(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツタイプIDです",
+		})
+	}
+
+	if err := ctrl.contentTypeService.DeleteContentType(c.Request().Context(), id); err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ========== 評価軸 ==========
+
+// GetScoreAxes はコンテンツタイプの評価軸一覧を取得するハンドラです
+// GET /api/content-types/:id/axes
+func (ctrl *ContentTypeController) GetScoreAxes(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツタイプIDです",
+		})
+	}
+
+	axisDTOs, err := ctrl.contentTypeService.GetScoreAxes(c.Request().Context(), id)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"axes": ctrl.contentTypePresenter.ToHTTPScoreAxisResponseList(axisDTOs),
+		},
+	})
+}
+
+// CreateScoreAxis は評価軸を追加するハンドラです（管理者用）
+// POST /api/content-types/:id/axes
+func (ctrl *ContentTypeController) CreateScoreAxis(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツタイプIDです",
+		})
+	}
+
+	var req dto.CreateScoreAxisRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	axisDTO, err := ctrl.contentTypeService.CreateScoreAxis(c.Request().Context(), id, &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"axis": ctrl.contentTypePresenter.ToHTTPScoreAxisResponse(axisDTO),
+		},
+	})
+}
+
+// UpdateScoreAxis は評価軸を更新するハンドラです（管理者用）
+// PUT /api/content-types/:id/axes/:axisId
+func (ctrl *ContentTypeController) UpdateScoreAxis(c echo.Context) error {
+	id, axisID, ok := ctrl.getScoreAxisParams(c)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なIDです",
+		})
+	}
+
+	var req dto.UpdateScoreAxisRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	axisDTO, err := ctrl.contentTypeService.UpdateScoreAxis(c.Request().Context(), id, axisID, &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"axis": ctrl.contentTypePresenter.ToHTTPScoreAxisResponse(axisDTO),
+		},
+	})
+}
+
+// DeleteScoreAxis は評価軸を削除するハンドラです（管理者用）
+// DELETE /api/content-types/:id/axes/:axisId
+func (ctrl *ContentTypeController) DeleteScoreAxis(c echo.Context) error {
+	id, axisID, ok := ctrl.getScoreAxisParams(c)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なIDです",
+		})
+	}
+
+	if err := ctrl.contentTypeService.DeleteScoreAxis(c.Request().Context(), id, axisID); err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ========== ヘルパーメソッド ==========
+
+// getScoreAxisParams はパスパラメータからコンテンツタイプIDと評価軸IDを取得します
+func (ctrl *ContentTypeController) getScoreAxisParams(c echo.Context) (int64, int64, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	axisID, err := strconv.ParseInt(c.Param("axisId"), 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return id, axisID, true
+}
+
+// handleError はエラーを適切なHTTPステータスコードでレスポンスします
+func (ctrl *ContentTypeController) handleError(c echo.Context, err error) error {
+	if domainErrors.IsValidationError(err) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsNotFoundError(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsConflictError(err) {
+		return c.JSON(http.StatusConflict, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsPermissionError(err) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+		"status": "error",
+		"error":  "内部サーバーエラーが発生しました",
+	})
+}
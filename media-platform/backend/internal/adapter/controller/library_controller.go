@@ -0,0 +1,276 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"media-platform/internal/adapter/presenter"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/usecase/dto"
+	"media-platform/internal/usecase/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// LibraryController はユーザーのライブラリに関するHTTPハンドラを提供します
+type LibraryController struct {
+	libraryService   *service.LibraryService
+	libraryPresenter *presenter.LibraryPresenter
+}
+
+// NewLibraryController は新しいLibraryControllerのインスタンスを生成します
+func NewLibraryController(
+	libraryService *service.LibraryService,
+	libraryPresenter *presenter.LibraryPresenter,
+) *LibraryController {
+	return &LibraryController{
+		libraryService:   libraryService,
+		libraryPresenter: libraryPresenter,
+	}
+}
+
+// GetLibrary は自分のライブラリ一覧を取得するハンドラです
+// GET /api/users/me/library?status=&type=&year=&target=&limit=&offset=
+func (ctrl *LibraryController) GetLibrary(c echo.Context) error {
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	year, err := ctrl.getYearParam(c)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	limit, offset := ctrl.getPaginationParams(c)
+	query := &dto.LibraryQuery{
+		Status: c.QueryParam("status"),
+		Type:   c.QueryParam("type"),
+		Year:   year,
+		Target: c.QueryParam("target"),
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	entryDTOs, total, err := ctrl.libraryService.GetLibrary(c.Request().Context(), userID, query)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"entries": ctrl.libraryPresenter.ToHTTPLibraryEntryResponseList(entryDTOs),
+			"pagination": map[string]interface{}{
+				"total":  total,
+				"limit":  query.Limit,
+				"offset": query.Offset,
+			},
+		},
+	})
+}
+
+// GetLibraryStats は自分のライブラリ集計を取得するハンドラです
+// GET /api/users/me/library/stats?year=
+func (ctrl *LibraryController) GetLibraryStats(c echo.Context) error {
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	year, err := ctrl.getYearParam(c)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	statsDTO, err := ctrl.libraryService.GetLibraryStats(c.Request().Context(), userID, year)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.libraryPresenter.ToHTTPLibraryStatsResponse(statsDTO),
+	})
+}
+
+// AddToLibrary は作品またはコンテンツをライブラリに登録するハンドラです
+// POST /api/users/me/library
+func (ctrl *LibraryController) AddToLibrary(c echo.Context) error {
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	var req dto.CreateLibraryEntryRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	userRole, _ := c.Get("role").(string)
+	entryDTO, err := ctrl.libraryService.AddToLibrary(c.Request().Context(), userID, userRole, &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"entry": ctrl.libraryPresenter.ToHTTPLibraryEntryResponse(entryDTO),
+		},
+	})
+}
+
+// UpdateLibraryEntry はライブラリエントリを更新するハンドラです
+// PUT /api/users/me/library/:entryId
+func (ctrl *LibraryController) UpdateLibraryEntry(c echo.Context) error {
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	entryID, err := strconv.ParseInt(c.Param("entryId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なライブラリエントリIDです",
+		})
+	}
+
+	var req dto.UpdateLibraryEntryRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	entryDTO, err := ctrl.libraryService.UpdateLibraryEntry(c.Request().Context(), userID, entryID, &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"entry": ctrl.libraryPresenter.ToHTTPLibraryEntryResponse(entryDTO),
+		},
+	})
+}
+
+// RemoveFromLibrary はライブラリエントリを削除するハンドラです
+// DELETE /api/users/me/library/:entryId
+func (ctrl *LibraryController) RemoveFromLibrary(c echo.Context) error {
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	entryID, err := strconv.ParseInt(c.Param("entryId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なライブラリエントリIDです",
+		})
+	}
+
+	if err := ctrl.libraryService.RemoveFromLibrary(c.Request().Context(), userID, entryID); err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ========== ヘルパーメソッド ==========
+
+// getUserIDFromContext はコンテキストからユーザーIDを取得します
+func (ctrl *LibraryController) getUserIDFromContext(c echo.Context) (int64, error) {
+	userIDInterface := c.Get("user_id")
+	if userIDInterface == nil {
+		return 0, domainErrors.NewValidationError("認証が必要です")
+	}
+
+	switch v := userIDInterface.(type) {
+	case float64:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	default:
+		return 0, domainErrors.NewValidationError("ユーザー情報の取得に失敗しました")
+	}
+}
+
+// getYearParam はリクエストから集計・絞り込み対象の年を取得します（未指定の場合はnil）
+func (ctrl *LibraryController) getYearParam(c echo.Context) (*int, error) {
+	yearStr := c.QueryParam("year")
+	if yearStr == "" {
+		return nil, nil
+	}
+
+	year, err := strconv.Atoi(yearStr)
+	if err != nil || year < 1 {
+		return nil, domainErrors.NewValidationError("無効な年です")
+	}
+	return &year, nil
+}
+
+// getPaginationParams はリクエストからページネーションパラメータを取得します
+func (ctrl *LibraryController) getPaginationParams(c echo.Context) (int, int) {
+	limit := 20
+	offset := 0
+
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		if val, err := strconv.Atoi(limitStr); err == nil && val > 0 {
+			limit = val
+		}
+	}
+
+	if offsetStr := c.QueryParam("offset"); offsetStr != "" {
+		if val, err := strconv.Atoi(offsetStr); err == nil && val >= 0 {
+			offset = val
+		}
+	}
+
+	return limit, offset
+}
+
+// handleError はエラーを適切なHTTPステータスコードでレスポンスします
+func (ctrl *LibraryController) handleError(c echo.Context, err error) error {
+	if domainErrors.IsValidationError(err) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsNotFoundError(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsConflictError(err) {
+		return c.JSON(http.StatusConflict, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsPermissionError(err) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+		"status": "error",
+		"error":  "内部サーバーエラーが発生しました",
+	})
+}
@@ -0,0 +1,212 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/usecase/service"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/net/websocket"
+)
+
+// liveMaxMessageBytes はクライアントから受け付けるメッセージの最大サイズです
+const liveMaxMessageBytes = 4096
+
+// liveClientMessage はクライアントから送られるメッセージです（例: {"type":"typing"}）
+type liveClientMessage struct {
+	Type string `json:"type"`
+}
+
+// liveServerMessage はクライアントに送るメッセージです
+type liveServerMessage struct {
+	ID   int64           `json:"id,omitempty"` // 再接続時にlast_event_idとして指定する
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// LiveController はコンテンツのライブスレッド（WebSocket）のハンドラを提供します
+type LiveController struct {
+	streamService     *service.StreamService
+	heartbeatInterval time.Duration
+	allowedOrigins    []string
+}
+
+// NewLiveController は新しいLiveControllerのインスタンスを生成します
+// allowedOriginsはブラウザからの接続を許可するOriginです（Originのない接続は許可します）
+func NewLiveController(streamService *service.StreamService, heartbeatInterval time.Duration, allowedOrigins []string) *LiveController {
+	return &LiveController{
+		streamService:     streamService,
+		heartbeatInterval: heartbeatInterval,
+		allowedOrigins:    allowedOrigins,
+	}
+}
+
+// Live はコンテンツのコメントの投稿・編集・削除と入力中の表示をWebSocketで配信するハンドラです
+// GET /api/contents/:id/live?token=<JWT>&last_event_id=<ID>
+// クライアントは {"type":"typing"} を送ると、同じスレッドの他の参加者に入力中であることを通知できます
+func (ctrl *LiveController) Live(c echo.Context) error {
+	contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツIDです",
+		})
+	}
+
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+	username, _ := c.Get("username").(string)
+	userRole, _ := c.Get("role").(string)
+
+	var lastEventID int64
+	if value := c.QueryParam("last_event_id"); value != "" {
+		if id, err := strconv.ParseInt(value, 10, 64); err == nil && id > 0 {
+			lastEventID = id
+		}
+	}
+
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+
+	events, err := ctrl.streamService.SubscribeLive(ctx, contentID, userID, userRole, lastEventID)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	server := websocket.Server{
+		Handshake: ctrl.checkOrigin,
+		Handler: func(ws *websocket.Conn) {
+			defer ws.Close()
+			ws.MaxPayloadBytes = liveMaxMessageBytes
+
+			go ctrl.readLoop(ctx, cancel, ws, contentID, userID, username)
+			ctrl.writeLoop(ctx, ws, events)
+		},
+	}
+	server.ServeHTTP(c.Response(), c.Request())
+	return nil
+}
+
+// readLoop はクライアントからのメッセージを処理します（接続が閉じられたら購読を終了します）
+func (ctrl *LiveController) readLoop(ctx context.Context, cancel context.CancelFunc, ws *websocket.Conn, contentID, userID int64, username string) {
+	defer cancel()
+
+	var lastTyping time.Time
+	for {
+		var raw []byte
+		if err := websocket.Message.Receive(ws, &raw); err != nil {
+			return
+		}
+
+		var msg liveClientMessage
+		if err := json.Unmarshal(raw, &msg); err != nil {
+			continue // 不正なメッセージは無視する
+		}
+
+		switch msg.Type {
+		case "typing":
+			if time.Since(lastTyping) < entity.LiveTypingInterval {
+				continue
+			}
+			lastTyping = time.Now()
+			ctrl.streamService.PublishTyping(ctx, contentID, userID, username)
+		}
+	}
+}
+
+// writeLoop はイベントとハートビートをクライアントに送信します
+func (ctrl *LiveController) writeLoop(ctx context.Context, ws *websocket.Conn, events <-chan *entity.StreamEvent) {
+	heartbeat := time.NewTicker(ctrl.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		var msg liveServerMessage
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				// 受信が追いつかずにハブから切断された場合はクライアントの再接続に任せる
+				return
+			}
+			msg = liveServerMessage{Type: string(event.Type), Data: event.Data}
+			if !event.Ephemeral {
+				msg.ID = event.ID
+			}
+		case <-heartbeat.C:
+			msg = liveServerMessage{Type: "heartbeat"}
+		}
+
+		if err := websocket.JSON.Send(ws, msg); err != nil {
+			return
+		}
+	}
+}
+
+// ========== ヘルパーメソッド ==========
+
+// checkOrigin はブラウザからの接続のOriginが許可されているか確認します
+func (ctrl *LiveController) checkOrigin(config *websocket.Config, req *http.Request) error {
+	origin := req.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+	for _, allowed := range ctrl.allowedOrigins {
+		if origin == allowed {
+			return nil
+		}
+	}
+	return websocket.ErrBadWebSocketOrigin
+}
+
+// getUserIDFromContext はコンテキストからユーザーIDを取得します
+func (ctrl *LiveController) getUserIDFromContext(c echo.Context) (int64, error) {
+	userIDInterface := c.Get("user_id")
+	if userIDInterface == nil {
+		return 0, domainErrors.NewValidationError("認証が必要です")
+	}
+
+	switch v := userIDInterface.(type) {
+	case float64:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	default:
+		return 0, domainErrors.NewValidationError("ユーザー情報の取得に失敗しました")
+	}
+}
+
+// handleError はエラーを適切なHTTPステータスコードでレスポンスします
+func (ctrl *LiveController) handleError(c echo.Context, err error) error {
+	if domainErrors.IsValidationError(err) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsNotFoundError(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+		"status": "error",
+		"error":  "内部サーバーエラーが発生しました",
+	})
+}
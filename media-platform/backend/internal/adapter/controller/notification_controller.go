@@ -0,0 +1,244 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"media-platform/internal/adapter/presenter"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/usecase/dto"
+	"media-platform/internal/usecase/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// NotificationController は通知に関するHTTPハンドラを提供します
+type NotificationController struct {
+	notificationService   *service.NotificationService
+	notificationPresenter *presenter.NotificationPresenter
+}
+
+// NewNotificationController は新しいNotificationControllerのインスタンスを生成します
+func NewNotificationController(
+	notificationService *service.NotificationService,
+	notificationPresenter *presenter.NotificationPresenter,
+) *NotificationController {
+	return &NotificationController{
+		notificationService:   notificationService,
+		notificationPresenter: notificationPresenter,
+	}
+}
+
+// GetNotifications はログインユーザーの通知一覧を未読数付きで取得するハンドラです
+// GET /api/notifications?unread_only=true&limit=20&offset=0
+func (ctrl *NotificationController) GetNotifications(c echo.Context) error {
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	unreadOnly := c.QueryParam("unread_only") == "true"
+	limit, offset := ctrl.getPaginationParams(c)
+
+	listDTO, err := ctrl.notificationService.GetNotifications(c.Request().Context(), userID, unreadOnly, limit, offset)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.notificationPresenter.ToHTTPNotificationListResponse(listDTO),
+	})
+}
+
+// GetUnreadCount はログインユーザーの未読の通知数を取得するハンドラです
+// GET /api/notifications/unread-count
+func (ctrl *NotificationController) GetUnreadCount(c echo.Context) error {
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	count, err := ctrl.notificationService.GetUnreadCount(c.Request().Context(), userID)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"unread_count": count,
+		},
+	})
+}
+
+// MarkRead は通知を既読にするハンドラです
+// PUT /api/notifications/:id/read
+func (ctrl *NotificationController) MarkRead(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効な通知IDです",
+		})
+	}
+
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if err := ctrl.notificationService.MarkRead(c.Request().Context(), id, userID); err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// MarkAllRead はログインユーザーの未読の通知をすべて既読にするハンドラです
+// PUT /api/notifications/read-all
+func (ctrl *NotificationController) MarkAllRead(c echo.Context) error {
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	result, err := ctrl.notificationService.MarkAllRead(c.Request().Context(), userID)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"marked_count": result.MarkedCount,
+		},
+	})
+}
+
+// GetPreferences はログインユーザーのメール通知の設定を取得するハンドラです
+// GET /api/notifications/preferences
+func (ctrl *NotificationController) GetPreferences(c echo.Context) error {
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	preferenceDTO, err := ctrl.notificationService.GetPreferences(c.Request().Context(), userID)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.notificationPresenter.ToHTTPNotificationPreferenceResponse(preferenceDTO),
+	})
+}
+
+// UpdatePreferences はログインユーザーのメール通知の設定を更新するハンドラです
+// PUT /api/notifications/preferences
+func (ctrl *NotificationController) UpdatePreferences(c echo.Context) error {
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	var req dto.UpdateNotificationPreferenceRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	preferenceDTO, err := ctrl.notificationService.UpdatePreferences(c.Request().Context(), userID, &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.notificationPresenter.ToHTTPNotificationPreferenceResponse(preferenceDTO),
+	})
+}
+
+// ========== ヘルパーメソッド ==========
+
+// getUserIDFromContext はコンテキストからユーザーIDを取得します
+func (ctrl *NotificationController) getUserIDFromContext(c echo.Context) (int64, error) {
+	userIDInterface := c.Get("user_id")
+	if userIDInterface == nil {
+		return 0, domainErrors.NewValidationError("認証が必要です")
+	}
+
+	switch v := userIDInterface.(type) {
+	case float64:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	default:
+		return 0, domainErrors.NewValidationError("ユーザー情報の取得に失敗しました")
+	}
+}
+
+// getPaginationParams はリクエストからページネーションパラメータを取得します
+func (ctrl *NotificationController) getPaginationParams(c echo.Context) (int, int) {
+	limit := 20 // デフォルト値
+	offset := 0 // デフォルト値
+
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
+			limit = l
+		}
+	}
+
+	if offsetStr := c.QueryParam("offset"); offsetStr != "" {
+		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
+			offset = o
+		}
+	}
+
+	return limit, offset
+}
+
+// handleError はエラーを適切なHTTPステータスコードでレスポンスします
+func (ctrl *NotificationController) handleError(c echo.Context, err error) error {
+	if domainErrors.IsValidationError(err) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsNotFoundError(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+		"status": "error",
+		"error":  "内部サーバーエラーが発生しました",
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"media-platform/internal/adapter/presenter"
+	"media-platform/internal/domain/entity"
 	domainErrors "media-platform/internal/domain/errors"
 	"media-platform/internal/usecase/dto"
 	"media-platform/internal/usecase/service"
@@ -46,22 +47,27 @@ func (ctrl *RatingController) GetRatingsByContentID(c echo.Context) error {
 	// ページネーションパラメータの取得
 	limit, offset := ctrl.getPaginationParams(c)
 
+	// include_user=true の場合は評価したユーザーの概要を含める
+	includeUser := c.QueryParam("include_user") == "true"
+
 	// UseCaseから評価を取得
-	ratingDTOs, err := ctrl.ratingService.GetRatingsByContentID(c.Request().Context(), contentID, limit, offset)
+	ratingListDTO, err := ctrl.ratingService.GetRatingsByContentID(c.Request().Context(), contentID, limit, offset, includeUser)
 	if err != nil {
 		return ctrl.handleError(c, err)
 	}
 
 	// PresenterでHTTPレスポンス用に変換
-	httpRatings := ctrl.ratingPresenter.ToHTTPRatingResponseList(ratingDTOs)
+	httpRatings := ctrl.ratingPresenter.ToHTTPRatingResponseList(ratingListDTO.Ratings)
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"status": "success",
 		"data": map[string]interface{}{
 			"ratings": httpRatings,
 			"pagination": map[string]interface{}{
-				"limit":  limit,
-				"offset": offset,
+				"total":    ratingListDTO.TotalCount,
+				"limit":    limit,
+				"offset":   offset,
+				"has_more": ratingListDTO.HasMore,
 			},
 			"content_id": contentID,
 		},
@@ -82,22 +88,27 @@ func (ctrl *RatingController) GetRatingsByUserID(c echo.Context) error {
 	// ページネーションパラメータの取得
 	limit, offset := ctrl.getPaginationParams(c)
 
+	// include_user=true の場合は評価したユーザーの概要を含める
+	includeUser := c.QueryParam("include_user") == "true"
+
 	// UseCaseから評価を取得
-	ratingDTOs, err := ctrl.ratingService.GetRatingsByUserID(c.Request().Context(), userID, limit, offset)
+	ratingListDTO, err := ctrl.ratingService.GetRatingsByUserID(c.Request().Context(), userID, limit, offset, includeUser)
 	if err != nil {
 		return ctrl.handleError(c, err)
 	}
 
 	// PresenterでHTTPレスポンス用に変換
-	httpRatings := ctrl.ratingPresenter.ToHTTPRatingResponseList(ratingDTOs)
+	httpRatings := ctrl.ratingPresenter.ToHTTPRatingResponseList(ratingListDTO.Ratings)
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"status": "success",
 		"data": map[string]interface{}{
 			"ratings": httpRatings,
 			"pagination": map[string]interface{}{
-				"limit":  limit,
-				"offset": offset,
+				"total":    ratingListDTO.TotalCount,
+				"limit":    limit,
+				"offset":   offset,
+				"has_more": ratingListDTO.HasMore,
 			},
 			"user_id": userID,
 		},
@@ -140,7 +151,7 @@ func (ctrl *RatingController) GetAverageRatingByContentID(c echo.Context) error
 	})
 }
 
-// GetGoodStatsByContentID は指定したコンテンツIDのグッド統計を取得するハンドラです
+// GetGoodStatsByContentID は指定したコンテンツIDのグッド統計と星評価の統計を取得するハンドラです
 func (ctrl *RatingController) GetGoodStatsByContentID(c echo.Context) error {
 	contentIDStr := c.Param("contentId")
 	contentID, err := strconv.ParseInt(contentIDStr, 10, 64)
@@ -166,10 +177,97 @@ func (ctrl *RatingController) GetGoodStatsByContentID(c echo.Context) error {
 			"good_count": httpStats.LikeCount,
 			"count":      httpStats.Count,
 			"content_id": httpStats.ContentID,
+			"stars": map[string]interface{}{
+				"count":     httpStats.StarCount,
+				"average":   httpStats.Average,
+				"histogram": httpStats.Histogram,
+			},
 		},
 	})
 }
 
+// RateWithStars は星評価（1〜5）を登録・変更するハンドラです
+// PUT /api/contents/:contentId/ratings/stars
+func (ctrl *RatingController) RateWithStars(c echo.Context) error {
+	contentID, err := strconv.ParseInt(c.Param("contentId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツIDです",
+		})
+	}
+
+	claims, err := ctrl.getUserClaimsFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	userID, err := ctrl.getUserIDFromClaims(claims)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	var req dto.StarRatingRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	starDTO, err := ctrl.ratingService.RateWithStars(c.Request().Context(), userID, contentID, &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"star_rating": ctrl.ratingPresenter.ToHTTPStarRatingResponse(starDTO),
+		},
+	})
+}
+
+// RemoveStarRating は自分の星評価を取り消すハンドラです
+// DELETE /api/contents/:contentId/ratings/stars
+func (ctrl *RatingController) RemoveStarRating(c echo.Context) error {
+	contentID, err := strconv.ParseInt(c.Param("contentId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツIDです",
+		})
+	}
+
+	claims, err := ctrl.getUserClaimsFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	userID, err := ctrl.getUserIDFromClaims(claims)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if err := ctrl.ratingService.RemoveStarRating(c.Request().Context(), userID, contentID); err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
 // GetUserRatingStatus は指定したコンテンツに対するユーザーの評価状態を取得するハンドラです
 func (ctrl *RatingController) GetUserRatingStatus(c echo.Context) error {
 	contentIDStr := c.Param("contentId")
@@ -244,33 +342,36 @@ func (ctrl *RatingController) CreateRating(c echo.Context) error {
 	req.Value = 1
 
 	// UseCaseで評価を作成/削除
-	ratingDTO, err := ctrl.ratingService.CreateOrUpdateRating(c.Request().Context(), userID, &req)
+	stateDTO, err := ctrl.ratingService.CreateOrUpdateRating(c.Request().Context(), userID, &req)
 	if err != nil {
 		return ctrl.handleError(c, err)
 	}
 
+	httpState := ctrl.ratingPresenter.ToHTTPLikeStateResponse(stateDTO)
+
 	// トグル動作の結果に応じてレスポンスを変更
-	if ratingDTO == nil {
+	if !stateDTO.Liked {
 		// 評価が削除された場合（トグルオフ）
 		return c.JSON(http.StatusOK, map[string]interface{}{
 			"status": "success",
 			"data": map[string]interface{}{
-				"action":  "removed",
-				"message": "評価を取り消しました",
+				"action":     "removed",
+				"like_state": httpState,
+				"like_count": httpState.LikeCount,
+				"message":    "評価を取り消しました",
 			},
 		})
 	}
 
-	// PresenterでHTTPレスポンス用に変換
-	httpRating := ctrl.ratingPresenter.ToHTTPRatingResponse(ratingDTO)
-
 	// 評価が作成された場合（トグルオン）
 	return c.JSON(http.StatusCreated, map[string]interface{}{
 		"status": "success",
 		"data": map[string]interface{}{
-			"rating":  httpRating,
-			"action":  "created",
-			"message": "評価を追加しました",
+			"action":     "created",
+			"like_state": httpState,
+			"rating_id":  httpState.RatingID,
+			"like_count": httpState.LikeCount,
+			"message":    "評価を追加しました",
 		},
 	})
 }
@@ -314,12 +415,19 @@ func (ctrl *RatingController) DeleteRating(c echo.Context) error {
 	isAdmin := userRole == "admin"
 
 	// UseCaseで評価を削除
-	err = ctrl.ratingService.DeleteRating(c.Request().Context(), id, userID, isAdmin)
+	stateDTO, err := ctrl.ratingService.DeleteRating(c.Request().Context(), id, userID, isAdmin)
 	if err != nil {
 		return ctrl.handleError(c, err)
 	}
 
-	return c.NoContent(http.StatusNoContent)
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"action":     "removed",
+			"like_state": ctrl.ratingPresenter.ToHTTPLikeStateResponse(stateDTO),
+			"message":    "評価を削除しました",
+		},
+	})
 }
 
 // ToggleLike はいいねのトグル（追加/削除）を行うハンドラです
@@ -357,35 +465,84 @@ func (ctrl *RatingController) ToggleLike(c echo.Context) error {
 	}
 
 	// UseCaseで評価を作成/削除
-	ratingDTO, err := ctrl.ratingService.CreateOrUpdateRating(c.Request().Context(), userID, req)
+	stateDTO, err := ctrl.ratingService.CreateOrUpdateRating(c.Request().Context(), userID, req)
 	if err != nil {
 		return ctrl.handleError(c, err)
 	}
 
-	// トグル動作の結果に応じてレスポンスを変更
-	if ratingDTO == nil {
-		// 評価が削除された場合（トグルオフ）
-		return c.JSON(http.StatusOK, map[string]interface{}{
-			"status": "success",
-			"data": map[string]interface{}{
-				"action":    "removed",
-				"has_liked": false,
-				"message":   "いいねを取り消しました",
-			},
+	return ctrl.respondLikeState(c, stateDTO)
+}
+
+// LikeContent はコンテンツにいいねするハンドラです（冪等: 既にいいね済みでも同じ結果を返します）
+// PUT /api/contents/:contentId/like
+func (ctrl *RatingController) LikeContent(c echo.Context) error {
+	return ctrl.applyLike(c, entity.LikeActionLike)
+}
+
+// UnlikeContent はコンテンツのいいねを取り消すハンドラです（冪等: いいねしていなくても同じ結果を返します）
+// DELETE /api/contents/:contentId/like
+func (ctrl *RatingController) UnlikeContent(c echo.Context) error {
+	return ctrl.applyLike(c, entity.LikeActionUnlike)
+}
+
+// applyLike はパスのコンテンツに対していいね操作を適用します
+func (ctrl *RatingController) applyLike(c echo.Context, action entity.LikeAction) error {
+	contentID, err := strconv.ParseInt(c.Param("contentId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なコンテンツIDです",
 		})
 	}
 
-	// PresenterでHTTPレスポンス用に変換
-	httpRating := ctrl.ratingPresenter.ToHTTPRatingResponse(ratingDTO)
+	claims, err := ctrl.getUserClaimsFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	userID, err := ctrl.getUserIDFromClaims(claims)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	stateDTO, err := ctrl.ratingService.ApplyLike(c.Request().Context(), userID, contentID, action)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return ctrl.respondLikeState(c, stateDTO)
+}
+
+// respondLikeState はいいね操作後の状態をレスポンスします
+func (ctrl *RatingController) respondLikeState(c echo.Context, stateDTO *dto.LikeStateResponse) error {
+	httpState := ctrl.ratingPresenter.ToHTTPLikeStateResponse(stateDTO)
+
+	action := "unchanged"
+	message := "いいねの状態は変わりませんでした"
+	switch {
+	case httpState.Changed && httpState.Liked:
+		action = "created"
+		message = "いいねしました"
+	case httpState.Changed:
+		action = "removed"
+		message = "いいねを取り消しました"
+	}
 
-	// 評価が作成された場合（トグルオン）
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"status": "success",
 		"data": map[string]interface{}{
-			"action":    "created",
-			"has_liked": true,
-			"rating_id": httpRating.ID,
-			"message":   "いいねしました",
+			"action":     action,
+			"has_liked":  httpState.Liked,
+			"rating_id":  httpState.RatingID,
+			"like_count": httpState.LikeCount,
+			"like_state": httpState,
+			"message":    message,
 		},
 	})
 }
@@ -424,6 +581,7 @@ func (ctrl *RatingController) GetUserLikedContents(c echo.Context) error {
 }
 
 // GetTopRatedContents は人気コンテンツを取得するハンドラです
+// GET /api/ratings/top-contents?sort=likes|bayesian
 func (ctrl *RatingController) GetTopRatedContents(c echo.Context) error {
 	// クエリパラメータの取得
 	limit := 10
@@ -441,8 +599,13 @@ func (ctrl *RatingController) GetTopRatedContents(c echo.Context) error {
 		}
 	}
 
+	sort := c.QueryParam("sort")
+	if sort == "" {
+		sort = "likes"
+	}
+
 	// 人気コンテンツIDを取得
-	contentIDs, err := ctrl.ratingService.GetTopRatedContents(c.Request().Context(), limit, days)
+	contentIDs, err := ctrl.ratingService.GetTopRatedContents(c.Request().Context(), limit, days, sort)
 	if err != nil {
 		return ctrl.handleError(c, err)
 	}
@@ -453,6 +616,7 @@ func (ctrl *RatingController) GetTopRatedContents(c echo.Context) error {
 			"content_ids": contentIDs,
 			"limit":       limit,
 			"days":        days,
+			"sort":        sort,
 		},
 	})
 }
@@ -0,0 +1,253 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"media-platform/internal/adapter/presenter"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/usecase/dto"
+	"media-platform/internal/usecase/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ReactionController は絵文字リアクションに関するHTTPハンドラを提供します
+type ReactionController struct {
+	reactionService   *service.ReactionService
+	reactionPresenter *presenter.ReactionPresenter
+}
+
+// NewReactionController は新しいReactionControllerのインスタンスを生成します
+func NewReactionController(
+	reactionService *service.ReactionService,
+	reactionPresenter *presenter.ReactionPresenter,
+) *ReactionController {
+	return &ReactionController{
+		reactionService:   reactionService,
+		reactionPresenter: reactionPresenter,
+	}
+}
+
+// GetPalette はリアクションのパレットを取得するハンドラです
+// GET /api/reactions/palette?include_inactive=true
+func (ctrl *ReactionController) GetPalette(c echo.Context) error {
+	includeInactive := c.QueryParam("include_inactive") == "true"
+
+	kindDTOs, err := ctrl.reactionService.GetPalette(c.Request().Context(), includeInactive)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"palette": ctrl.reactionPresenter.ToHTTPReactionKindResponseList(kindDTOs),
+		},
+	})
+}
+
+// CreateReactionKind はパレットにリアクションの種類を追加するハンドラです（管理者用）
+// POST /api/reactions/palette
+func (ctrl *ReactionController) CreateReactionKind(c echo.Context) error {
+	var req dto.CreateReactionKindRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	kindDTO, err := ctrl.reactionService.CreateReactionKind(c.Request().Context(), &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"reaction_kind": ctrl.reactionPresenter.ToHTTPReactionKindResponse(kindDTO),
+		},
+	})
+}
+
+// UpdateReactionKind はリアクションの種類を更新するハンドラです（管理者用）
+// PUT /api/reactions/palette/:id
+func (ctrl *ReactionController) UpdateReactionKind(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なリアクションIDです",
+		})
+	}
+
+	var req dto.UpdateReactionKindRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	kindDTO, err := ctrl.reactionService.UpdateReactionKind(c.Request().Context(), id, &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"reaction_kind": ctrl.reactionPresenter.ToHTTPReactionKindResponse(kindDTO),
+		},
+	})
+}
+
+// DeleteReactionKind はリアクションの種類を削除するハンドラです（管理者用）
+// DELETE /api/reactions/palette/:id
+func (ctrl *ReactionController) DeleteReactionKind(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なリアクションIDです",
+		})
+	}
+
+	if err := ctrl.reactionService.DeleteReactionKind(c.Request().Context(), id); err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ToggleReaction はリアクションのトグル（追加/取り消し）を行うハンドラです
+// POST /api/reactions/toggle/:targetType/:targetId （targetType は content または comment）
+func (ctrl *ReactionController) ToggleReaction(c echo.Context) error {
+	targetID, err := strconv.ParseInt(c.Param("targetId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効な対象IDです",
+		})
+	}
+
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	var req dto.ToggleReactionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	toggleDTO, err := ctrl.reactionService.ToggleReaction(c.Request().Context(), userID, c.Param("targetType"), targetID, &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	action := "created"
+	message := "リアクションしました"
+	if !toggleDTO.Reacted {
+		action = "removed"
+		message = "リアクションを取り消しました"
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"action":   action,
+			"reaction": ctrl.reactionPresenter.ToHTTPReactionToggleResponse(toggleDTO),
+			"message":  message,
+		},
+	})
+}
+
+// GetBulkReactionCounts は複数の対象のリアクション数を一括取得するハンドラです
+// POST /api/reactions/bulk-counts
+func (ctrl *ReactionController) GetBulkReactionCounts(c echo.Context) error {
+	var req dto.BulkReactionCountsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	countsMap, err := ctrl.reactionService.GetBulkCounts(c.Request().Context(), &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"target_type": req.TargetType,
+			"counts":      ctrl.reactionPresenter.ToHTTPReactionCountsMap(countsMap),
+		},
+	})
+}
+
+// ========== ヘルパーメソッド ==========
+
+// getUserIDFromContext はコンテキストからユーザーIDを取得します
+func (ctrl *ReactionController) getUserIDFromContext(c echo.Context) (int64, error) {
+	userIDInterface := c.Get("user_id")
+	if userIDInterface == nil {
+		return 0, domainErrors.NewValidationError("認証が必要です")
+	}
+
+	switch v := userIDInterface.(type) {
+	case float64:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	default:
+		return 0, domainErrors.NewValidationError("ユーザー情報の取得に失敗しました")
+	}
+}
+
+// handleError はエラーを適切なHTTPステータスコードでレスポンスします
+func (ctrl *ReactionController) handleError(c echo.Context, err error) error {
+	if domainErrors.IsValidationError(err) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsNotFoundError(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsConflictError(err) {
+		return c.JSON(http.StatusConflict, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsPermissionError(err) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+		"status": "error",
+		"error":  "内部サーバーエラーが発生しました",
+	})
+}
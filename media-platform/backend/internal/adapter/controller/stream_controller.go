@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/usecase/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// StreamController はServer-Sent Eventsによるリアルタイム配信のHTTPハンドラを提供します
+type StreamController struct {
+	streamService     *service.StreamService
+	heartbeatInterval time.Duration
+}
+
+// NewStreamController は新しいStreamControllerのインスタンスを生成します
+func NewStreamController(streamService *service.StreamService, heartbeatInterval time.Duration) *StreamController {
+	return &StreamController{
+		streamService:     streamService,
+		heartbeatInterval: heartbeatInterval,
+	}
+}
+
+// Stream はログインユーザーへの通知と、指定したコンテンツのいいね数・新しいコメントを配信するハンドラです
+// GET /api/stream?contents=1,2,3&token=<JWT>
+// EventSourceはAuthorizationヘッダーを送れないため、tokenクエリでJWTを渡せます（ヘッダーがある場合はヘッダーを優先）
+// 再接続時はLast-Event-IDヘッダー（またはlast_event_idクエリ）以降のイベントが再送されます
+func (ctrl *StreamController) Stream(c echo.Context) error {
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	userRole, _ := c.Get("role").(string)
+
+	contentIDs, err := ctrl.parseContentIDs(c.QueryParam("contents"))
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	lastEventID := ctrl.parseLastEventID(c)
+
+	ctx := c.Request().Context()
+	events, err := ctrl.streamService.Subscribe(ctx, userID, userRole, contentIDs, lastEventID)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no") // リバースプロキシでのバッファリングを無効にする
+	res.WriteHeader(http.StatusOK)
+
+	// 切断時の再接続までの待ち時間（ミリ秒）
+	if _, err := fmt.Fprint(res, "retry: 3000\n\n"); err != nil {
+		return nil
+	}
+	res.Flush()
+
+	heartbeat := time.NewTicker(ctrl.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				// 受信が追いつかずにハブから切断された場合はクライアントの再接続に任せる
+				return nil
+			}
+			if _, err := fmt.Fprintf(res, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, event.Data); err != nil {
+				return nil
+			}
+			res.Flush()
+		case <-heartbeat.C:
+			if _, err := fmt.Fprintf(res, ": heartbeat %d\n\n", time.Now().Unix()); err != nil {
+				return nil
+			}
+			res.Flush()
+		}
+	}
+}
+
+// ========== ヘルパーメソッド ==========
+
+// parseContentIDs はカンマ区切りのコンテンツIDを解析します
+func (ctrl *StreamController) parseContentIDs(value string) ([]int64, error) {
+	contentIDs := []int64{}
+	seen := make(map[int64]bool)
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || id <= 0 {
+			return nil, domainErrors.NewValidationError("無効なコンテンツIDです: " + part)
+		}
+		if !seen[id] {
+			seen[id] = true
+			contentIDs = append(contentIDs, id)
+		}
+	}
+	return contentIDs, nil
+}
+
+// parseLastEventID は再接続時の最後に受信したイベントIDを取得します（ない場合は0）
+func (ctrl *StreamController) parseLastEventID(c echo.Context) int64 {
+	value := c.Request().Header.Get("Last-Event-ID")
+	if value == "" {
+		value = c.QueryParam("last_event_id")
+	}
+
+	id, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || id < 0 {
+		return 0
+	}
+	return id
+}
+
+// getUserIDFromContext はコンテキストからユーザーIDを取得します
+func (ctrl *StreamController) getUserIDFromContext(c echo.Context) (int64, error) {
+	userIDInterface := c.Get("user_id")
+	if userIDInterface == nil {
+		return 0, domainErrors.NewValidationError("認証が必要です")
+	}
+
+	switch v := userIDInterface.(type) {
+	case float64:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	default:
+		return 0, domainErrors.NewValidationError("ユーザー情報の取得に失敗しました")
+	}
+}
+
+// handleError はエラーを適切なHTTPステータスコードでレスポンスします
+func (ctrl *StreamController) handleError(c echo.Context, err error) error {
+	if domainErrors.IsValidationError(err) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+		"status": "error",
+		"error":  "内部サーバーエラーが発生しました",
+	})
+}
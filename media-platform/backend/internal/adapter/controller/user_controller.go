@@ -2,6 +2,7 @@ package controller
 
 import (
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"strconv"
@@ -227,6 +228,111 @@ func (ctrl *UserController) UpdateCurrentUser(c echo.Context) error {
 	})
 }
 
+// UploadAvatar は現在のユーザーのアバター画像をアップロードします
+// PUT /api/users/me/avatar (multipart/form-data: avatar, crop_x, crop_y, crop_size)
+func (ctrl *UserController) UploadAvatar(c echo.Context) error {
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	fileHeader, err := c.FormFile("avatar")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "アバター画像ファイル(avatar)が必要です",
+		})
+	}
+	if fileHeader.Size > dto.MaxAvatarUploadBytes {
+		return c.JSON(http.StatusRequestEntityTooLarge, map[string]interface{}{
+			"status": "error",
+			"error":  "アバター画像は5MB以内である必要があります",
+		})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "アバター画像ファイルを開けませんでした",
+		})
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, dto.MaxAvatarUploadBytes+1))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "アバター画像ファイルの読み込みに失敗しました",
+		})
+	}
+
+	req := &dto.UpdateAvatarRequest{Data: data}
+	for name, target := range map[string]**int{
+		"crop_x":    &req.CropX,
+		"crop_y":    &req.CropY,
+		"crop_size": &req.CropSize,
+	} {
+		value := c.FormValue(name)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"status": "error",
+				"error":  fmt.Sprintf("%sは整数で指定してください", name),
+			})
+		}
+		*target = &n
+	}
+
+	// アバター更新の実行
+	serviceResp, err := ctrl.userService.UpdateAvatar(c.Request().Context(), userID, req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	// Service DTOをPresentation DTOに変換
+	response := ctrl.userPresenter.ToHTTPUserResponse(serviceResp)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"user": response,
+		},
+	})
+}
+
+// DeleteAvatar は現在のユーザーのアバター画像を削除します
+// DELETE /api/users/me/avatar
+func (ctrl *UserController) DeleteAvatar(c echo.Context) error {
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	serviceResp, err := ctrl.userService.DeleteAvatar(c.Request().Context(), userID)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	response := ctrl.userPresenter.ToHTTPUserResponse(serviceResp)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"user": response,
+		},
+	})
+}
+
 // DeleteUser はユーザーを削除します
 // DELETE /api/users/:id
 func (ctrl *UserController) DeleteUser(c echo.Context) error {
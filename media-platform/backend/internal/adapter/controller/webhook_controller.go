@@ -0,0 +1,314 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"media-platform/internal/adapter/presenter"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/usecase/dto"
+	"media-platform/internal/usecase/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// WebhookController はWebhookの管理と配信ログに関するHTTPハンドラを提供します（管理者用）
+type WebhookController struct {
+	webhookService   *service.WebhookService
+	webhookPresenter *presenter.WebhookPresenter
+}
+
+// NewWebhookController は新しいWebhookControllerのインスタンスを生成します
+func NewWebhookController(
+	webhookService *service.WebhookService,
+	webhookPresenter *presenter.WebhookPresenter,
+) *WebhookController {
+	return &WebhookController{
+		webhookService:   webhookService,
+		webhookPresenter: webhookPresenter,
+	}
+}
+
+// ListWebhooks はWebhookの一覧を取得するハンドラです
+// GET /api/admin/webhooks?limit=20&offset=0
+func (ctrl *WebhookController) ListWebhooks(c echo.Context) error {
+	limit, offset := ctrl.getPaginationParams(c)
+
+	listDTO, err := ctrl.webhookService.ListWebhooks(c.Request().Context(), limit, offset)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.webhookPresenter.ToHTTPWebhookListResponse(listDTO),
+	})
+}
+
+// GetWebhook はWebhookを取得するハンドラです
+// GET /api/admin/webhooks/:id
+func (ctrl *WebhookController) GetWebhook(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なWebhook IDです",
+		})
+	}
+
+	webhookDTO, err := ctrl.webhookService.GetWebhook(c.Request().Context(), id)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.webhookPresenter.ToHTTPWebhookResponse(webhookDTO),
+	})
+}
+
+// CreateWebhook はWebhookを登録するハンドラです（レスポンスに署名用のシークレットが含まれます）
+// POST /api/admin/webhooks
+func (ctrl *WebhookController) CreateWebhook(c echo.Context) error {
+	userID, err := ctrl.getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	var req dto.CreateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	webhookDTO, err := ctrl.webhookService.CreateWebhook(c.Request().Context(), userID, &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.webhookPresenter.ToHTTPWebhookResponse(webhookDTO),
+	})
+}
+
+// UpdateWebhook はWebhookを更新するハンドラです
+// PUT /api/admin/webhooks/:id
+func (ctrl *WebhookController) UpdateWebhook(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なWebhook IDです",
+		})
+	}
+
+	var req dto.UpdateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	webhookDTO, err := ctrl.webhookService.UpdateWebhook(c.Request().Context(), id, &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.webhookPresenter.ToHTTPWebhookResponse(webhookDTO),
+	})
+}
+
+// DeleteWebhook はWebhookを配信ログとともに削除するハンドラです
+// DELETE /api/admin/webhooks/:id
+func (ctrl *WebhookController) DeleteWebhook(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なWebhook IDです",
+		})
+	}
+
+	if err := ctrl.webhookService.DeleteWebhook(c.Request().Context(), id); err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RotateSecret はWebhookのシークレットを再生成するハンドラです
+// POST /api/admin/webhooks/:id/rotate-secret
+func (ctrl *WebhookController) RotateSecret(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なWebhook IDです",
+		})
+	}
+
+	webhookDTO, err := ctrl.webhookService.RotateSecret(c.Request().Context(), id)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.webhookPresenter.ToHTTPWebhookResponse(webhookDTO),
+	})
+}
+
+// PingWebhook は疎通確認のpingイベントを送信するハンドラです（配信はワーカーが行います）
+// POST /api/admin/webhooks/:id/ping
+func (ctrl *WebhookController) PingWebhook(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なWebhook IDです",
+		})
+	}
+
+	deliveryDTO, err := ctrl.webhookService.PingWebhook(c.Request().Context(), id)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.webhookPresenter.ToHTTPWebhookDeliveryResponse(deliveryDTO),
+	})
+}
+
+// GetDeliveries はWebhookの配信ログを取得するハンドラです
+// GET /api/admin/webhooks/:id/deliveries?status=dead&limit=20&offset=0
+func (ctrl *WebhookController) GetDeliveries(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なWebhook IDです",
+		})
+	}
+
+	limit, offset := ctrl.getPaginationParams(c)
+
+	listDTO, err := ctrl.webhookService.GetDeliveries(c.Request().Context(), id, c.QueryParam("status"), limit, offset)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.webhookPresenter.ToHTTPWebhookDeliveryListResponse(listDTO),
+	})
+}
+
+// RedeliverDelivery はデッドレターまたは成功した配信を再配信するハンドラです
+// POST /api/admin/webhooks/:id/deliveries/:deliveryId/redeliver
+func (ctrl *WebhookController) RedeliverDelivery(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効なWebhook IDです",
+		})
+	}
+
+	deliveryID, err := strconv.ParseInt(c.Param("deliveryId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効な配信IDです",
+		})
+	}
+
+	deliveryDTO, err := ctrl.webhookService.RedeliverDelivery(c.Request().Context(), id, deliveryID)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.webhookPresenter.ToHTTPWebhookDeliveryResponse(deliveryDTO),
+	})
+}
+
+// ========== ヘルパーメソッド ==========
+
+// getUserIDFromContext はコンテキストからユーザーIDを取得します
+func (ctrl *WebhookController) getUserIDFromContext(c echo.Context) (int64, error) {
+	userIDInterface := c.Get("user_id")
+	if userIDInterface == nil {
+		return 0, domainErrors.NewValidationError("認証が必要です")
+	}
+
+	switch v := userIDInterface.(type) {
+	case float64:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	default:
+		return 0, domainErrors.NewValidationError("ユーザー情報の取得に失敗しました")
+	}
+}
+
+// getPaginationParams はリクエストからページネーションパラメータを取得します
+func (ctrl *WebhookController) getPaginationParams(c echo.Context) (int, int) {
+	limit := 20 // デフォルト値
+	offset := 0 // デフォルト値
+
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
+			limit = l
+		}
+	}
+
+	if offsetStr := c.QueryParam("offset"); offsetStr != "" {
+		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
+			offset = o
+		}
+	}
+
+	return limit, offset
+}
+
+// handleError はエラーを適切なHTTPステータスコードでレスポンスします
+func (ctrl *WebhookController) handleError(c echo.Context, err error) error {
+	if domainErrors.IsValidationError(err) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsNotFoundError(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsConflictError(err) {
+		return c.JSON(http.StatusConflict, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+		"status": "error",
+		"error":  "内部サーバーエラーが発生しました",
+	})
+}
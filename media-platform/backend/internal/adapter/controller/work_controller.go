@@ -0,0 +1,231 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"media-platform/internal/adapter/presenter"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/usecase/dto"
+	"media-platform/internal/usecase/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// WorkController は作品カタログに関するHTTPハンドラを提供します
+type WorkController struct {
+	workService   *service.WorkService
+	workPresenter *presenter.WorkPresenter
+}
+
+// NewWorkController は新しいWorkControllerのインスタンスを生成します
+func NewWorkController(
+	workService *service.WorkService,
+	workPresenter *presenter.WorkPresenter,
+) *WorkController {
+	return &WorkController{
+		workService:   workService,
+		workPresenter: workPresenter,
+	}
+}
+
+// GetWorks は作品を検索するハンドラです
+// GET /api/works?q=&type=&limit=&offset=
+func (ctrl *WorkController) GetWorks(c echo.Context) error {
+	limit, offset := ctrl.getPaginationParams(c)
+	query := &dto.WorkQuery{
+		Keyword: c.QueryParam("q"),
+		Type:    c.QueryParam("type"),
+		Limit:   limit,
+		Offset:  offset,
+	}
+
+	workDTOs, err := ctrl.workService.SearchWorks(c.Request().Context(), query)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"works": ctrl.workPresenter.ToHTTPWorkResponseList(workDTOs),
+			"pagination": map[string]interface{}{
+				"limit":  query.Limit,
+				"offset": query.Offset,
+			},
+		},
+	})
+}
+
+// GetWork は作品詳細（レビュー一覧・平均スコア・おすすめ度分布）を取得するハンドラです
+// GET /api/works/:id
+func (ctrl *WorkController) GetWork(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効な作品IDです",
+		})
+	}
+
+	detailDTO, err := ctrl.workService.GetWorkDetail(c.Request().Context(), id)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.workPresenter.ToHTTPWorkDetailResponse(detailDTO),
+	})
+}
+
+// LookupWorks はレビュー作成時の入力補完用に作品を検索するハンドラです
+// GET /api/works/lookup?q=&type=
+func (ctrl *WorkController) LookupWorks(c echo.Context) error {
+	req := &dto.WorkLookupRequest{
+		Query: c.QueryParam("q"),
+		Type:  c.QueryParam("type"),
+	}
+
+	lookupDTO, err := ctrl.workService.LookupWorks(c.Request().Context(), req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   ctrl.workPresenter.ToHTTPWorkLookupResponse(lookupDTO),
+	})
+}
+
+// CreateWork は作品を登録するハンドラです
+// POST /api/works
+func (ctrl *WorkController) CreateWork(c echo.Context) error {
+	var req dto.CreateWorkRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	workDTO, err := ctrl.workService.CreateWork(c.Request().Context(), &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"work": ctrl.workPresenter.ToHTTPWorkResponse(workDTO),
+		},
+	})
+}
+
+// UpdateWork は作品情報を更新するハンドラです
+// PUT /api/works/:id
+func (ctrl *WorkController) UpdateWork(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効な作品IDです",
+		})
+	}
+
+	var req dto.UpdateWorkRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "リクエストデータが無効です: " + err.Error(),
+		})
+	}
+
+	workDTO, err := ctrl.workService.UpdateWork(c.Request().Context(), id, &req)
+	if err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"work": ctrl.workPresenter.ToHTTPWorkResponse(workDTO),
+		},
+	})
+}
+
+// DeleteWork は作品を削除するハンドラです
+// DELETE /api/works/:id
+func (ctrl *WorkController) DeleteWork(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  "無効な作品IDです",
+		})
+	}
+
+	if err := ctrl.workService.DeleteWork(c.Request().Context(), id); err != nil {
+		return ctrl.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ========== ヘルパーメソッド ==========
+
+// getPaginationParams はリクエストからページネーションパラメータを取得します
+func (ctrl *WorkController) getPaginationParams(c echo.Context) (int, int) {
+	limit := 20
+	offset := 0
+
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		if val, err := strconv.Atoi(limitStr); err == nil && val > 0 {
+			limit = val
+		}
+	}
+
+	if offsetStr := c.QueryParam("offset"); offsetStr != "" {
+		if val, err := strconv.Atoi(offsetStr); err == nil && val >= 0 {
+			offset = val
+		}
+	}
+
+	return limit, offset
+}
+
+// handleError はエラーを適切なHTTPステータスコードでレスポンスします
+func (ctrl *WorkController) handleError(c echo.Context, err error) error {
+	if domainErrors.IsValidationError(err) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsNotFoundError(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsConflictError(err) {
+		return c.JSON(http.StatusConflict, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	if domainErrors.IsPermissionError(err) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"status": "error",
+			"error":  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+		"status": "error",
+		"error":  "内部サーバーエラーが発生しました",
+	})
+}
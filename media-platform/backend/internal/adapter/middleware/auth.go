@@ -144,3 +144,28 @@ func (jc *JWTConfig) OptionalAuthMiddleware() echo.MiddlewareFunc {
 		}
 	}
 }
+
+// WebSocketAuthMiddleware はWebSocket・SSE（EventSource）接続用の認証ミドルウェアです
+// ブラウザのWebSocketとEventSourceはヘッダーを設定できないため、Authorizationヘッダーがない場合は
+// tokenクエリパラメータのJWTをBearerトークンとして扱い、AuthMiddlewareと同じ検証を行います
+func (jc *JWTConfig) WebSocketAuthMiddleware() echo.MiddlewareFunc {
+	authMiddleware := jc.AuthMiddleware()
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		authenticated := authMiddleware(next)
+		return func(c echo.Context) error {
+			req := c.Request()
+			query := req.URL.Query()
+			if token := query.Get("token"); token != "" {
+				if req.Header.Get("Authorization") == "" {
+					req.Header.Set("Authorization", "Bearer "+token)
+				}
+
+				// アクセスログにトークンが残らないようにURLから取り除く
+				query.Del("token")
+				req.URL.RawQuery = query.Encode()
+				req.RequestURI = req.URL.RequestURI()
+			}
+			return authenticated(c)
+		}
+	}
+}
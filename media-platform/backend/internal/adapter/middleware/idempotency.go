@@ -0,0 +1,175 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"media-platform/internal/domain/entity"
+	"media-platform/internal/domain/repository"
+
+	"github.com/labstack/echo/v4"
+)
+
+// IdempotencyKeyHeader はリクエストの冪等性キーを指定するヘッダーです
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader は保存済みのレスポンスを返したことを示すヘッダーです
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// IdempotencyMiddleware はIdempotency-Keyヘッダー付きのリクエストを1回だけ実行するミドルウェアを提供します
+// 同じキーの再送には最初のレスポンスをそのまま返します。AuthMiddlewareの後に適用してください
+func IdempotencyMiddleware(idempotencyRepo repository.IdempotencyRepository) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(IdempotencyKeyHeader)
+			if key == "" {
+				return next(c)
+			}
+
+			if len(key) > entity.IdempotencyKeyMaxLength {
+				return c.JSON(http.StatusBadRequest, map[string]interface{}{
+					"status": "error",
+					"error":  "Idempotency-Keyは255文字以内で指定してください",
+				})
+			}
+
+			userID, ok := idempotencyUserID(c)
+			if !ok {
+				return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+					"status": "error",
+					"error":  "認証が必要です",
+				})
+			}
+
+			requestHash, err := hashRequest(c)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, map[string]interface{}{
+					"status": "error",
+					"error":  "リクエストボディの読み込みに失敗しました",
+				})
+			}
+
+			ctx := c.Request().Context()
+			record, reserved, err := idempotencyRepo.Reserve(ctx, userID, key, requestHash, time.Now().Add(-entity.IdempotencyKeyTTL))
+			if err != nil {
+				log.Printf("⚠️  Idempotency key reservation failed: %v", err)
+				return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+					"status": "error",
+					"error":  "内部サーバーエラーが発生しました",
+				})
+			}
+
+			if !reserved {
+				if record.RequestHash != requestHash {
+					return c.JSON(http.StatusUnprocessableEntity, map[string]interface{}{
+						"status": "error",
+						"error":  "同じIdempotency-Keyが異なるリクエストに使用されています",
+					})
+				}
+				if !record.IsCompleted() {
+					return c.JSON(http.StatusConflict, map[string]interface{}{
+						"status": "error",
+						"error":  "同じIdempotency-Keyのリクエストを処理中です",
+					})
+				}
+
+				// 保存済みのレスポンスを返す
+				c.Response().Header().Set(IdempotentReplayedHeader, "true")
+				return c.Blob(*record.StatusCode, record.ContentType, record.ResponseBody)
+			}
+
+			// レスポンスを記録しながらハンドラを実行する
+			recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+
+			handlerErr := next(c)
+
+			// リクエストがキャンセルされても結果は保存する
+			saveCtx := context.WithoutCancel(ctx)
+			status := c.Response().Status
+			if handlerErr != nil || status >= http.StatusInternalServerError {
+				// 失敗したリクエストは再試行できるようにキーを解放する
+				if err := idempotencyRepo.Release(saveCtx, userID, key); err != nil {
+					log.Printf("⚠️  Idempotency key release failed: %v", err)
+				}
+				return handlerErr
+			}
+
+			contentType := c.Response().Header().Get(echo.HeaderContentType)
+			if err := idempotencyRepo.Complete(saveCtx, userID, key, status, contentType, recorder.body.Bytes()); err != nil {
+				log.Printf("⚠️  Idempotency key completion failed: %v", err)
+			}
+
+			return nil
+		}
+	}
+}
+
+// idempotencyUserID はコンテキストからユーザーIDを取得します
+func idempotencyUserID(c echo.Context) (int64, bool) {
+	switch v := c.Get("user_id").(type) {
+	case float64:
+		return int64(v), true
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// hashRequest はメソッド・パス・ボディからリクエストのハッシュを計算します（ボディは読み直せるように戻します）
+func hashRequest(c echo.Context) (string, error) {
+	req := c.Request()
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return "", err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(req.Method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(req.URL.Path))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// responseRecorder はレスポンスボディを記録しながら書き込むResponseWriterです
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *responseRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (r *responseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if hijacker, ok := r.ResponseWriter.(http.Hijacker); ok {
+		return hijacker.Hijack()
+	}
+	return nil, nil, errors.New("response writer does not support hijacking")
+}
@@ -0,0 +1,103 @@
+package presenter
+
+import (
+	"media-platform/internal/usecase/dto"
+)
+
+// AnnotationPresenter は注釈をHTTPレスポンスDTOに変換します
+type AnnotationPresenter struct{}
+
+// NewAnnotationPresenter は新しいAnnotationPresenterのインスタンスを生成します
+func NewAnnotationPresenter() *AnnotationPresenter {
+	return &AnnotationPresenter{}
+}
+
+// ========== HTTP Response DTO構造体 ==========
+
+// HTTPTextQuoteResponse はHTTPレスポンス用の注釈の引用部分です
+type HTTPTextQuoteResponse struct {
+	Exact  string `json:"exact"`
+	Prefix string `json:"prefix"`
+	Suffix string `json:"suffix"`
+}
+
+// HTTPAnnotationResponse はHTTPレスポンス用の注釈です
+type HTTPAnnotationResponse struct {
+	ID         int64                 `json:"id"`
+	ContentID  int64                 `json:"content_id"`
+	UserID     int64                 `json:"user_id"`
+	User       *HTTPUserBrief        `json:"user,omitempty"`
+	Body       string                `json:"body"`
+	BlockIndex int                   `json:"block_index"`
+	Quote      HTTPTextQuoteResponse `json:"quote"`
+	Start      *int                  `json:"start,omitempty"` // 段落内の引用部分の開始位置（文字単位）
+	End        *int                  `json:"end,omitempty"`   // 段落内の引用部分の終了位置（文字単位）
+	IsOrphaned bool                  `json:"is_orphaned"`
+	CreatedAt  string                `json:"created_at"`
+	UpdatedAt  string                `json:"updated_at,omitempty"`
+}
+
+// HTTPAnnotationListResponse はHTTPレスポンス用の注釈一覧です
+type HTTPAnnotationListResponse struct {
+	ContentID   int64                     `json:"content_id"`
+	Annotations []*HTTPAnnotationResponse `json:"annotations"`
+	Total       int                       `json:"total"`
+}
+
+// ========== UseCase DTO → HTTP Response DTO変換 ==========
+
+// ToHTTPAnnotationResponse は注釈DTOをHTTPレスポンス用DTOに変換します
+func (p *AnnotationPresenter) ToHTTPAnnotationResponse(annotationDTO *dto.AnnotationResponse) *HTTPAnnotationResponse {
+	if annotationDTO == nil {
+		return nil
+	}
+
+	response := &HTTPAnnotationResponse{
+		ID:         annotationDTO.ID,
+		ContentID:  annotationDTO.ContentID,
+		UserID:     annotationDTO.UserID,
+		Body:       annotationDTO.Body,
+		BlockIndex: annotationDTO.BlockIndex,
+		Quote: HTTPTextQuoteResponse{
+			Exact:  annotationDTO.Quote.Exact,
+			Prefix: annotationDTO.Quote.Prefix,
+			Suffix: annotationDTO.Quote.Suffix,
+		},
+		Start:      annotationDTO.Start,
+		End:        annotationDTO.End,
+		IsOrphaned: annotationDTO.IsOrphaned,
+		CreatedAt:  annotationDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if !annotationDTO.UpdatedAt.IsZero() {
+		response.UpdatedAt = annotationDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	if annotationDTO.User != nil {
+		response.User = &HTTPUserBrief{
+			ID:       annotationDTO.User.ID,
+			Username: annotationDTO.User.Username,
+			Avatar:   annotationDTO.User.Avatar,
+		}
+	}
+
+	return response
+}
+
+// ToHTTPAnnotationListResponse は注釈一覧DTOをHTTPレスポンス用DTOに変換します
+func (p *AnnotationPresenter) ToHTTPAnnotationListResponse(listDTO *dto.AnnotationListResponse) *HTTPAnnotationListResponse {
+	if listDTO == nil {
+		return nil
+	}
+
+	annotations := make([]*HTTPAnnotationResponse, len(listDTO.Annotations))
+	for i, annotationDTO := range listDTO.Annotations {
+		annotations[i] = p.ToHTTPAnnotationResponse(annotationDTO)
+	}
+
+	return &HTTPAnnotationListResponse{
+		ContentID:   listDTO.ContentID,
+		Annotations: annotations,
+		Total:       listDTO.Total,
+	}
+}
@@ -1,6 +1,8 @@
 package presenter
 
 import (
+	"time"
+
 	"media-platform/internal/usecase/dto" // DTOのみに依存
 )
 
@@ -20,6 +22,39 @@ type HTTPCommentResponse struct {
 	User      *HTTPUserBrief `json:"user,omitempty"`
 	CreatedAt string         `json:"created_at"`
 	UpdatedAt string         `json:"updated_at,omitempty"`
+
+	IsPinned      bool   `json:"is_pinned"`
+	ReactionCount int64  `json:"reaction_count"`
+	IsDeleted     bool   `json:"is_deleted"`
+	IsEdited      bool   `json:"is_edited"`
+	EditedAt      string `json:"edited_at,omitempty"`
+	IsPending     bool   `json:"is_pending"`
+
+	Mentions []*HTTPMentionResponse `json:"mentions"`
+}
+
+// HTTPMentionResponse はHTTPレスポンス用の本文中のメンションの位置です（文字単位のオフセット）
+type HTTPMentionResponse struct {
+	UserID   int64  `json:"user_id"`
+	Username string `json:"username"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+}
+
+// HTTPCommentRevisionResponse はHTTPレスポンス用のコメントの編集前の本文です
+type HTTPCommentRevisionResponse struct {
+	ID        int64  `json:"id"`
+	Body      string `json:"body"`
+	EditorID  *int64 `json:"editor_id,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+// HTTPCommentRevisionListResponse はHTTPレスポンス用のコメントの編集履歴です
+type HTTPCommentRevisionListResponse struct {
+	CommentID   int64                          `json:"comment_id"`
+	CurrentBody string                         `json:"current_body"`
+	EditedAt    string                         `json:"edited_at,omitempty"`
+	Revisions   []*HTTPCommentRevisionResponse `json:"revisions"`
 }
 
 type HTTPUserBrief struct {
@@ -34,6 +69,37 @@ type HTTPCommentListResponse struct {
 	HasMore    bool                   `json:"has_more"`
 }
 
+// HTTPCommentTreeNodeResponse はHTTPレスポンス用のコメントツリーのノードです
+type HTTPCommentTreeNodeResponse struct {
+	ID             int64                          `json:"id"`
+	Body           string                         `json:"body"`
+	UserID         int64                          `json:"user_id"`
+	ContentID      int64                          `json:"content_id"`
+	ParentID       *int64                         `json:"parent_id,omitempty"`
+	User           *HTTPUserBrief                 `json:"user,omitempty"`
+	CreatedAt      string                         `json:"created_at"`
+	UpdatedAt      string                         `json:"updated_at,omitempty"`
+	IsDeleted      bool                           `json:"is_deleted"`
+	IsEdited       bool                           `json:"is_edited"`
+	EditedAt       string                         `json:"edited_at,omitempty"`
+	IsPending      bool                           `json:"is_pending"`
+	Mentions       []*HTTPMentionResponse         `json:"mentions"`
+	Depth          int                            `json:"depth"`
+	ReplyCount     int64                          `json:"reply_count"`
+	HasMoreReplies bool                           `json:"has_more_replies"`
+	RepliesCursor  string                         `json:"replies_cursor,omitempty"`
+	Replies        []*HTTPCommentTreeNodeResponse `json:"replies"`
+}
+
+// HTTPCommentTreeResponse はHTTPレスポンス用のコメントツリーです
+type HTTPCommentTreeResponse struct {
+	ContentID  int64                          `json:"content_id"`
+	ParentID   *int64                         `json:"parent_id,omitempty"`
+	Comments   []*HTTPCommentTreeNodeResponse `json:"comments"`
+	HasMore    bool                           `json:"has_more"`
+	NextCursor string                         `json:"next_cursor,omitempty"`
+}
+
 // UseCase DTO → HTTP Response DTO変換
 func (p *CommentPresenter) ToHTTPCommentResponse(commentDTO *dto.CommentResponse) *HTTPCommentResponse {
 	if commentDTO == nil {
@@ -48,6 +114,15 @@ func (p *CommentPresenter) ToHTTPCommentResponse(commentDTO *dto.CommentResponse
 		ParentID:  commentDTO.ParentID,
 		CreatedAt: commentDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt: commentDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+
+		IsPinned:      commentDTO.IsPinned,
+		ReactionCount: commentDTO.ReactionCount,
+		IsDeleted:     commentDTO.IsDeleted,
+		IsEdited:      commentDTO.IsEdited,
+		EditedAt:      formatOptionalCommentTime(commentDTO.EditedAt),
+		IsPending:     commentDTO.IsPending,
+
+		Mentions: toHTTPMentionResponses(commentDTO.Mentions),
 	}
 
 	// UserBriefが存在する場合
@@ -83,3 +158,97 @@ func (p *CommentPresenter) ToHTTPCommentListResponse(commentDTOs []*dto.CommentR
 		HasMore:    hasMore,
 	}
 }
+
+// ToHTTPCommentTreeResponse はコメントツリーのDTOをHTTPレスポンス用に変換します
+func (p *CommentPresenter) ToHTTPCommentTreeResponse(treeDTO *dto.CommentTreeResponse) *HTTPCommentTreeResponse {
+	response := &HTTPCommentTreeResponse{
+		ContentID:  treeDTO.ContentID,
+		ParentID:   treeDTO.ParentID,
+		Comments:   make([]*HTTPCommentTreeNodeResponse, 0, len(treeDTO.Comments)),
+		HasMore:    treeDTO.HasMore,
+		NextCursor: treeDTO.NextCursor,
+	}
+	for _, node := range treeDTO.Comments {
+		response.Comments = append(response.Comments, p.toHTTPCommentTreeNodeResponse(node))
+	}
+	return response
+}
+
+func (p *CommentPresenter) toHTTPCommentTreeNodeResponse(nodeDTO *dto.CommentTreeNodeResponse) *HTTPCommentTreeNodeResponse {
+	response := &HTTPCommentTreeNodeResponse{
+		ID:             nodeDTO.ID,
+		Body:           nodeDTO.Body,
+		UserID:         nodeDTO.UserID,
+		ContentID:      nodeDTO.ContentID,
+		ParentID:       nodeDTO.ParentID,
+		CreatedAt:      nodeDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      nodeDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		IsDeleted:      nodeDTO.IsDeleted,
+		IsEdited:       nodeDTO.IsEdited,
+		EditedAt:       formatOptionalCommentTime(nodeDTO.EditedAt),
+		IsPending:      nodeDTO.IsPending,
+		Mentions:       toHTTPMentionResponses(nodeDTO.Mentions),
+		Depth:          nodeDTO.Depth,
+		ReplyCount:     nodeDTO.ReplyCount,
+		HasMoreReplies: nodeDTO.HasMoreReplies,
+		RepliesCursor:  nodeDTO.RepliesCursor,
+		Replies:        make([]*HTTPCommentTreeNodeResponse, 0, len(nodeDTO.Replies)),
+	}
+
+	if nodeDTO.User != nil {
+		response.User = &HTTPUserBrief{
+			ID:       nodeDTO.User.ID,
+			Username: nodeDTO.User.Username,
+			Avatar:   nodeDTO.User.Avatar,
+		}
+	}
+
+	for _, reply := range nodeDTO.Replies {
+		response.Replies = append(response.Replies, p.toHTTPCommentTreeNodeResponse(reply))
+	}
+
+	return response
+}
+
+// ToHTTPCommentRevisionListResponse はコメントの編集履歴のDTOをHTTPレスポンス用に変換します
+func (p *CommentPresenter) ToHTTPCommentRevisionListResponse(revisionsDTO *dto.CommentRevisionListResponse) *HTTPCommentRevisionListResponse {
+	response := &HTTPCommentRevisionListResponse{
+		CommentID:   revisionsDTO.CommentID,
+		CurrentBody: revisionsDTO.CurrentBody,
+		EditedAt:    formatOptionalCommentTime(revisionsDTO.EditedAt),
+		Revisions:   make([]*HTTPCommentRevisionResponse, 0, len(revisionsDTO.Revisions)),
+	}
+
+	for _, revision := range revisionsDTO.Revisions {
+		response.Revisions = append(response.Revisions, &HTTPCommentRevisionResponse{
+			ID:        revision.ID,
+			Body:      revision.Body,
+			EditorID:  revision.EditorID,
+			CreatedAt: revision.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+
+	return response
+}
+
+// formatOptionalCommentTime は日時をRFC3339形式の文字列に変換します（nilの場合は空文字列）
+func formatOptionalCommentTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02T15:04:05Z07:00")
+}
+
+// toHTTPMentionResponses はメンションの位置をHTTPレスポンス用に変換します（nilの場合は空配列）
+func toHTTPMentionResponses(mentionDTOs []*dto.MentionResponse) []*HTTPMentionResponse {
+	responses := make([]*HTTPMentionResponse, 0, len(mentionDTOs))
+	for _, mention := range mentionDTOs {
+		responses = append(responses, &HTTPMentionResponse{
+			UserID:   mention.UserID,
+			Username: mention.Username,
+			Start:    mention.Start,
+			End:      mention.End,
+		})
+	}
+	return responses
+}
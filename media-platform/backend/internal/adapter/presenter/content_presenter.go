@@ -16,18 +16,54 @@ func NewContentPresenter() *ContentPresenter {
 
 // HTTPContentResponse はHTTPレスポンス用のコンテンツ情報です
 type HTTPContentResponse struct {
-	ID          int64  `json:"id"`
-	Title       string `json:"title"`
-	Body        string `json:"body"`
-	Type        string `json:"type"`
-	Genre       string `json:"genre,omitempty"`
-	AuthorID    int64  `json:"author_id"`
-	CategoryID  int64  `json:"category_id,omitempty"`
-	Status      string `json:"status"`
-	ViewCount   int64  `json:"view_count"`
-	PublishedAt string `json:"published_at,omitempty"` // RFC3339形式
-	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at,omitempty"`
+	ID           int64  `json:"id"`
+	Title        string `json:"title"`
+	Body         string `json:"body"`
+	Type         string `json:"type"`
+	Genre        string `json:"genre,omitempty"`
+	AuthorID     int64  `json:"author_id"`
+	CategoryID   int64  `json:"category_id,omitempty"`
+	Status       string `json:"status"`
+	ViewCount    int64  `json:"view_count"`
+	LikeCount    int64  `json:"like_count"`
+	CommentCount int64  `json:"comment_count"`
+	PublishedAt  string `json:"published_at,omitempty"` // RFC3339形式
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at,omitempty"`
+
+	WorkID              *int64   `json:"work_id,omitempty"`
+	ReviewScore         *float64 `json:"review_score,omitempty"`
+	RecommendationLevel string   `json:"recommendation_level,omitempty"`
+
+	ArtistName  string   `json:"artist_name,omitempty"`
+	ReleaseYear *int     `json:"release_year,omitempty"`
+	ImageURL    string   `json:"image_url,omitempty"`
+	ExternalURL string   `json:"external_url,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+
+	Metadata map[string]interface{} `json:"metadata"`
+
+	CommentPolicy string `json:"comment_policy"`
+
+	AxisScores []*HTTPAxisScoreResponse `json:"axis_scores,omitempty"`
+	Radar      *HTTPRadarChartResponse  `json:"radar,omitempty"`
+
+	Mentions []*HTTPMentionResponse `json:"mentions,omitempty"`
+}
+
+// HTTPAxisScoreResponse はHTTPレスポンス用の軸別スコアです
+type HTTPAxisScoreResponse struct {
+	Key   string  `json:"key"`
+	Label string  `json:"label"`
+	Score float64 `json:"score"`
+}
+
+// HTTPRadarChartResponse はHTTPレスポンス用のレーダーチャート描画データです
+type HTTPRadarChartResponse struct {
+	Keys   []string   `json:"keys"`
+	Labels []string   `json:"labels"`
+	Values []*float64 `json:"values"`
+	Max    float64    `json:"max"`
 }
 
 // ========== UseCase DTO → HTTP Response DTO変換 ==========
@@ -39,17 +75,47 @@ func (p *ContentPresenter) ToHTTPContentResponse(contentDTO *dto.ContentResponse
 	}
 
 	response := &HTTPContentResponse{
-		ID:         contentDTO.ID,
-		Title:      contentDTO.Title,
-		Body:       contentDTO.Body,
-		Type:       contentDTO.Type,
-		Genre:      contentDTO.Genre,
-		AuthorID:   contentDTO.AuthorID,
-		CategoryID: contentDTO.CategoryID,
-		Status:     contentDTO.Status,
-		ViewCount:  contentDTO.ViewCount,
-		CreatedAt:  contentDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:  contentDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:           contentDTO.ID,
+		Title:        contentDTO.Title,
+		Body:         contentDTO.Body,
+		Type:         contentDTO.Type,
+		Genre:        contentDTO.Genre,
+		AuthorID:     contentDTO.AuthorID,
+		CategoryID:   contentDTO.CategoryID,
+		Status:       contentDTO.Status,
+		ViewCount:    contentDTO.ViewCount,
+		LikeCount:    contentDTO.LikeCount,
+		CommentCount: contentDTO.CommentCount,
+		CreatedAt:    contentDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:    contentDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+
+		WorkID:              contentDTO.WorkID,
+		ReviewScore:         contentDTO.ReviewScore,
+		RecommendationLevel: contentDTO.RecommendationLevel,
+
+		ArtistName:  contentDTO.ArtistName,
+		ReleaseYear: contentDTO.ReleaseYear,
+		ImageURL:    contentDTO.ImageURL,
+		ExternalURL: contentDTO.ExternalURL,
+		Tags:        contentDTO.Tags,
+
+		Metadata: contentDTO.Metadata,
+
+		CommentPolicy: contentDTO.CommentPolicy,
+
+		Radar: toHTTPRadarChartResponse(contentDTO.Radar),
+	}
+
+	for _, axisScore := range contentDTO.AxisScores {
+		response.AxisScores = append(response.AxisScores, &HTTPAxisScoreResponse{
+			Key:   axisScore.Key,
+			Label: axisScore.Label,
+			Score: axisScore.Score,
+		})
+	}
+
+	if contentDTO.Mentions != nil {
+		response.Mentions = toHTTPMentionResponses(contentDTO.Mentions)
 	}
 
 	// PublishedAtはnilの可能性があるため条件付き
@@ -74,3 +140,16 @@ func (p *ContentPresenter) ToHTTPContentResponseList(contentDTOs []*dto.ContentR
 	}
 	return responses
 }
+
+// toHTTPRadarChartResponse はレーダーチャート描画データをHTTPレスポンス用DTOに変換します
+func toHTTPRadarChartResponse(radarDTO *dto.RadarChartResponse) *HTTPRadarChartResponse {
+	if radarDTO == nil {
+		return nil
+	}
+	return &HTTPRadarChartResponse{
+		Keys:   radarDTO.Keys,
+		Labels: radarDTO.Labels,
+		Values: radarDTO.Values,
+		Max:    radarDTO.Max,
+	}
+}
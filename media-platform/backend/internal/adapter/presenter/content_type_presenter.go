@@ -0,0 +1,131 @@
+package presenter
+
+import (
+	"media-platform/internal/usecase/dto"
+)
+
+// ContentTypePresenter はコンテンツタイプをHTTPレスポンスDTOに変換します
+type ContentTypePresenter struct{}
+
+// NewContentTypePresenter は新しいContentTypePresenterのインスタンスを生成します
+func NewContentTypePresenter() *ContentTypePresenter {
+	return &ContentTypePresenter{}
+}
+
+// ========== HTTP Response DTO構造体 ==========
+
+// HTTPContentTypeResponse はHTTPレスポンス用のコンテンツタイプ情報です
+type HTTPContentTypeResponse struct {
+	ID                int64    `json:"id"`
+	Name              string   `json:"name"`
+	DisplayName       string   `json:"display_name"`
+	Description       string   `json:"description,omitempty"`
+	AllowedFields     []string `json:"allowed_fields"`
+	MetadataSchema    string   `json:"metadata_schema"`
+	DefaultCategoryID *int64   `json:"default_category_id,omitempty"`
+	SortOrder         int      `json:"sort_order"`
+	IsActive          bool     `json:"is_active"`
+	CreatedAt         string   `json:"created_at"`
+	UpdatedAt         string   `json:"updated_at,omitempty"`
+
+	MetadataFields []*HTTPMetadataFieldResponse `json:"metadata_fields"`
+}
+
+// HTTPMetadataFieldResponse はHTTPレスポンス用の構造化項目の定義です
+type HTTPMetadataFieldResponse struct {
+	Key      string   `json:"key"`
+	Label    string   `json:"label"`
+	Kind     string   `json:"kind"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options,omitempty"`
+	Min      *float64 `json:"min,omitempty"`
+	Max      *float64 `json:"max,omitempty"`
+}
+
+// HTTPScoreAxisResponse はHTTPレスポンス用の評価軸情報です
+type HTTPScoreAxisResponse struct {
+	ID          int64  `json:"id"`
+	ContentType string `json:"content_type"`
+	Key         string `json:"key"`
+	Label       string `json:"label"`
+	SortOrder   int    `json:"sort_order"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+// ========== UseCase DTO → HTTP Response DTO変換 ==========
+
+// ToHTTPContentTypeResponse はUseCase DTOをHTTPレスポンス用DTOに変換します
+func (p *ContentTypePresenter) ToHTTPContentTypeResponse(contentTypeDTO *dto.ContentTypeResponse) *HTTPContentTypeResponse {
+	if contentTypeDTO == nil {
+		return nil
+	}
+
+	metadataFields := make([]*HTTPMetadataFieldResponse, 0, len(contentTypeDTO.MetadataFields))
+	for _, field := range contentTypeDTO.MetadataFields {
+		metadataFields = append(metadataFields, &HTTPMetadataFieldResponse{
+			Key:      field.Key,
+			Label:    field.Label,
+			Kind:     field.Kind,
+			Required: field.Required,
+			Options:  field.Options,
+			Min:      field.Min,
+			Max:      field.Max,
+		})
+	}
+
+	return &HTTPContentTypeResponse{
+		ID:                contentTypeDTO.ID,
+		Name:              contentTypeDTO.Name,
+		DisplayName:       contentTypeDTO.DisplayName,
+		Description:       contentTypeDTO.Description,
+		AllowedFields:     contentTypeDTO.AllowedFields,
+		MetadataSchema:    contentTypeDTO.MetadataSchema,
+		DefaultCategoryID: contentTypeDTO.DefaultCategoryID,
+		SortOrder:         contentTypeDTO.SortOrder,
+		IsActive:          contentTypeDTO.IsActive,
+		CreatedAt:         contentTypeDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:         contentTypeDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+
+		MetadataFields: metadataFields,
+	}
+}
+
+// ToHTTPContentTypeResponseList はUseCase DTOリストをHTTPレスポンス用DTOリストに変換します
+func (p *ContentTypePresenter) ToHTTPContentTypeResponseList(contentTypeDTOs []*dto.ContentTypeResponse) []*HTTPContentTypeResponse {
+	responses := make([]*HTTPContentTypeResponse, 0, len(contentTypeDTOs))
+	for _, contentTypeDTO := range contentTypeDTOs {
+		if contentTypeDTO != nil {
+			responses = append(responses, p.ToHTTPContentTypeResponse(contentTypeDTO))
+		}
+	}
+	return responses
+}
+
+// ToHTTPScoreAxisResponse は評価軸DTOをHTTPレスポンス用DTOに変換します
+func (p *ContentTypePresenter) ToHTTPScoreAxisResponse(axisDTO *dto.ScoreAxisResponse) *HTTPScoreAxisResponse {
+	if axisDTO == nil {
+		return nil
+	}
+
+	return &HTTPScoreAxisResponse{
+		ID:          axisDTO.ID,
+		ContentType: axisDTO.ContentType,
+		Key:         axisDTO.Key,
+		Label:       axisDTO.Label,
+		SortOrder:   axisDTO.SortOrder,
+		CreatedAt:   axisDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   axisDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// ToHTTPScoreAxisResponseList は評価軸DTOリストをHTTPレスポンス用DTOリストに変換します
+func (p *ContentTypePresenter) ToHTTPScoreAxisResponseList(axisDTOs []*dto.ScoreAxisResponse) []*HTTPScoreAxisResponse {
+	responses := make([]*HTTPScoreAxisResponse, 0, len(axisDTOs))
+	for _, axisDTO := range axisDTOs {
+		if axisDTO != nil {
+			responses = append(responses, p.ToHTTPScoreAxisResponse(axisDTO))
+		}
+	}
+	return responses
+}
@@ -123,14 +123,15 @@ func (p *FollowPresenter) ToHTTPFollowUserResponse(appDTO *dto.FollowUserRespons
 
 	return &HTTPFollowUserResponse{
 		User: HTTPUserResponse{
-			ID:        appDTO.User.ID,
-			Username:  appDTO.User.Username,
-			Email:     appDTO.User.Email,
-			Bio:       appDTO.User.Bio,
-			Avatar:    appDTO.User.Avatar,
-			Role:      appDTO.User.Role,
-			CreatedAt: appDTO.User.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt: appDTO.User.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			ID:         appDTO.User.ID,
+			Username:   appDTO.User.Username,
+			Email:      appDTO.User.Email,
+			Bio:        appDTO.User.Bio,
+			Avatar:     appDTO.User.Avatar,
+			AvatarURLs: appDTO.User.AvatarURLs,
+			Role:       appDTO.User.Role,
+			CreatedAt:  appDTO.User.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			UpdatedAt:  appDTO.User.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		},
 		CreatedAt: appDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
@@ -179,16 +180,30 @@ func (p *FollowPresenter) ToHTTPContentResponse(appDTO *dto.ContentResponse) *HT
 	}
 
 	response := &HTTPContentResponse{
-		ID:         appDTO.ID,
-		Title:      appDTO.Title,
-		Body:       appDTO.Body,
-		Type:       appDTO.Type,
-		AuthorID:   appDTO.AuthorID,
-		CategoryID: appDTO.CategoryID,
-		Status:     appDTO.Status,
-		ViewCount:  appDTO.ViewCount,
-		CreatedAt:  appDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:  appDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:           appDTO.ID,
+		Title:        appDTO.Title,
+		Body:         appDTO.Body,
+		Type:         appDTO.Type,
+		AuthorID:     appDTO.AuthorID,
+		CategoryID:   appDTO.CategoryID,
+		Status:       appDTO.Status,
+		ViewCount:    appDTO.ViewCount,
+		LikeCount:    appDTO.LikeCount,
+		CommentCount: appDTO.CommentCount,
+		CreatedAt:    appDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:    appDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+
+		WorkID:              appDTO.WorkID,
+		ReviewScore:         appDTO.ReviewScore,
+		RecommendationLevel: appDTO.RecommendationLevel,
+
+		ArtistName:  appDTO.ArtistName,
+		ReleaseYear: appDTO.ReleaseYear,
+		ImageURL:    appDTO.ImageURL,
+		ExternalURL: appDTO.ExternalURL,
+		Tags:        appDTO.Tags,
+
+		Metadata: appDTO.Metadata,
 	}
 
 	if appDTO.PublishedAt != nil {
@@ -0,0 +1,102 @@
+package presenter
+
+import (
+	"time"
+
+	"media-platform/internal/usecase/dto"
+)
+
+// LibraryPresenter はライブラリをHTTPレスポンスDTOに変換します
+type LibraryPresenter struct{}
+
+// NewLibraryPresenter は新しいLibraryPresenterのインスタンスを生成します
+func NewLibraryPresenter() *LibraryPresenter {
+	return &LibraryPresenter{}
+}
+
+// ========== HTTP Response DTO構造体 ==========
+
+// HTTPLibraryEntryResponse はHTTPレスポンス用のライブラリエントリです
+type HTTPLibraryEntryResponse struct {
+	ID            int64  `json:"id"`
+	WorkID        *int64 `json:"work_id,omitempty"`
+	ContentID     *int64 `json:"content_id,omitempty"`
+	ItemTitle     string `json:"item_title"`
+	ItemType      string `json:"item_type"`
+	Status        string `json:"status"`
+	Progress      int    `json:"progress"`
+	ProgressTotal *int   `json:"progress_total,omitempty"`
+	ProgressUnit  string `json:"progress_unit,omitempty"`
+	StartedOn     string `json:"started_on,omitempty"`
+	FinishedOn    string `json:"finished_on,omitempty"`
+	Note          string `json:"note"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at,omitempty"`
+}
+
+// HTTPLibraryStatsResponse はHTTPレスポンス用のライブラリ集計です
+type HTTPLibraryStatsResponse struct {
+	Year            *int           `json:"year,omitempty"`
+	Total           int            `json:"total"`
+	ByStatus        map[string]int `json:"by_status"`
+	CompletedByType map[string]int `json:"completed_by_type"`
+}
+
+// ========== UseCase DTO → HTTP Response DTO変換 ==========
+
+// ToHTTPLibraryEntryResponse はUseCase DTOをHTTPレスポンス用DTOに変換します
+func (p *LibraryPresenter) ToHTTPLibraryEntryResponse(entryDTO *dto.LibraryEntryResponse) *HTTPLibraryEntryResponse {
+	if entryDTO == nil {
+		return nil
+	}
+
+	return &HTTPLibraryEntryResponse{
+		ID:            entryDTO.ID,
+		WorkID:        entryDTO.WorkID,
+		ContentID:     entryDTO.ContentID,
+		ItemTitle:     entryDTO.ItemTitle,
+		ItemType:      entryDTO.ItemType,
+		Status:        entryDTO.Status,
+		Progress:      entryDTO.Progress,
+		ProgressTotal: entryDTO.ProgressTotal,
+		ProgressUnit:  entryDTO.ProgressUnit,
+		StartedOn:     p.formatDate(entryDTO.StartedOn),
+		FinishedOn:    p.formatDate(entryDTO.FinishedOn),
+		Note:          entryDTO.Note,
+		CreatedAt:     entryDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     entryDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// ToHTTPLibraryEntryResponseList はUseCase DTOリストをHTTPレスポンス用DTOリストに変換します
+func (p *LibraryPresenter) ToHTTPLibraryEntryResponseList(entryDTOs []*dto.LibraryEntryResponse) []*HTTPLibraryEntryResponse {
+	responses := make([]*HTTPLibraryEntryResponse, 0, len(entryDTOs))
+	for _, entryDTO := range entryDTOs {
+		if entryDTO != nil {
+			responses = append(responses, p.ToHTTPLibraryEntryResponse(entryDTO))
+		}
+	}
+	return responses
+}
+
+// ToHTTPLibraryStatsResponse はライブラリ集計DTOをHTTPレスポンス用DTOに変換します
+func (p *LibraryPresenter) ToHTTPLibraryStatsResponse(statsDTO *dto.LibraryStatsResponse) *HTTPLibraryStatsResponse {
+	if statsDTO == nil {
+		return nil
+	}
+
+	return &HTTPLibraryStatsResponse{
+		Year:            statsDTO.Year,
+		Total:           statsDTO.Total,
+		ByStatus:        statsDTO.ByStatus,
+		CompletedByType: statsDTO.CompletedByType,
+	}
+}
+
+// formatDate は日付をYYYY-MM-DD形式に変換します（nilの場合は空文字）
+func (p *LibraryPresenter) formatDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format(dto.LibraryDateLayout)
+}
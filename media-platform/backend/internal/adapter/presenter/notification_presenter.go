@@ -0,0 +1,120 @@
+package presenter
+
+import (
+	"media-platform/internal/usecase/dto"
+)
+
+// NotificationPresenter は通知をHTTPレスポンスDTOに変換します
+type NotificationPresenter struct{}
+
+// NewNotificationPresenter は新しいNotificationPresenterのインスタンスを生成します
+func NewNotificationPresenter() *NotificationPresenter {
+	return &NotificationPresenter{}
+}
+
+// ========== HTTP Response DTO構造体 ==========
+
+// HTTPNotificationResponse はHTTPレスポンス用の通知です
+type HTTPNotificationResponse struct {
+	ID           int64          `json:"id"`
+	Type         string         `json:"type"`
+	Message      string         `json:"message"`
+	ContentID    *int64         `json:"content_id,omitempty"`
+	CommentID    *int64         `json:"comment_id,omitempty"`
+	ContentTitle string         `json:"content_title,omitempty"`
+	Actor        *HTTPUserBrief `json:"actor,omitempty"` // 最後に関わったユーザー
+	ActorCount   int            `json:"actor_count"`     // まとめられたユーザー数
+	IsRead       bool           `json:"is_read"`
+	ReadAt       string         `json:"read_at,omitempty"`
+	CreatedAt    string         `json:"created_at"`
+	UpdatedAt    string         `json:"updated_at"`
+}
+
+// HTTPNotificationListResponse はHTTPレスポンス用の通知一覧です
+type HTTPNotificationListResponse struct {
+	Notifications []*HTTPNotificationResponse `json:"notifications"`
+	UnreadCount   int64                       `json:"unread_count"`
+	TotalCount    int64                       `json:"total_count"`
+	HasMore       bool                        `json:"has_more"`
+}
+
+// HTTPNotificationPreferenceResponse はHTTPレスポンス用のメール通知の設定です
+type HTTPNotificationPreferenceResponse struct {
+	EmailFollow  bool   `json:"email_follow"`
+	EmailReply   bool   `json:"email_reply"`
+	EmailMention bool   `json:"email_mention"`
+	EmailDigest  bool   `json:"email_digest"` // 週間ダイジェスト
+	Locale       string `json:"locale"`       // メールの言語（ja / en）
+	UpdatedAt    string `json:"updated_at"`
+}
+
+// ========== UseCase DTO → HTTP Response DTO変換 ==========
+
+// ToHTTPNotificationResponse は通知DTOをHTTPレスポンス用DTOに変換します
+func (p *NotificationPresenter) ToHTTPNotificationResponse(notificationDTO *dto.NotificationResponse) *HTTPNotificationResponse {
+	if notificationDTO == nil {
+		return nil
+	}
+
+	response := &HTTPNotificationResponse{
+		ID:           notificationDTO.ID,
+		Type:         notificationDTO.Type,
+		Message:      notificationDTO.Message,
+		ContentID:    notificationDTO.ContentID,
+		CommentID:    notificationDTO.CommentID,
+		ContentTitle: notificationDTO.ContentTitle,
+		ActorCount:   notificationDTO.ActorCount,
+		IsRead:       notificationDTO.IsRead,
+		CreatedAt:    notificationDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:    notificationDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if notificationDTO.ReadAt != nil {
+		response.ReadAt = notificationDTO.ReadAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	if notificationDTO.Actor != nil {
+		response.Actor = &HTTPUserBrief{
+			ID:       notificationDTO.Actor.ID,
+			Username: notificationDTO.Actor.Username,
+			Avatar:   notificationDTO.Actor.Avatar,
+		}
+	}
+
+	return response
+}
+
+// ToHTTPNotificationListResponse は通知一覧DTOをHTTPレスポンス用DTOに変換します
+func (p *NotificationPresenter) ToHTTPNotificationListResponse(listDTO *dto.NotificationListResponse) *HTTPNotificationListResponse {
+	if listDTO == nil {
+		return nil
+	}
+
+	notifications := make([]*HTTPNotificationResponse, len(listDTO.Notifications))
+	for i, notificationDTO := range listDTO.Notifications {
+		notifications[i] = p.ToHTTPNotificationResponse(notificationDTO)
+	}
+
+	return &HTTPNotificationListResponse{
+		Notifications: notifications,
+		UnreadCount:   listDTO.UnreadCount,
+		TotalCount:    listDTO.TotalCount,
+		HasMore:       listDTO.HasMore,
+	}
+}
+
+// ToHTTPNotificationPreferenceResponse はメール通知の設定DTOをHTTPレスポンス用DTOに変換します
+func (p *NotificationPresenter) ToHTTPNotificationPreferenceResponse(preferenceDTO *dto.NotificationPreferenceResponse) *HTTPNotificationPreferenceResponse {
+	if preferenceDTO == nil {
+		return nil
+	}
+
+	return &HTTPNotificationPreferenceResponse{
+		EmailFollow:  preferenceDTO.EmailFollow,
+		EmailReply:   preferenceDTO.EmailReply,
+		EmailMention: preferenceDTO.EmailMention,
+		EmailDigest:  preferenceDTO.EmailDigest,
+		Locale:       preferenceDTO.Locale,
+		UpdatedAt:    preferenceDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
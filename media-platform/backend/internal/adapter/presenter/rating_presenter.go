@@ -22,6 +22,8 @@ type HTTPRatingResponse struct {
 	ContentID int64  `json:"content_id"`
 	CreatedAt string `json:"created_at"` // RFC3339形式の文字列
 	UpdatedAt string `json:"updated_at,omitempty"`
+
+	User *HTTPUserBrief `json:"user,omitempty"` // 評価したユーザー（include_user指定時のみ）
 }
 
 // HTTPRatingStatsResponse はHTTPレスポンス用の評価統計です
@@ -29,6 +31,29 @@ type HTTPRatingStatsResponse struct {
 	ContentID int64 `json:"content_id"`
 	LikeCount int   `json:"like_count"`
 	Count     int   `json:"count"`
+
+	StarCount int            `json:"star_count"`
+	Average   *float64       `json:"average"`
+	Histogram map[string]int `json:"histogram"`
+}
+
+// HTTPStarRatingResponse はHTTPレスポンス用の星評価情報です
+type HTTPStarRatingResponse struct {
+	ID        int64  `json:"id"`
+	Stars     int    `json:"stars"`
+	UserID    int64  `json:"user_id"`
+	ContentID int64  `json:"content_id"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// HTTPLikeStateResponse はHTTPレスポンス用のいいね操作後の状態です
+type HTTPLikeStateResponse struct {
+	ContentID int64  `json:"content_id"`
+	Liked     bool   `json:"liked"`
+	RatingID  *int64 `json:"rating_id,omitempty"`
+	LikeCount int64  `json:"like_count"`
+	Changed   bool   `json:"changed"`
 }
 
 // ========== UseCase DTO → HTTP Response DTO変換 ==========
@@ -39,7 +64,7 @@ func (p *RatingPresenter) ToHTTPRatingResponse(ratingDTO *dto.RatingResponse) *H
 		return nil
 	}
 
-	return &HTTPRatingResponse{
+	response := &HTTPRatingResponse{
 		ID:        ratingDTO.ID,
 		Value:     ratingDTO.Value,
 		UserID:    ratingDTO.UserID,
@@ -47,6 +72,31 @@ func (p *RatingPresenter) ToHTTPRatingResponse(ratingDTO *dto.RatingResponse) *H
 		CreatedAt: ratingDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt: ratingDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
+
+	if ratingDTO.User != nil {
+		response.User = &HTTPUserBrief{
+			ID:       ratingDTO.User.ID,
+			Username: ratingDTO.User.Username,
+			Avatar:   ratingDTO.User.Avatar,
+		}
+	}
+
+	return response
+}
+
+// ToHTTPLikeStateResponse はいいね状態のUseCase DTOをHTTPレスポンス用DTOに変換します
+func (p *RatingPresenter) ToHTTPLikeStateResponse(stateDTO *dto.LikeStateResponse) *HTTPLikeStateResponse {
+	if stateDTO == nil {
+		return nil
+	}
+
+	return &HTTPLikeStateResponse{
+		ContentID: stateDTO.ContentID,
+		Liked:     stateDTO.Liked,
+		RatingID:  stateDTO.RatingID,
+		LikeCount: stateDTO.LikeCount,
+		Changed:   stateDTO.Changed,
+	}
 }
 
 // ToHTTPRatingStatsResponse はUseCase統計DTOをHTTPレスポンス用DTOに変換します
@@ -56,6 +106,7 @@ func (p *RatingPresenter) ToHTTPRatingStatsResponse(statsDTO *dto.RatingStatsRes
 			ContentID: 0,
 			LikeCount: 0,
 			Count:     0,
+			Histogram: map[string]int{},
 		}
 	}
 
@@ -63,6 +114,25 @@ func (p *RatingPresenter) ToHTTPRatingStatsResponse(statsDTO *dto.RatingStatsRes
 		ContentID: statsDTO.ContentID,
 		LikeCount: statsDTO.LikeCount,
 		Count:     statsDTO.Count,
+		StarCount: statsDTO.StarCount,
+		Average:   statsDTO.Average,
+		Histogram: statsDTO.Histogram,
+	}
+}
+
+// ToHTTPStarRatingResponse は星評価DTOをHTTPレスポンス用DTOに変換します
+func (p *RatingPresenter) ToHTTPStarRatingResponse(starDTO *dto.StarRatingResponse) *HTTPStarRatingResponse {
+	if starDTO == nil {
+		return nil
+	}
+
+	return &HTTPStarRatingResponse{
+		ID:        starDTO.ID,
+		Stars:     starDTO.Stars,
+		UserID:    starDTO.UserID,
+		ContentID: starDTO.ContentID,
+		CreatedAt: starDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: starDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
 
@@ -0,0 +1,113 @@
+package presenter
+
+import (
+	"media-platform/internal/usecase/dto"
+)
+
+// ReactionPresenter はリアクションをHTTPレスポンスDTOに変換します
+type ReactionPresenter struct{}
+
+// NewReactionPresenter は新しいReactionPresenterのインスタンスを生成します
+func NewReactionPresenter() *ReactionPresenter {
+	return &ReactionPresenter{}
+}
+
+// ========== HTTP Response DTO構造体 ==========
+
+// HTTPReactionKindResponse はHTTPレスポンス用のリアクションの種類です
+type HTTPReactionKindResponse struct {
+	ID        int64  `json:"id"`
+	Key       string `json:"key"`
+	Emoji     string `json:"emoji"`
+	Label     string `json:"label"`
+	SortOrder int    `json:"sort_order"`
+	IsActive  bool   `json:"is_active"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// HTTPReactionCountResponse はHTTPレスポンス用の種類ごとのリアクション数です
+type HTTPReactionCountResponse struct {
+	Key   string `json:"key"`
+	Emoji string `json:"emoji"`
+	Count int    `json:"count"`
+}
+
+// HTTPReactionToggleResponse はHTTPレスポンス用のリアクションのトグル結果です
+type HTTPReactionToggleResponse struct {
+	TargetType string                       `json:"target_type"`
+	TargetID   int64                        `json:"target_id"`
+	Kind       string                       `json:"kind"`
+	Reacted    bool                         `json:"reacted"`
+	Counts     []*HTTPReactionCountResponse `json:"counts"`
+}
+
+// ========== UseCase DTO → HTTP Response DTO変換 ==========
+
+// ToHTTPReactionKindResponse はリアクションの種類DTOをHTTPレスポンス用DTOに変換します
+func (p *ReactionPresenter) ToHTTPReactionKindResponse(kindDTO *dto.ReactionKindResponse) *HTTPReactionKindResponse {
+	if kindDTO == nil {
+		return nil
+	}
+
+	return &HTTPReactionKindResponse{
+		ID:        kindDTO.ID,
+		Key:       kindDTO.Key,
+		Emoji:     kindDTO.Emoji,
+		Label:     kindDTO.Label,
+		SortOrder: kindDTO.SortOrder,
+		IsActive:  kindDTO.IsActive,
+		CreatedAt: kindDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: kindDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// ToHTTPReactionKindResponseList はリアクションの種類DTOリストをHTTPレスポンス用DTOリストに変換します
+func (p *ReactionPresenter) ToHTTPReactionKindResponseList(kindDTOs []*dto.ReactionKindResponse) []*HTTPReactionKindResponse {
+	responses := make([]*HTTPReactionKindResponse, 0, len(kindDTOs))
+	for _, kindDTO := range kindDTOs {
+		if kindDTO != nil {
+			responses = append(responses, p.ToHTTPReactionKindResponse(kindDTO))
+		}
+	}
+	return responses
+}
+
+// ToHTTPReactionToggleResponse はトグル結果DTOをHTTPレスポンス用DTOに変換します
+func (p *ReactionPresenter) ToHTTPReactionToggleResponse(toggleDTO *dto.ReactionToggleResponse) *HTTPReactionToggleResponse {
+	if toggleDTO == nil {
+		return nil
+	}
+
+	return &HTTPReactionToggleResponse{
+		TargetType: toggleDTO.TargetType,
+		TargetID:   toggleDTO.TargetID,
+		Kind:       toggleDTO.Kind,
+		Reacted:    toggleDTO.Reacted,
+		Counts:     p.ToHTTPReactionCountResponseList(toggleDTO.Counts),
+	}
+}
+
+// ToHTTPReactionCountResponseList はリアクション数DTOリストをHTTPレスポンス用DTOリストに変換します
+func (p *ReactionPresenter) ToHTTPReactionCountResponseList(countDTOs []*dto.ReactionCountResponse) []*HTTPReactionCountResponse {
+	responses := make([]*HTTPReactionCountResponse, 0, len(countDTOs))
+	for _, countDTO := range countDTOs {
+		if countDTO != nil {
+			responses = append(responses, &HTTPReactionCountResponse{
+				Key:   countDTO.Key,
+				Emoji: countDTO.Emoji,
+				Count: countDTO.Count,
+			})
+		}
+	}
+	return responses
+}
+
+// ToHTTPReactionCountsMap は対象IDごとのリアクション数DTOをHTTPレスポンス用DTOマップに変換します
+func (p *ReactionPresenter) ToHTTPReactionCountsMap(countsMap map[int64][]*dto.ReactionCountResponse) map[int64][]*HTTPReactionCountResponse {
+	responseMap := make(map[int64][]*HTTPReactionCountResponse, len(countsMap))
+	for targetID, countDTOs := range countsMap {
+		responseMap[targetID] = p.ToHTTPReactionCountResponseList(countDTOs)
+	}
+	return responseMap
+}
@@ -13,14 +13,15 @@ func NewUserPresenter() *UserPresenter {
 // ========== HTTP Response DTO構造体 ==========
 
 type HTTPUserResponse struct {
-	ID        int64  `json:"id"`
-	Username  string `json:"username"`
-	Email     string `json:"email,omitempty"`
-	Bio       string `json:"bio,omitempty"`
-	Avatar    string `json:"avatar,omitempty"`
-	Role      string `json:"role,omitempty"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at,omitempty"`
+	ID         int64             `json:"id"`
+	Username   string            `json:"username"`
+	Email      string            `json:"email,omitempty"`
+	Bio        string            `json:"bio,omitempty"`
+	Avatar     string            `json:"avatar,omitempty"`
+	AvatarURLs map[string]string `json:"avatar_urls,omitempty"`
+	Role       string            `json:"role,omitempty"`
+	CreatedAt  string            `json:"created_at"`
+	UpdatedAt  string            `json:"updated_at,omitempty"`
 }
 
 type HTTPLoginResponse struct {
@@ -29,11 +30,12 @@ type HTTPLoginResponse struct {
 }
 
 type HTTPPublicUserResponse struct {
-	ID        int64  `json:"id"`
-	Username  string `json:"username"`
-	Bio       string `json:"bio,omitempty"`
-	Avatar    string `json:"avatar,omitempty"`
-	CreatedAt string `json:"created_at"`
+	ID         int64             `json:"id"`
+	Username   string            `json:"username"`
+	Bio        string            `json:"bio,omitempty"`
+	Avatar     string            `json:"avatar,omitempty"`
+	AvatarURLs map[string]string `json:"avatar_urls,omitempty"`
+	CreatedAt  string            `json:"created_at"`
 }
 
 // ========== UseCase DTO → HTTP Response DTO変換のみ ==========
@@ -44,14 +46,15 @@ func (p *UserPresenter) ToHTTPUserResponse(appDTO *dto.UserResponse) *HTTPUserRe
 	}
 
 	return &HTTPUserResponse{
-		ID:        appDTO.ID,
-		Username:  appDTO.Username,
-		Email:     appDTO.Email,
-		Bio:       appDTO.Bio,
-		Avatar:    appDTO.Avatar,
-		Role:      appDTO.Role,
-		CreatedAt: appDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt: appDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:         appDTO.ID,
+		Username:   appDTO.Username,
+		Email:      appDTO.Email,
+		Bio:        appDTO.Bio,
+		Avatar:     appDTO.Avatar,
+		AvatarURLs: appDTO.AvatarURLs,
+		Role:       appDTO.Role,
+		CreatedAt:  appDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:  appDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
 
@@ -84,11 +87,12 @@ func (p *UserPresenter) ToHTTPPublicUserResponse(appDTO *dto.UserResponse) *HTTP
 	}
 
 	return &HTTPPublicUserResponse{
-		ID:        appDTO.ID,
-		Username:  appDTO.Username,
-		Bio:       appDTO.Bio,
-		Avatar:    appDTO.Avatar,
-		CreatedAt: appDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:         appDTO.ID,
+		Username:   appDTO.Username,
+		Bio:        appDTO.Bio,
+		Avatar:     appDTO.Avatar,
+		AvatarURLs: appDTO.AvatarURLs,
+		CreatedAt:  appDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
 
@@ -0,0 +1,150 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"media-platform/internal/usecase/dto"
+)
+
+// WebhookPresenter はWebhookと配信ログをHTTPレスポンスDTOに変換します
+type WebhookPresenter struct{}
+
+// NewWebhookPresenter は新しいWebhookPresenterのインスタンスを生成します
+func NewWebhookPresenter() *WebhookPresenter {
+	return &WebhookPresenter{}
+}
+
+// ========== HTTP Response DTO構造体 ==========
+
+// HTTPWebhookResponse はHTTPレスポンス用のWebhookです
+type HTTPWebhookResponse struct {
+	ID          int64    `json:"id"`
+	OwnerID     int64    `json:"owner_id"`
+	URL         string   `json:"url"`
+	Events      []string `json:"events"`
+	Description string   `json:"description"`
+	IsActive    bool     `json:"is_active"`
+	Secret      string   `json:"secret,omitempty"` // 作成時とシークレットの再生成時のみ
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+// HTTPWebhookListResponse はHTTPレスポンス用のWebhook一覧です
+type HTTPWebhookListResponse struct {
+	Webhooks []*HTTPWebhookResponse `json:"webhooks"`
+	Total    int64                  `json:"total"`
+}
+
+// HTTPWebhookDeliveryResponse はHTTPレスポンス用の配信ログです
+type HTTPWebhookDeliveryResponse struct {
+	ID             int64           `json:"id"`
+	WebhookID      int64           `json:"webhook_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Status         string          `json:"status"` // pending / succeeded / dead
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  string          `json:"next_attempt_at,omitempty"`
+	LastAttemptAt  string          `json:"last_attempt_at,omitempty"`
+	ResponseStatus *int            `json:"response_status,omitempty"`
+	ResponseBody   string          `json:"response_body,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	DeliveredAt    string          `json:"delivered_at,omitempty"`
+	CreatedAt      string          `json:"created_at"`
+	Payload        json.RawMessage `json:"payload"`
+}
+
+// HTTPWebhookDeliveryListResponse はHTTPレスポンス用の配信ログ一覧です
+type HTTPWebhookDeliveryListResponse struct {
+	Deliveries []*HTTPWebhookDeliveryResponse `json:"deliveries"`
+	Total      int64                          `json:"total"`
+	HasMore    bool                           `json:"has_more"`
+}
+
+// ========== UseCase DTO → HTTP Response DTO変換 ==========
+
+// ToHTTPWebhookResponse はWebhook DTOをHTTPレスポンス用DTOに変換します
+func (p *WebhookPresenter) ToHTTPWebhookResponse(webhookDTO *dto.WebhookResponse) *HTTPWebhookResponse {
+	if webhookDTO == nil {
+		return nil
+	}
+
+	return &HTTPWebhookResponse{
+		ID:          webhookDTO.ID,
+		OwnerID:     webhookDTO.OwnerID,
+		URL:         webhookDTO.URL,
+		Events:      webhookDTO.Events,
+		Description: webhookDTO.Description,
+		IsActive:    webhookDTO.IsActive,
+		Secret:      webhookDTO.Secret,
+		CreatedAt:   webhookDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   webhookDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// ToHTTPWebhookListResponse はWebhook一覧DTOをHTTPレスポンス用DTOに変換します
+func (p *WebhookPresenter) ToHTTPWebhookListResponse(listDTO *dto.WebhookListResponse) *HTTPWebhookListResponse {
+	if listDTO == nil {
+		return nil
+	}
+
+	webhooks := make([]*HTTPWebhookResponse, len(listDTO.Webhooks))
+	for i, webhookDTO := range listDTO.Webhooks {
+		webhooks[i] = p.ToHTTPWebhookResponse(webhookDTO)
+	}
+
+	return &HTTPWebhookListResponse{
+		Webhooks: webhooks,
+		Total:    listDTO.Total,
+	}
+}
+
+// ToHTTPWebhookDeliveryResponse は配信ログDTOをHTTPレスポンス用DTOに変換します
+func (p *WebhookPresenter) ToHTTPWebhookDeliveryResponse(deliveryDTO *dto.WebhookDeliveryResponse) *HTTPWebhookDeliveryResponse {
+	if deliveryDTO == nil {
+		return nil
+	}
+
+	return &HTTPWebhookDeliveryResponse{
+		ID:             deliveryDTO.ID,
+		WebhookID:      deliveryDTO.WebhookID,
+		EventID:        deliveryDTO.EventID,
+		EventType:      deliveryDTO.EventType,
+		Status:         deliveryDTO.Status,
+		Attempts:       deliveryDTO.Attempts,
+		NextAttemptAt:  formatOptionalTime(deliveryDTO.NextAttemptAt),
+		LastAttemptAt:  formatOptionalTime(deliveryDTO.LastAttemptAt),
+		ResponseStatus: deliveryDTO.ResponseStatus,
+		ResponseBody:   deliveryDTO.ResponseBody,
+		LastError:      deliveryDTO.LastError,
+		DeliveredAt:    formatOptionalTime(deliveryDTO.DeliveredAt),
+		CreatedAt:      deliveryDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Payload:        deliveryDTO.Payload,
+	}
+}
+
+// ToHTTPWebhookDeliveryListResponse は配信ログ一覧DTOをHTTPレスポンス用DTOに変換します
+func (p *WebhookPresenter) ToHTTPWebhookDeliveryListResponse(listDTO *dto.WebhookDeliveryListResponse) *HTTPWebhookDeliveryListResponse {
+	if listDTO == nil {
+		return nil
+	}
+
+	deliveries := make([]*HTTPWebhookDeliveryResponse, len(listDTO.Deliveries))
+	for i, deliveryDTO := range listDTO.Deliveries {
+		deliveries[i] = p.ToHTTPWebhookDeliveryResponse(deliveryDTO)
+	}
+
+	return &HTTPWebhookDeliveryListResponse{
+		Deliveries: deliveries,
+		Total:      listDTO.Total,
+		HasMore:    listDTO.HasMore,
+	}
+}
+
+// formatOptionalTime は日時をRFC3339形式に変換します（nilの場合は空文字）
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02T15:04:05Z07:00")
+}
@@ -0,0 +1,176 @@
+package presenter
+
+import (
+	"media-platform/internal/usecase/dto"
+)
+
+// WorkPresenter は作品をHTTPレスポンスDTOに変換します
+type WorkPresenter struct {
+	contentPresenter *ContentPresenter
+}
+
+// NewWorkPresenter は新しいWorkPresenterのインスタンスを生成します
+func NewWorkPresenter() *WorkPresenter {
+	return &WorkPresenter{
+		contentPresenter: NewContentPresenter(),
+	}
+}
+
+// ========== HTTP Response DTO構造体 ==========
+
+// HTTPWorkResponse はHTTPレスポンス用の作品情報です
+type HTTPWorkResponse struct {
+	ID              int64             `json:"id"`
+	Type            string            `json:"type"`
+	Title           string            `json:"title"`
+	AlternateTitles []string          `json:"alternate_titles"`
+	Creator         string            `json:"creator,omitempty"`
+	ReleaseYear     *int              `json:"release_year,omitempty"`
+	ExternalIDs     map[string]string `json:"external_ids"`
+	CreatedAt       string            `json:"created_at"`
+	UpdatedAt       string            `json:"updated_at,omitempty"`
+}
+
+// HTTPWorkReviewStatsResponse はHTTPレスポンス用の作品レビュー集計です
+type HTTPWorkReviewStatsResponse struct {
+	ReviewCount                int            `json:"review_count"`
+	ScoredCount                int            `json:"scored_count"`
+	AverageScore               *float64       `json:"average_score"`
+	RecommendationDistribution map[string]int `json:"recommendation_distribution"`
+
+	AxisStats []*HTTPAxisScoreStatsResponse `json:"axis_stats"`
+	Radar     *HTTPRadarChartResponse       `json:"radar,omitempty"`
+}
+
+// HTTPAxisScoreStatsResponse はHTTPレスポンス用の軸別スコア集計です
+type HTTPAxisScoreStatsResponse struct {
+	Key          string         `json:"key"`
+	Label        string         `json:"label"`
+	Count        int            `json:"count"`
+	Average      *float64       `json:"average"`
+	Distribution map[string]int `json:"distribution"`
+}
+
+// HTTPWorkDetailResponse はHTTPレスポンス用の作品詳細です
+type HTTPWorkDetailResponse struct {
+	Work    *HTTPWorkResponse            `json:"work"`
+	Stats   *HTTPWorkReviewStatsResponse `json:"stats"`
+	Reviews []*HTTPContentResponse       `json:"reviews"`
+}
+
+// HTTPWorkCandidateResponse はHTTPレスポンス用の外部メタデータ候補です
+type HTTPWorkCandidateResponse struct {
+	Source      string            `json:"source"`
+	SourceID    string            `json:"source_id"`
+	Type        string            `json:"type"`
+	Title       string            `json:"title"`
+	Creator     string            `json:"creator,omitempty"`
+	ReleaseYear *int              `json:"release_year,omitempty"`
+	CoverURL    string            `json:"cover_url,omitempty"`
+	ExternalIDs map[string]string `json:"external_ids"`
+}
+
+// HTTPWorkLookupResponse はHTTPレスポンス用の作品ルックアップ結果です
+type HTTPWorkLookupResponse struct {
+	Query      string                       `json:"query"`
+	Cached     bool                         `json:"cached"`
+	Works      []*HTTPWorkResponse          `json:"works"`
+	Candidates []*HTTPWorkCandidateResponse `json:"candidates"`
+}
+
+// ========== UseCase DTO → HTTP Response DTO変換 ==========
+
+// ToHTTPWorkResponse はUseCase DTOをHTTPレスポンス用DTOに変換します
+func (p *WorkPresenter) ToHTTPWorkResponse(workDTO *dto.WorkResponse) *HTTPWorkResponse {
+	if workDTO == nil {
+		return nil
+	}
+
+	return &HTTPWorkResponse{
+		ID:              workDTO.ID,
+		Type:            workDTO.Type,
+		Title:           workDTO.Title,
+		AlternateTitles: workDTO.AlternateTitles,
+		Creator:         workDTO.Creator,
+		ReleaseYear:     workDTO.ReleaseYear,
+		ExternalIDs:     workDTO.ExternalIDs,
+		CreatedAt:       workDTO.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:       workDTO.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// ToHTTPWorkResponseList はUseCase DTOリストをHTTPレスポンス用DTOリストに変換します
+func (p *WorkPresenter) ToHTTPWorkResponseList(workDTOs []*dto.WorkResponse) []*HTTPWorkResponse {
+	responses := make([]*HTTPWorkResponse, 0, len(workDTOs))
+	for _, workDTO := range workDTOs {
+		if workDTO != nil {
+			responses = append(responses, p.ToHTTPWorkResponse(workDTO))
+		}
+	}
+	return responses
+}
+
+// ToHTTPWorkDetailResponse は作品詳細DTOをHTTPレスポンス用DTOに変換します
+func (p *WorkPresenter) ToHTTPWorkDetailResponse(detailDTO *dto.WorkDetailResponse) *HTTPWorkDetailResponse {
+	if detailDTO == nil {
+		return nil
+	}
+
+	response := &HTTPWorkDetailResponse{
+		Work:    p.ToHTTPWorkResponse(detailDTO.Work),
+		Reviews: p.contentPresenter.ToHTTPContentResponseList(detailDTO.Reviews),
+	}
+
+	if detailDTO.Stats != nil {
+		response.Stats = &HTTPWorkReviewStatsResponse{
+			ReviewCount:                detailDTO.Stats.ReviewCount,
+			ScoredCount:                detailDTO.Stats.ScoredCount,
+			AverageScore:               detailDTO.Stats.AverageScore,
+			RecommendationDistribution: detailDTO.Stats.RecommendationDistribution,
+			AxisStats:                  make([]*HTTPAxisScoreStatsResponse, 0, len(detailDTO.Stats.AxisStats)),
+			Radar:                      toHTTPRadarChartResponse(detailDTO.Stats.Radar),
+		}
+		for _, axisStat := range detailDTO.Stats.AxisStats {
+			response.Stats.AxisStats = append(response.Stats.AxisStats, &HTTPAxisScoreStatsResponse{
+				Key:          axisStat.Key,
+				Label:        axisStat.Label,
+				Count:        axisStat.Count,
+				Average:      axisStat.Average,
+				Distribution: axisStat.Distribution,
+			})
+		}
+	}
+
+	return response
+}
+
+// ToHTTPWorkLookupResponse は作品ルックアップ結果をHTTPレスポンス用DTOに変換します
+func (p *WorkPresenter) ToHTTPWorkLookupResponse(lookupDTO *dto.WorkLookupResponse) *HTTPWorkLookupResponse {
+	if lookupDTO == nil {
+		return nil
+	}
+
+	candidates := make([]*HTTPWorkCandidateResponse, 0, len(lookupDTO.Candidates))
+	for _, candidate := range lookupDTO.Candidates {
+		if candidate == nil {
+			continue
+		}
+		candidates = append(candidates, &HTTPWorkCandidateResponse{
+			Source:      candidate.Source,
+			SourceID:    candidate.SourceID,
+			Type:        candidate.Type,
+			Title:       candidate.Title,
+			Creator:     candidate.Creator,
+			ReleaseYear: candidate.ReleaseYear,
+			CoverURL:    candidate.CoverURL,
+			ExternalIDs: candidate.ExternalIDs,
+		})
+	}
+
+	return &HTTPWorkLookupResponse{
+		Query:      lookupDTO.Query,
+		Cached:     lookupDTO.Cached,
+		Works:      p.ToHTTPWorkResponseList(lookupDTO.Works),
+		Candidates: candidates,
+	}
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+
+	"github.com/lib/pq"
+)
+
+type annotationRepository struct {
+	db *sql.DB
+}
+
+// NewAnnotationRepository はAnnotationRepositoryを作成します
+func NewAnnotationRepository(db *sql.DB) repository.AnnotationRepository {
+	return &annotationRepository{db: db}
+}
+
+const annotationColumns = `id, content_id, user_id, body, block_index, quote_exact, quote_prefix, quote_suffix, is_orphaned, created_at, updated_at`
+
+func scanAnnotation(row rowScanner) (*entity.Annotation, error) {
+	annotation := &entity.Annotation{}
+	err := row.Scan(
+		&annotation.ID,
+		&annotation.ContentID,
+		&annotation.UserID,
+		&annotation.Body,
+		&annotation.BlockIndex,
+		&annotation.Quote.Exact,
+		&annotation.Quote.Prefix,
+		&annotation.Quote.Suffix,
+		&annotation.IsOrphaned,
+		&annotation.CreatedAt,
+		&annotation.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return annotation, nil
+}
+
+// Find は指定したIDの注釈を取得します
+func (r *annotationRepository) Find(ctx context.Context, id int64) (*entity.Annotation, error) {
+	query := `SELECT ` + annotationColumns + ` FROM annotations WHERE id = $1`
+
+	annotation, err := scanAnnotation(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.NewNotFoundError("annotation", id)
+		}
+		return nil, fmt.Errorf("failed to find annotation: %w", err)
+	}
+
+	return annotation, nil
+}
+
+// FindByContent はコンテンツの注釈を段落順・作成順に取得します（includeOrphanedがfalseの場合は孤立した注釈を除く）
+func (r *annotationRepository) FindByContent(ctx context.Context, contentID int64, includeOrphaned bool) ([]*entity.Annotation, error) {
+	query := `
+		SELECT ` + annotationColumns + `
+		FROM annotations
+		WHERE content_id = $1 AND ($2 OR is_orphaned = FALSE)
+		ORDER BY is_orphaned, block_index, created_at, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, contentID, includeOrphaned)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query annotations: %w", err)
+	}
+	defer rows.Close()
+
+	annotations := []*entity.Annotation{}
+	for rows.Next() {
+		annotation, err := scanAnnotation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan annotation: %w", err)
+		}
+		annotations = append(annotations, annotation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return annotations, nil
+}
+
+// Create は新しい注釈を作成します
+func (r *annotationRepository) Create(ctx context.Context, annotation *entity.Annotation) error {
+	query := `
+		INSERT INTO annotations (content_id, user_id, body, block_index, quote_exact, quote_prefix, quote_suffix, is_orphaned, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		annotation.ContentID,
+		annotation.UserID,
+		annotation.Body,
+		annotation.BlockIndex,
+		annotation.Quote.Exact,
+		annotation.Quote.Prefix,
+		annotation.Quote.Suffix,
+		annotation.IsOrphaned,
+		annotation.CreatedAt,
+		annotation.UpdatedAt,
+	).Scan(&annotation.ID)
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" { // foreign_key_violation
+			return domainErrors.NewValidationError("注釈の対象が存在しません")
+		}
+		return fmt.Errorf("failed to create annotation: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateBody は注釈の本文を更新します
+func (r *annotationRepository) UpdateBody(ctx context.Context, annotation *entity.Annotation) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE annotations SET body = $2, updated_at = $3 WHERE id = $1`,
+		annotation.ID, annotation.Body, annotation.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update annotation: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("annotation", annotation.ID)
+	}
+
+	return nil
+}
+
+// UpdateAnchors は複数の注釈の段落の位置と孤立状態をまとめて更新します
+func (r *annotationRepository) UpdateAnchors(ctx context.Context, annotations []*entity.Annotation) error {
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `UPDATE annotations SET block_index = $2, is_orphaned = $3 WHERE id = $1`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare annotation anchor update: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, annotation := range annotations {
+		if _, err := stmt.ExecContext(ctx, annotation.ID, annotation.BlockIndex, annotation.IsOrphaned); err != nil {
+			return fmt.Errorf("failed to update annotation anchor: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit annotation anchors: %w", err)
+	}
+
+	return nil
+}
+
+// Delete は指定したIDの注釈を削除します
+func (r *annotationRepository) Delete(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM annotations WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete annotation: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("annotation", id)
+	}
+
+	return nil
+}
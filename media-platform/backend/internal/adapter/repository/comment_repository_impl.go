@@ -8,6 +8,8 @@ import (
 	"media-platform/internal/domain/entity"
 	domainErrors "media-platform/internal/domain/errors"
 	"media-platform/internal/domain/repository"
+
+	"github.com/lib/pq"
 )
 
 type CommentRepositoryImpl struct {
@@ -29,17 +31,40 @@ type CommentQuery struct {
 	Offset    int
 }
 
-func (r *CommentRepositoryImpl) Find(ctx context.Context, id int64) (*entity.Comment, error) {
-	query := `
-		SELECT id, body, user_id, content_id, parent_id, created_at, updated_at
-		FROM comments
-		WHERE id = $1
-	`
+// commentColumns はコメントの取得クエリで共通して使用するカラムです（scanCommentと順序を揃えること）
+const commentColumns = `c.id, c.body, c.user_id, c.content_id, c.parent_id, c.created_at, c.updated_at, c.is_pinned,
+	(SELECT COUNT(*) FROM reactions rx WHERE rx.comment_id = c.id) AS reaction_count, c.deleted_at, c.edited_at, c.is_pending`
 
+// commentVisibleClause は承認待ちのコメントを閲覧者に応じて絞り込む条件です
+// userArgは閲覧者のユーザーID、moderatorArgはすべての承認待ちのコメントを表示するかのプレースホルダー番号です
+func commentVisibleClause(alias string, userArg, moderatorArg int) string {
+	return fmt.Sprintf("(NOT %[1]s.is_pending OR $%[3]d::boolean OR %[1]s.user_id = $%[2]d)", alias, userArg, moderatorArg)
+}
+
+// commentOrderClauses は並び順ごとのORDER BY句です
+var commentOrderClauses = map[entity.CommentSort]string{
+	entity.CommentSortNewest: "c.created_at DESC, c.id DESC",
+	entity.CommentSortOldest: "c.created_at ASC, c.id ASC",
+	entity.CommentSortTop:    "reaction_count DESC, c.created_at DESC, c.id DESC",
+	entity.CommentSortControversial: `(SELECT COUNT(*) FROM comments rp WHERE rp.parent_id = c.id)::float8
+		/ ((SELECT COUNT(*) FROM reactions rx WHERE rx.comment_id = c.id) + 1) DESC, c.created_at DESC, c.id DESC`,
+}
+
+// commentOrderClause は並び順に対応するORDER BY句を返します（不明な並び順の場合はdefaultSortを使用）
+func commentOrderClause(sort, defaultSort entity.CommentSort) string {
+	if clause, ok := commentOrderClauses[sort]; ok {
+		return clause
+	}
+	return commentOrderClauses[defaultSort]
+}
+
+// scanComment はcommentColumnsの順序で1行を読み取ります
+func scanComment(row rowScanner) (*entity.Comment, error) {
 	var comment entity.Comment
 	var parentID sql.NullInt64
+	var deletedAt, editedAt sql.NullTime
 
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := row.Scan(
 		&comment.ID,
 		&comment.Body,
 		&comment.UserID,
@@ -47,33 +72,32 @@ func (r *CommentRepositoryImpl) Find(ctx context.Context, id int64) (*entity.Com
 		&parentID,
 		&comment.CreatedAt,
 		&comment.UpdatedAt,
+		&comment.IsPinned,
+		&comment.ReactionCount,
+		&deletedAt,
+		&editedAt,
+		&comment.IsPending,
 	)
-
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, domainErrors.NewNotFoundError("comment", id)
-		}
-		return nil, fmt.Errorf("failed to find comment: %w", err)
+		return nil, err
 	}
 
 	if parentID.Valid {
 		comment.ParentID = &parentID.Int64
 	}
+	if deletedAt.Valid {
+		comment.DeletedAt = &deletedAt.Time
+	}
+	if editedAt.Valid {
+		comment.EditedAt = &editedAt.Time
+	}
 
 	return &comment, nil
 }
 
-// シンプルなメソッドに分割（複雑なクエリビルダーを削除）
-func (r *CommentRepositoryImpl) FindByContent(ctx context.Context, contentID int64, limit, offset int) ([]*entity.Comment, error) {
-	query := `
-		SELECT id, body, user_id, content_id, parent_id, created_at, updated_at
-		FROM comments
-		WHERE content_id = $1 AND parent_id IS NULL
-		ORDER BY created_at DESC
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.QueryContext(ctx, query, contentID, limit, offset)
+// queryComments はコメント一覧を取得します
+func (r *CommentRepositoryImpl) queryComments(ctx context.Context, query string, args ...interface{}) ([]*entity.Comment, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query comments: %w", err)
 	}
@@ -81,27 +105,11 @@ func (r *CommentRepositoryImpl) FindByContent(ctx context.Context, contentID int
 
 	var comments []*entity.Comment
 	for rows.Next() {
-		var comment entity.Comment
-		var parentID sql.NullInt64
-
-		err := rows.Scan(
-			&comment.ID,
-			&comment.Body,
-			&comment.UserID,
-			&comment.ContentID,
-			&parentID,
-			&comment.CreatedAt,
-			&comment.UpdatedAt,
-		)
+		comment, err := scanComment(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan comment: %w", err)
 		}
-
-		if parentID.Valid {
-			comment.ParentID = &parentID.Int64
-		}
-
-		comments = append(comments, &comment)
+		comments = append(comments, comment)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -111,104 +119,262 @@ func (r *CommentRepositoryImpl) FindByContent(ctx context.Context, contentID int
 	return comments, nil
 }
 
+func (r *CommentRepositoryImpl) Find(ctx context.Context, id int64) (*entity.Comment, error) {
+	query := `SELECT ` + commentColumns + ` FROM comments c WHERE c.id = $1`
+
+	comment, err := scanComment(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.NewNotFoundError("comment", id)
+		}
+		return nil, fmt.Errorf("failed to find comment: %w", err)
+	}
+
+	return comment, nil
+}
+
+func (r *CommentRepositoryImpl) FindByContent(ctx context.Context, contentID int64, viewer entity.CommentViewer, sort entity.CommentSort, limit, offset int) ([]*entity.Comment, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM comments c
+		WHERE c.content_id = $1 AND c.parent_id IS NULL AND %s
+		ORDER BY c.is_pinned DESC, %s
+		LIMIT $2 OFFSET $3
+	`, commentColumns, commentVisibleClause("c", 4, 5), commentOrderClause(sort, entity.CommentSortNewest))
+
+	return r.queryComments(ctx, query, contentID, limit, offset, viewer.UserID, viewer.CanModerate)
+}
+
+func (r *CommentRepositoryImpl) FindPending(ctx context.Context, contentID int64, limit, offset int) ([]*entity.Comment, error) {
+	query := `
+		SELECT ` + commentColumns + `
+		FROM comments c
+		WHERE c.content_id = $1 AND c.is_pending
+		ORDER BY c.created_at ASC, c.id ASC
+		LIMIT $2 OFFSET $3
+	`
+
+	return r.queryComments(ctx, query, contentID, limit, offset)
+}
+
 func (r *CommentRepositoryImpl) FindByUser(ctx context.Context, userID int64, limit, offset int) ([]*entity.Comment, error) {
 	query := `
-		SELECT id, body, user_id, content_id, parent_id, created_at, updated_at
-		FROM comments
-		WHERE user_id = $1
-		ORDER BY created_at DESC
+		SELECT ` + commentColumns + `
+		FROM comments c
+		WHERE c.user_id = $1 AND c.deleted_at IS NULL AND NOT c.is_pending
+		ORDER BY c.created_at DESC
 		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
+	return r.queryComments(ctx, query, userID, limit, offset)
+}
+
+func (r *CommentRepositoryImpl) FindReplies(ctx context.Context, parentID int64, viewer entity.CommentViewer, sort entity.CommentSort, limit, offset int) ([]*entity.Comment, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM comments c
+		WHERE c.parent_id = $1 AND %s
+		ORDER BY %s
+		LIMIT $2 OFFSET $3
+	`, commentColumns, commentVisibleClause("c", 4, 5), commentOrderClause(sort, entity.CommentSortOldest))
+
+	return r.queryComments(ctx, query, parentID, limit, offset, viewer.UserID, viewer.CanModerate)
+}
+
+func (r *CommentRepositoryImpl) SetPinned(ctx context.Context, contentID int64, commentID *int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query comments by user: %w", err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	defer rows.Close()
+	defer tx.Rollback()
 
-	var comments []*entity.Comment
-	for rows.Next() {
-		var comment entity.Comment
-		var parentID sql.NullInt64
+	// 既存のピン留めを解除してから新しいコメントを固定する（1コンテンツ1件まで）
+	if _, err := tx.ExecContext(ctx, `UPDATE comments SET is_pinned = FALSE WHERE content_id = $1 AND is_pinned`, contentID); err != nil {
+		return fmt.Errorf("failed to unpin comments: %w", err)
+	}
 
-		err := rows.Scan(
-			&comment.ID,
-			&comment.Body,
-			&comment.UserID,
-			&comment.ContentID,
-			&parentID,
-			&comment.CreatedAt,
-			&comment.UpdatedAt,
+	if commentID != nil {
+		result, err := tx.ExecContext(ctx,
+			`UPDATE comments SET is_pinned = TRUE WHERE id = $1 AND content_id = $2 AND parent_id IS NULL`,
+			*commentID, contentID,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan comment: %w", err)
+			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" { // unique_violation
+				return domainErrors.NewConflictError("comment", "another comment was pinned concurrently")
+			}
+			return fmt.Errorf("failed to pin comment: %w", err)
 		}
 
-		if parentID.Valid {
-			comment.ParentID = &parentID.Int64
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return domainErrors.NewNotFoundError("comment", *commentID)
 		}
-
-		comments = append(comments, &comment)
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("row iteration error: %w", err)
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit comment pin: %w", err)
 	}
 
-	return comments, nil
+	return nil
 }
 
-func (r *CommentRepositoryImpl) FindReplies(ctx context.Context, parentID int64, limit, offset int) ([]*entity.Comment, error) {
-	query := `
-		SELECT id, body, user_id, content_id, parent_id, created_at, updated_at
-		FROM comments
-		WHERE parent_id = $1
-		ORDER BY created_at ASC
-		LIMIT $2 OFFSET $3
-	`
+// commentTreeQuery はコメントツリーを再帰CTEで取得するクエリです
+// %s には起点の階層を取得する条件と並び順が入ります（ルートコメントは新着順、返信は古い順）
+// 各コメントの返信はLATERALで古い順にReplyLimit件まで取得し、MaxDepthの階層で打ち切ります
+// 承認待ちのコメントは閲覧者（$7: ユーザーID, $8: 投稿者・管理者か）に応じて除外します
+const commentTreeQuery = `
+	WITH RECURSIVE tree AS (
+		(
+			SELECT c.id, c.body, c.user_id, c.content_id, c.parent_id, c.created_at, c.updated_at, c.deleted_at, c.edited_at, c.is_pending, 0 AS depth
+			FROM comments c
+			WHERE %s
+			LIMIT $2
+		)
+		UNION ALL
+		SELECT ch.id, ch.body, ch.user_id, ch.content_id, ch.parent_id, ch.created_at, ch.updated_at, ch.deleted_at, ch.edited_at, ch.is_pending, t.depth + 1
+		FROM tree t
+		CROSS JOIN LATERAL (
+			SELECT c.id, c.body, c.user_id, c.content_id, c.parent_id, c.created_at, c.updated_at, c.deleted_at, c.edited_at, c.is_pending
+			FROM comments c
+			WHERE c.parent_id = t.id
+				AND (NOT c.is_pending OR $8::boolean OR c.user_id = $7)
+			ORDER BY c.created_at ASC, c.id ASC
+			LIMIT $3
+		) ch
+		WHERE t.depth < $4
+	)
+	SELECT
+		t.id, t.body, t.user_id, t.content_id, t.parent_id, t.created_at, t.updated_at, t.deleted_at, t.edited_at, t.is_pending, t.depth,
+		(
+			SELECT COUNT(*) FROM comments r
+			WHERE r.parent_id = t.id AND (NOT r.is_pending OR $8::boolean OR r.user_id = $7)
+		) AS reply_count,
+		u.username, u.avatar
+	FROM tree t
+	LEFT JOIN users u ON u.id = t.user_id
+	ORDER BY t.depth, t.created_at, t.id
+`
+
+// 起点の階層を取得する条件（$5, $6 は続きを取得する位置）
+const (
+	commentTreeRootAnchor = `c.content_id = $1 AND c.parent_id IS NULL
+				AND (NOT c.is_pending OR $8::boolean OR c.user_id = $7)
+				AND ($5::timestamptz IS NULL OR (c.created_at, c.id) < ($5::timestamptz, $6::bigint))
+			ORDER BY c.created_at DESC, c.id DESC`
+	commentTreeReplyAnchor = `c.parent_id = $1
+				AND (NOT c.is_pending OR $8::boolean OR c.user_id = $7)
+				AND ($5::timestamptz IS NULL OR (c.created_at, c.id) > ($5::timestamptz, $6::bigint))
+			ORDER BY c.created_at ASC, c.id ASC`
+)
+
+func (r *CommentRepositoryImpl) FindTree(ctx context.Context, query entity.CommentTreeQuery) ([]*entity.CommentNode, error) {
+	anchor := commentTreeRootAnchor
+	anchorID := query.ContentID
+	if query.ParentID != nil {
+		anchor = commentTreeReplyAnchor
+		anchorID = *query.ParentID
+	}
+
+	var afterTime sql.NullTime
+	var afterID sql.NullInt64
+	if query.After != nil {
+		afterTime = sql.NullTime{Time: query.After.CreatedAt, Valid: true}
+		afterID = sql.NullInt64{Int64: query.After.ID, Valid: true}
+	}
 
-	rows, err := r.db.QueryContext(ctx, query, parentID, limit, offset)
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(commentTreeQuery, anchor),
+		anchorID, query.RootLimit, query.ReplyLimit, query.MaxDepth, afterTime, afterID,
+		query.Viewer.UserID, query.Viewer.CanModerate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query replies: %w", err)
+		return nil, fmt.Errorf("failed to query comment tree: %w", err)
 	}
 	defer rows.Close()
 
-	var comments []*entity.Comment
+	nodes := []*entity.CommentNode{}
 	for rows.Next() {
 		var comment entity.Comment
-		var dbParentID sql.NullInt64
+		var parentID sql.NullInt64
+		var deletedAt, editedAt sql.NullTime
+		var username, avatar sql.NullString
+		node := &entity.CommentNode{Comment: &comment}
 
 		err := rows.Scan(
 			&comment.ID,
 			&comment.Body,
 			&comment.UserID,
 			&comment.ContentID,
-			&dbParentID,
+			&parentID,
 			&comment.CreatedAt,
 			&comment.UpdatedAt,
+			&deletedAt,
+			&editedAt,
+			&comment.IsPending,
+			&node.Depth,
+			&node.ReplyCount,
+			&username,
+			&avatar,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan reply: %w", err)
+			return nil, fmt.Errorf("failed to scan comment tree node: %w", err)
 		}
 
-		if dbParentID.Valid {
-			comment.ParentID = &dbParentID.Int64
+		if parentID.Valid {
+			comment.ParentID = &parentID.Int64
+		}
+		if deletedAt.Valid {
+			comment.DeletedAt = &deletedAt.Time
+		}
+		if editedAt.Valid {
+			comment.EditedAt = &editedAt.Time
+		}
+		if username.Valid {
+			node.Author = &entity.CommentAuthor{
+				ID:       comment.UserID,
+				Username: username.String,
+				Avatar:   avatar.String,
+			}
 		}
 
-		comments = append(comments, &comment)
+		nodes = append(nodes, node)
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, fmt.Errorf("row iteration error: %w", err)
 	}
 
-	return comments, nil
+	return nodes, nil
+}
+
+func (r *CommentRepositoryImpl) FindDepth(ctx context.Context, id int64) (int, error) {
+	query := `
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id, 0 AS depth FROM comments WHERE id = $1
+			UNION ALL
+			SELECT c.id, c.parent_id, a.depth + 1
+			FROM comments c
+			JOIN ancestors a ON c.id = a.parent_id
+		)
+		SELECT MAX(depth) FROM ancestors
+	`
+
+	var depth sql.NullInt64
+	if err := r.db.QueryRowContext(ctx, query, id).Scan(&depth); err != nil {
+		return 0, fmt.Errorf("failed to find comment depth: %w", err)
+	}
+	if !depth.Valid {
+		return 0, domainErrors.NewNotFoundError("comment", id)
+	}
+
+	return int(depth.Int64), nil
 }
 
 func (r *CommentRepositoryImpl) Create(ctx context.Context, comment *entity.Comment) error {
 	query := `
-		INSERT INTO comments (body, user_id, content_id, parent_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO comments (body, user_id, content_id, parent_id, created_at, updated_at, is_pending)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		RETURNING id
 	`
 
@@ -218,13 +384,14 @@ func (r *CommentRepositoryImpl) Create(ctx context.Context, comment *entity.Comm
 		parentID.Valid = true
 	}
 
-	err := r.db.QueryRowContext(ctx, query,
+	err := executorFrom(ctx, r.db).QueryRowContext(ctx, query,
 		comment.Body,
 		comment.UserID,
 		comment.ContentID,
 		parentID,
 		comment.CreatedAt,
 		comment.UpdatedAt,
+		comment.IsPending,
 	).Scan(&comment.ID)
 
 	if err != nil {
@@ -234,34 +401,111 @@ func (r *CommentRepositoryImpl) Create(ctx context.Context, comment *entity.Comm
 	return nil
 }
 
-func (r *CommentRepositoryImpl) Update(ctx context.Context, comment *entity.Comment) error {
+func (r *CommentRepositoryImpl) Update(ctx context.Context, comment *entity.Comment, editorID int64) error {
+	// 編集前の本文の保存と更新を1つのクエリで行う（本文が変わらない場合は履歴を残さない）
 	query := `
-		UPDATE comments
-		SET body = $1, updated_at = $2
-		WHERE id = $3
+		WITH old AS (
+			SELECT id, body FROM comments
+			WHERE id = $3 AND deleted_at IS NULL
+			FOR UPDATE
+		),
+		revision AS (
+			INSERT INTO comment_revisions (comment_id, body, editor_id, created_at)
+			SELECT id, body, $4, $2 FROM old WHERE body <> $1
+			RETURNING comment_id
+		)
+		UPDATE comments c
+		SET body = $1,
+			updated_at = $2,
+			edited_at = CASE WHEN EXISTS (SELECT 1 FROM revision) THEN $2 ELSE c.edited_at END
+		FROM old
+		WHERE c.id = old.id
+		RETURNING c.edited_at
 	`
 
-	result, err := r.db.ExecContext(ctx, query,
+	var editedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query,
 		comment.Body,
 		comment.UpdatedAt,
 		comment.ID,
-	)
+		editorID,
+	).Scan(&editedAt)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return domainErrors.NewNotFoundError("comment", comment.ID)
+		}
 		return fmt.Errorf("failed to update comment: %w", err)
 	}
 
+	if editedAt.Valid {
+		comment.EditedAt = &editedAt.Time
+	}
+
+	return nil
+}
+
+func (r *CommentRepositoryImpl) Approve(ctx context.Context, id int64) error {
+	result, err := executorFrom(ctx, r.db).ExecContext(ctx,
+		`UPDATE comments SET is_pending = FALSE, updated_at = NOW() WHERE id = $1 AND is_pending`, id)
+	if err != nil {
+		return fmt.Errorf("failed to approve comment: %w", err)
+	}
+
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 
 	if rowsAffected == 0 {
-		return domainErrors.NewNotFoundError("comment", comment.ID)
+		return domainErrors.NewNotFoundError("comment", id)
 	}
 
 	return nil
 }
 
+func (r *CommentRepositoryImpl) FindRevisions(ctx context.Context, commentID int64) ([]*entity.CommentRevision, error) {
+	query := `
+		SELECT id, comment_id, body, editor_id, created_at
+		FROM comment_revisions
+		WHERE comment_id = $1
+		ORDER BY created_at DESC, id DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query comment revisions: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := []*entity.CommentRevision{}
+	for rows.Next() {
+		revision := &entity.CommentRevision{}
+		var editorID sql.NullInt64
+
+		err := rows.Scan(
+			&revision.ID,
+			&revision.CommentID,
+			&revision.Body,
+			&editorID,
+			&revision.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan comment revision: %w", err)
+		}
+
+		if editorID.Valid {
+			revision.EditorID = &editorID.Int64
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return revisions, nil
+}
+
 func (r *CommentRepositoryImpl) Delete(ctx context.Context, id int64) error {
 	query := `DELETE FROM comments WHERE id = $1`
 
@@ -282,11 +526,116 @@ func (r *CommentRepositoryImpl) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (r *CommentRepositoryImpl) CountByContent(ctx context.Context, contentID int64) (int64, error) {
-	query := `SELECT COUNT(*) FROM comments WHERE content_id = $1`
+func (r *CommentRepositoryImpl) DeleteOrTombstone(ctx context.Context, id int64) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 返信の追加と競合しないよう対象行をロックする
+	var parentID sql.NullInt64
+	err = tx.QueryRowContext(ctx, `
+		SELECT parent_id FROM comments
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
+	`, id).Scan(&parentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, domainErrors.NewNotFoundError("comment", id)
+		}
+		return false, fmt.Errorf("failed to lock comment: %w", err)
+	}
+
+	// ロック取得後に確認することで、ロック待ちの間に追加された返信も対象にする
+	var hasReplies bool
+	err = tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM comments WHERE parent_id = $1)`, id).Scan(&hasReplies)
+	if err != nil {
+		return false, fmt.Errorf("failed to check replies: %w", err)
+	}
+
+	// 返信がある場合は墓標にする
+
+	if hasReplies {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE comments
+			SET body = '', is_pinned = FALSE, deleted_at = NOW(), updated_at = NOW()
+			WHERE id = $1
+		`, id)
+		if err != nil {
+			return false, fmt.Errorf("failed to tombstone comment: %w", err)
+		}
+
+		// 削除された本文が履歴から読めないよう編集履歴も削除する
+		if _, err := tx.ExecContext(ctx, `DELETE FROM comment_revisions WHERE comment_id = $1`, id); err != nil {
+			return false, fmt.Errorf("failed to delete comment revisions: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM mentions WHERE comment_id = $1`, id); err != nil {
+			return false, fmt.Errorf("failed to delete comment mentions: %w", err)
+		}
+	} else {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM comments WHERE id = $1`, id); err != nil {
+			return false, fmt.Errorf("failed to delete comment: %w", err)
+		}
+
+		// 返信がなくなった祖先の墓標を親から順に削除する
+		for parentID.Valid {
+			var grandparentID sql.NullInt64
+			err := tx.QueryRowContext(ctx, `
+				DELETE FROM comments c
+				WHERE c.id = $1 AND c.deleted_at IS NOT NULL
+					AND NOT EXISTS (SELECT 1 FROM comments r WHERE r.parent_id = c.id)
+				RETURNING c.parent_id
+			`, parentID.Int64).Scan(&grandparentID)
+			if err == sql.ErrNoRows {
+				break
+			}
+			if err != nil {
+				return false, fmt.Errorf("failed to delete orphan tombstone: %w", err)
+			}
+			parentID = grandparentID
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit comment deletion: %w", err)
+	}
+
+	return hasReplies, nil
+}
+
+func (r *CommentRepositoryImpl) PurgeOrphanTombstones(ctx context.Context) (int64, error) {
+	query := `
+		DELETE FROM comments c
+		WHERE c.deleted_at IS NOT NULL
+			AND NOT EXISTS (SELECT 1 FROM comments r WHERE r.parent_id = c.id)
+	`
+
+	// 墓標の連鎖（墓標の返信が墓標）に対応するため、削除対象がなくなるまで繰り返す
+	var total int64
+	for {
+		result, err := r.db.ExecContext(ctx, query)
+		if err != nil {
+			return total, fmt.Errorf("failed to purge orphan tombstones: %w", err)
+		}
+
+		deleted, err := result.RowsAffected()
+		if err != nil {
+			return total, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if deleted == 0 {
+			return total, nil
+		}
+		total += deleted
+	}
+}
+
+func (r *CommentRepositoryImpl) CountByContent(ctx context.Context, contentID int64, viewer entity.CommentViewer) (int64, error) {
+	query := `SELECT COUNT(*) FROM comments c WHERE c.content_id = $1 AND c.deleted_at IS NULL AND ` +
+		commentVisibleClause("c", 2, 3)
 
 	var count int64
-	err := r.db.QueryRowContext(ctx, query, contentID).Scan(&count)
+	err := r.db.QueryRowContext(ctx, query, contentID, viewer.UserID, viewer.CanModerate).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count comments: %w", err)
 	}
@@ -295,7 +644,7 @@ func (r *CommentRepositoryImpl) CountByContent(ctx context.Context, contentID in
 }
 
 func (r *CommentRepositoryImpl) CountByUser(ctx context.Context, userID int64) (int64, error) {
-	query := `SELECT COUNT(*) FROM comments WHERE user_id = $1`
+	query := `SELECT COUNT(*) FROM comments WHERE user_id = $1 AND deleted_at IS NULL AND NOT is_pending`
 
 	var count int64
 	err := r.db.QueryRowContext(ctx, query, userID).Scan(&count)
@@ -305,3 +654,15 @@ func (r *CommentRepositoryImpl) CountByUser(ctx context.Context, userID int64) (
 
 	return count, nil
 }
+
+func (r *CommentRepositoryImpl) CountPending(ctx context.Context, contentID int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM comments WHERE content_id = $1 AND is_pending`
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, query, contentID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pending comments: %w", err)
+	}
+
+	return count, nil
+}
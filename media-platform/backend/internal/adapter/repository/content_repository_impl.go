@@ -3,12 +3,15 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"media-platform/internal/domain/entity"
 	domainErrors "media-platform/internal/domain/errors"
 	"media-platform/internal/domain/repository"
+
+	"github.com/lib/pq"
 )
 
 type ContentRepositoryImpl struct {
@@ -23,32 +26,12 @@ func NewContentRepository(db *sql.DB) repository.ContentRepository {
 
 func (r *ContentRepositoryImpl) Find(ctx context.Context, id int64) (*entity.Content, error) {
 	query := `
-		SELECT 
-			id, title, body, type, genre, author_id, category_id, 
-			status, view_count, published_at, created_at, updated_at
+		SELECT ` + contentColumns + `
 		FROM contents
 		WHERE id = $1
 	`
 
-	var content entity.Content
-	var publishedAt sql.NullTime
-	var genre sql.NullString
-
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&content.ID,
-		&content.Title,
-		&content.Body,
-		&content.Type,
-		&genre,
-		&content.AuthorID,
-		&content.CategoryID,
-		&content.Status,
-		&content.ViewCount,
-		&publishedAt,
-		&content.CreatedAt,
-		&content.UpdatedAt,
-	)
-
+	content, err := scanContent(r.db.QueryRowContext(ctx, query, id))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domainErrors.NewNotFoundError("content", id)
@@ -56,21 +39,12 @@ func (r *ContentRepositoryImpl) Find(ctx context.Context, id int64) (*entity.Con
 		return nil, fmt.Errorf("failed to find content: %w", err)
 	}
 
-	if publishedAt.Valid {
-		content.PublishedAt = &publishedAt.Time
-	}
-	if genre.Valid {
-		content.Genre = genre.String
-	}
-
-	return &content, nil
+	return content, nil
 }
 
 func (r *ContentRepositoryImpl) FindByAuthor(ctx context.Context, authorID int64, limit, offset int) ([]*entity.Content, error) {
 	query := `
-		SELECT 
-			id, title, body, type, genre, author_id, category_id,
-			status, view_count, published_at, created_at, updated_at
+		SELECT ` + contentColumns + `
 		FROM contents
 		WHERE author_id = $1
 		ORDER BY created_at DESC
@@ -88,9 +62,7 @@ func (r *ContentRepositoryImpl) FindByAuthor(ctx context.Context, authorID int64
 
 func (r *ContentRepositoryImpl) FindByCategory(ctx context.Context, categoryID int64, limit, offset int) ([]*entity.Content, error) {
 	query := `
-		SELECT 
-			id, title, body, type, genre, author_id, category_id,
-			status, view_count, published_at, created_at, updated_at
+		SELECT ` + contentColumns + `
 		FROM contents
 		WHERE category_id = $1 AND status = 'published' AND published_at <= NOW()
 		ORDER BY published_at DESC
@@ -108,9 +80,7 @@ func (r *ContentRepositoryImpl) FindByCategory(ctx context.Context, categoryID i
 
 func (r *ContentRepositoryImpl) FindPublished(ctx context.Context, limit, offset int) ([]*entity.Content, error) {
 	query := `
-		SELECT 
-			id, title, body, type, genre, author_id, category_id,
-			status, view_count, published_at, created_at, updated_at
+		SELECT ` + contentColumns + `
 		FROM contents
 		WHERE status = 'published' AND published_at <= NOW()
 		ORDER BY published_at DESC
@@ -128,9 +98,7 @@ func (r *ContentRepositoryImpl) FindPublished(ctx context.Context, limit, offset
 
 func (r *ContentRepositoryImpl) FindByStatus(ctx context.Context, status string, authorID int64, limit, offset int) ([]*entity.Content, error) {
 	query := `
-		SELECT 
-			id, title, body, type, genre, author_id, category_id,
-			status, view_count, published_at, created_at, updated_at
+		SELECT ` + contentColumns + `
 		FROM contents
 		WHERE status = $1 AND author_id = $2
 		ORDER BY updated_at DESC
@@ -146,11 +114,52 @@ func (r *ContentRepositoryImpl) FindByStatus(ctx context.Context, status string,
 	return r.scanContentRows(rows)
 }
 
+func (r *ContentRepositoryImpl) FindByWork(ctx context.Context, workID int64, limit, offset int) ([]*entity.Content, error) {
+	query := `
+		SELECT ` + contentColumns + `
+		FROM contents
+		WHERE work_id = $1 AND status = 'published' AND published_at <= NOW()
+		ORDER BY published_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, workID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query contents by work: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanContentRows(rows)
+}
+
+func (r *ContentRepositoryImpl) FindByTypeAndMetadata(ctx context.Context, contentType entity.ContentType, categoryID *int64, metadata map[string]interface{}, limit, offset int) ([]*entity.Content, error) {
+	filter, err := marshalContentMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + contentColumns + `
+		FROM contents
+		WHERE type = $1 AND metadata @> $2::jsonb
+		    AND ($3::bigint IS NULL OR category_id = $3::bigint)
+		    AND status = 'published' AND published_at <= NOW()
+		ORDER BY published_at DESC
+		LIMIT $4 OFFSET $5
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, string(contentType), filter, categoryID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query contents by metadata: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanContentRows(rows)
+}
+
 func (r *ContentRepositoryImpl) FindTrending(ctx context.Context, limit int) ([]*entity.Content, error) {
 	query := `
-		SELECT 
-			id, title, body, type, genre, author_id, category_id,
-			status, view_count, published_at, created_at, updated_at
+		SELECT ` + contentColumns + `
 		FROM contents
 		WHERE status = 'published' AND published_at <= NOW()
 		ORDER BY view_count DESC, published_at DESC
@@ -174,9 +183,7 @@ func (r *ContentRepositoryImpl) Search(ctx context.Context, keyword string, limi
 
 	// まず全文検索関数を試行
 	query := `
-		SELECT 
-			id, title, body, type, genre, author_id, category_id,
-			status, view_count, published_at, created_at, updated_at
+		SELECT ` + contentColumns + `
 		FROM search_contents($1, $2, $3)
 	`
 
@@ -200,9 +207,7 @@ func (r *ContentRepositoryImpl) Search(ctx context.Context, keyword string, limi
 
 func (r *ContentRepositoryImpl) searchFallback(ctx context.Context, keyword string, limit, offset int) ([]*entity.Content, error) {
 	query := `
-		SELECT 
-			id, title, body, type, genre, author_id, category_id, 
-			status, view_count, published_at, created_at, updated_at,
+		SELECT ` + contentColumns + `,
 			(
 				CASE WHEN LOWER(title) = LOWER($1) THEN 100
 				     WHEN title ILIKE $4 THEN 50
@@ -243,18 +248,26 @@ func (r *ContentRepositoryImpl) Create(ctx context.Context, content *entity.Cont
 	query := `
 		INSERT INTO contents (
 			title, body, type, genre, author_id, category_id, 
-			status, view_count, published_at, created_at, updated_at
+			status, view_count, published_at, created_at, updated_at,
+			work_id, rating, recommendation_level,
+			artist_name, release_year, image_url, external_url, tags, metadata,
+			comment_policy
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
 		RETURNING id
 	`
 
+	metadata, err := marshalContentMetadata(content.Metadata)
+	if err != nil {
+		return err
+	}
+
 	var publishedAt sql.NullTime
 	if content.PublishedAt != nil {
 		publishedAt = sql.NullTime{Time: *content.PublishedAt, Valid: true}
 	}
 
-	err := r.db.QueryRowContext(ctx, query,
+	err = executorFrom(ctx, r.db).QueryRowContext(ctx, query,
 		content.Title,
 		content.Body,
 		content.Type,
@@ -266,9 +279,22 @@ func (r *ContentRepositoryImpl) Create(ctx context.Context, content *entity.Cont
 		publishedAt,
 		content.CreatedAt,
 		content.UpdatedAt,
+		content.WorkID,
+		content.ReviewScore,
+		string(content.RecommendationLevel),
+		nullIfEmpty(content.ArtistName),
+		content.ReleaseYear,
+		nullIfEmpty(content.ImageURL),
+		nullIfEmpty(content.ExternalURL),
+		pq.Array(content.Tags),
+		metadata,
+		commentPolicyOrDefault(content.CommentPolicy),
 	).Scan(&content.ID)
 
 	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" { // foreign_key_violation
+			return domainErrors.NewValidationError("指定されたコンテンツタイプまたはカテゴリが存在しません")
+		}
 		return fmt.Errorf("failed to create content: %w", err)
 	}
 
@@ -279,16 +305,24 @@ func (r *ContentRepositoryImpl) Update(ctx context.Context, content *entity.Cont
 	query := `
 		UPDATE contents
 		SET title = $1, body = $2, type = $3, genre = $4, category_id = $5, 
-		    status = $6, published_at = $7, updated_at = $8
-		WHERE id = $9
+		    status = $6, published_at = $7, updated_at = $8,
+		    work_id = $9, rating = $10, recommendation_level = $11,
+		    artist_name = $12, release_year = $13, image_url = $14, external_url = $15, tags = $16,
+		    metadata = $17, comment_policy = $18
+		WHERE id = $19
 	`
 
+	metadata, err := marshalContentMetadata(content.Metadata)
+	if err != nil {
+		return err
+	}
+
 	var publishedAt sql.NullTime
 	if content.PublishedAt != nil {
 		publishedAt = sql.NullTime{Time: *content.PublishedAt, Valid: true}
 	}
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := executorFrom(ctx, r.db).ExecContext(ctx, query,
 		content.Title,
 		content.Body,
 		content.Type,
@@ -297,9 +331,22 @@ func (r *ContentRepositoryImpl) Update(ctx context.Context, content *entity.Cont
 		content.Status,
 		publishedAt,
 		content.UpdatedAt,
+		content.WorkID,
+		content.ReviewScore,
+		string(content.RecommendationLevel),
+		nullIfEmpty(content.ArtistName),
+		content.ReleaseYear,
+		nullIfEmpty(content.ImageURL),
+		nullIfEmpty(content.ExternalURL),
+		pq.Array(content.Tags),
+		metadata,
+		commentPolicyOrDefault(content.CommentPolicy),
 		content.ID,
 	)
 	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" { // foreign_key_violation
+			return domainErrors.NewValidationError("指定されたコンテンツタイプまたはカテゴリが存在しません")
+		}
 		return fmt.Errorf("failed to update content: %w", err)
 	}
 
@@ -362,36 +409,11 @@ func (r *ContentRepositoryImpl) IncrementViewCount(ctx context.Context, id int64
 func (r *ContentRepositoryImpl) scanContentRows(rows *sql.Rows) ([]*entity.Content, error) {
 	var contents []*entity.Content
 	for rows.Next() {
-		var content entity.Content
-		var publishedAt sql.NullTime
-		var genre sql.NullString
-
-		err := rows.Scan(
-			&content.ID,
-			&content.Title,
-			&content.Body,
-			&content.Type,
-			&genre,
-			&content.AuthorID,
-			&content.CategoryID,
-			&content.Status,
-			&content.ViewCount,
-			&publishedAt,
-			&content.CreatedAt,
-			&content.UpdatedAt,
-		)
+		content, err := scanContent(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan content: %w", err)
 		}
-
-		if publishedAt.Valid {
-			content.PublishedAt = &publishedAt.Time
-		}
-		if genre.Valid {
-			content.Genre = genre.String
-		}
-
-		contents = append(contents, &content)
+		contents = append(contents, content)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -404,38 +426,12 @@ func (r *ContentRepositoryImpl) scanContentRows(rows *sql.Rows) ([]*entity.Conte
 func (r *ContentRepositoryImpl) scanContentRowsWithScore(rows *sql.Rows) ([]*entity.Content, error) {
 	var contents []*entity.Content
 	for rows.Next() {
-		var content entity.Content
-		var publishedAt sql.NullTime
-		var genre sql.NullString
 		var relevanceScore int
-
-		err := rows.Scan(
-			&content.ID,
-			&content.Title,
-			&content.Body,
-			&content.Type,
-			&genre,
-			&content.AuthorID,
-			&content.CategoryID,
-			&content.Status,
-			&content.ViewCount,
-			&publishedAt,
-			&content.CreatedAt,
-			&content.UpdatedAt,
-			&relevanceScore,
-		)
+		content, err := scanContent(rows, &relevanceScore)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan content with score: %w", err)
 		}
-
-		if publishedAt.Valid {
-			content.PublishedAt = &publishedAt.Time
-		}
-		if genre.Valid {
-			content.Genre = genre.String
-		}
-
-		contents = append(contents, &content)
+		contents = append(contents, content)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -444,3 +440,149 @@ func (r *ContentRepositoryImpl) scanContentRowsWithScore(rows *sql.Rows) ([]*ent
 
 	return contents, nil
 }
+
+// ReconcileCounters はいいね数・コメント数を実際の件数で再集計します
+// 通常はトリガーで更新されるため、ずれがあるコンテンツのみ更新されます
+func (r *ContentRepositoryImpl) ReconcileCounters(ctx context.Context) (int64, error) {
+	query := `
+		WITH actual AS (
+			SELECT
+				c.id,
+				(SELECT COUNT(*) FROM ratings r WHERE r.content_id = c.id) AS like_count,
+				(SELECT COUNT(*) FROM comments m WHERE m.content_id = c.id AND m.deleted_at IS NULL AND NOT m.is_pending) AS comment_count
+			FROM contents c
+		)
+		UPDATE contents c
+		SET like_count = a.like_count, comment_count = a.comment_count
+		FROM actual a
+		WHERE c.id = a.id
+			AND (c.like_count <> a.like_count OR c.comment_count <> a.comment_count)
+	`
+
+	result, err := r.db.ExecContext(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reconcile content counters: %w", err)
+	}
+
+	fixed, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return fixed, nil
+}
+
+// contentColumns はコンテンツ取得クエリで共通して使用するカラムです（scanContentと順序を揃えること）
+const contentColumns = `
+			id, title, body, type, genre, author_id, category_id,
+			status, view_count, published_at, created_at, updated_at,
+			work_id, rating, recommendation_level,
+			artist_name, release_year, image_url, external_url, tags, metadata,
+			like_count, comment_count, comment_policy`
+
+// commentPolicyOrDefault はコメント設定が未指定の場合に誰でもコメントできる設定を返します
+func commentPolicyOrDefault(policy entity.CommentPolicy) string {
+	if policy == "" {
+		return string(entity.CommentPolicyOpen)
+	}
+	return string(policy)
+}
+
+// rowScanner は*sql.Rowと*sql.Rowsの共通インターフェースです
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanContent はcontentColumnsの順でコンテンツを読み込みます
+// extra には contentColumns の後ろに続く追加カラムの格納先を指定します
+func scanContent(row rowScanner, extra ...interface{}) (*entity.Content, error) {
+	var content entity.Content
+	var publishedAt sql.NullTime
+	var genre sql.NullString
+	var workID sql.NullInt64
+	var reviewScore sql.NullFloat64
+	var recommendationLevel sql.NullString
+	var artistName, imageURL, externalURL sql.NullString
+	var releaseYear sql.NullInt64
+	var metadata []byte
+
+	dest := []interface{}{
+		&content.ID,
+		&content.Title,
+		&content.Body,
+		&content.Type,
+		&genre,
+		&content.AuthorID,
+		&content.CategoryID,
+		&content.Status,
+		&content.ViewCount,
+		&publishedAt,
+		&content.CreatedAt,
+		&content.UpdatedAt,
+		&workID,
+		&reviewScore,
+		&recommendationLevel,
+		&artistName,
+		&releaseYear,
+		&imageURL,
+		&externalURL,
+		pq.Array(&content.Tags),
+		&metadata,
+		&content.LikeCount,
+		&content.CommentCount,
+		&content.CommentPolicy,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	if publishedAt.Valid {
+		content.PublishedAt = &publishedAt.Time
+	}
+	if genre.Valid {
+		content.Genre = genre.String
+	}
+	if workID.Valid {
+		id := workID.Int64
+		content.WorkID = &id
+	}
+	if reviewScore.Valid {
+		score := reviewScore.Float64
+		content.ReviewScore = &score
+	}
+	if recommendationLevel.Valid {
+		content.RecommendationLevel = entity.RecommendationLevel(recommendationLevel.String)
+	}
+	content.ArtistName = artistName.String
+	content.ImageURL = imageURL.String
+	content.ExternalURL = externalURL.String
+	if releaseYear.Valid {
+		year := int(releaseYear.Int64)
+		content.ReleaseYear = &year
+	}
+	content.Metadata = map[string]interface{}{}
+	if len(metadata) > 0 {
+		if err := json.Unmarshal(metadata, &content.Metadata); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal content metadata: %w", err)
+		}
+	}
+
+	return &content, nil
+}
+
+// marshalContentMetadata は種類別の構造化項目をJSONBとして保存できる形式に変換します
+func marshalContentMetadata(metadata map[string]interface{}) ([]byte, error) {
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal content metadata: %w", err)
+	}
+	return data, nil
+}
+
+// nullIfEmpty は空文字をNULLとして保存するための変換を行います
+func nullIfEmpty(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+
+	"github.com/lib/pq"
+)
+
+type contentTypeRepository struct {
+	db *sql.DB
+}
+
+// NewContentTypeRepository はContentTypeRepositoryを作成します
+func NewContentTypeRepository(db *sql.DB) repository.ContentTypeRepository {
+	return &contentTypeRepository{db: db}
+}
+
+// contentTypeColumns はコンテンツタイプ取得クエリで共通して使用するカラムです（scanContentTypeと順序を揃えること）
+const contentTypeColumns = `
+			id, name, display_name, description, allowed_fields, metadata_schema, default_category_id,
+			sort_order, is_active, created_at, updated_at`
+
+// FindAll はコンテンツタイプを表示順で取得します
+func (r *contentTypeRepository) FindAll(ctx context.Context, includeInactive bool) ([]*entity.ContentTypeDefinition, error) {
+	query := `
+		SELECT ` + contentTypeColumns + `
+		FROM content_types
+		WHERE $1 OR is_active = TRUE
+		ORDER BY sort_order, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, includeInactive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query content types: %w", err)
+	}
+	defer rows.Close()
+
+	var definitions []*entity.ContentTypeDefinition
+	for rows.Next() {
+		definition, err := scanContentType(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan content type: %w", err)
+		}
+		definitions = append(definitions, definition)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return definitions, nil
+}
+
+// Find は指定されたIDのコンテンツタイプを取得します
+func (r *contentTypeRepository) Find(ctx context.Context, id int64) (*entity.ContentTypeDefinition, error) {
+	query := `
+		SELECT ` + contentTypeColumns + `
+		FROM content_types
+		WHERE id = $1
+	`
+
+	definition, err := scanContentType(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.NewNotFoundError("content type", id)
+		}
+		return nil, fmt.Errorf("failed to find content type: %w", err)
+	}
+
+	return definition, nil
+}
+
+// FindByName は指定された名前のコンテンツタイプを取得します
+func (r *contentTypeRepository) FindByName(ctx context.Context, name entity.ContentType) (*entity.ContentTypeDefinition, error) {
+	query := `
+		SELECT ` + contentTypeColumns + `
+		FROM content_types
+		WHERE name = $1
+	`
+
+	definition, err := scanContentType(r.db.QueryRowContext(ctx, query, string(name)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.NewNotFoundError("content type", string(name))
+		}
+		return nil, fmt.Errorf("failed to find content type by name: %w", err)
+	}
+
+	return definition, nil
+}
+
+// Create は新しいコンテンツタイプを作成します
+func (r *contentTypeRepository) Create(ctx context.Context, definition *entity.ContentTypeDefinition) error {
+	query := `
+		INSERT INTO content_types (
+			name, display_name, description, allowed_fields, metadata_schema, default_category_id,
+			sort_order, is_active, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		string(definition.Name),
+		definition.DisplayName,
+		definition.Description,
+		pq.Array(definition.AllowedFields),
+		definition.MetadataSchemaKey,
+		definition.DefaultCategoryID,
+		definition.SortOrder,
+		definition.IsActive,
+		definition.CreatedAt,
+		definition.UpdatedAt,
+	).Scan(&definition.ID)
+
+	if err != nil {
+		return r.translateError(err, "failed to create content type")
+	}
+
+	return nil
+}
+
+// Update は既存のコンテンツタイプを更新します（名前は作成後に変更できないため更新しません）
+func (r *contentTypeRepository) Update(ctx context.Context, definition *entity.ContentTypeDefinition) error {
+	query := `
+		UPDATE content_types
+		SET display_name = $1, description = $2, allowed_fields = $3, metadata_schema = $4,
+		    default_category_id = $5, sort_order = $6, is_active = $7, updated_at = $8
+		WHERE id = $9
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		definition.DisplayName,
+		definition.Description,
+		pq.Array(definition.AllowedFields),
+		definition.MetadataSchemaKey,
+		definition.DefaultCategoryID,
+		definition.SortOrder,
+		definition.IsActive,
+		definition.UpdatedAt,
+		definition.ID,
+	)
+	if err != nil {
+		return r.translateError(err, "failed to update content type")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("content type", definition.ID)
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDのコンテンツタイプを削除します
+func (r *contentTypeRepository) Delete(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM content_types WHERE id = $1`, id)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" { // foreign_key_violation
+			return domainErrors.NewConflictError("content type", "content type is in use")
+		}
+		return fmt.Errorf("failed to delete content type: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("content type", id)
+	}
+
+	return nil
+}
+
+// translateError は作成・更新時のDBエラーをドメインエラーに変換します
+func (r *contentTypeRepository) translateError(err error, message string) error {
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code {
+		case "23505": // unique_violation
+			return domainErrors.NewConflictError("content type", "content type already exists")
+		case "23503": // foreign_key_violation
+			return domainErrors.NewValidationError("指定された既定カテゴリが存在しません")
+		}
+	}
+	return fmt.Errorf("%s: %w", message, err)
+}
+
+// scanContentType はcontentTypeColumnsの順でコンテンツタイプを読み込みます
+func scanContentType(row rowScanner) (*entity.ContentTypeDefinition, error) {
+	var definition entity.ContentTypeDefinition
+	var name string
+	var defaultCategoryID sql.NullInt64
+
+	err := row.Scan(
+		&definition.ID,
+		&name,
+		&definition.DisplayName,
+		&definition.Description,
+		pq.Array(&definition.AllowedFields),
+		&definition.MetadataSchemaKey,
+		&defaultCategoryID,
+		&definition.SortOrder,
+		&definition.IsActive,
+		&definition.CreatedAt,
+		&definition.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	definition.Name = entity.ContentType(name)
+	if defaultCategoryID.Valid {
+		id := defaultCategoryID.Int64
+		definition.DefaultCategoryID = &id
+	}
+
+	return &definition, nil
+}
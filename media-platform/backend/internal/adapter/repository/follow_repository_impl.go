@@ -99,7 +99,7 @@ func (r *followRepository) Create(ctx context.Context, follow *entity.Follow) er
 		RETURNING id
 	`
 
-	err := r.db.QueryRowContext(
+	err := executorFrom(ctx, r.db).QueryRowContext(
 		ctx,
 		query,
 		follow.FollowerID,
@@ -127,7 +127,7 @@ func (r *followRepository) Delete(ctx context.Context, followerID, followingID i
 		WHERE follower_id = $1 AND following_id = $2
 	`
 
-	result, err := r.db.ExecContext(ctx, query, followerID, followingID)
+	result, err := executorFrom(ctx, r.db).ExecContext(ctx, query, followerID, followingID)
 	if err != nil {
 		return fmt.Errorf("failed to delete follow: %w", err)
 	}
@@ -297,17 +297,12 @@ func (r *followRepository) GetFollowStats(ctx context.Context, userID int64, cur
 // GetFollowingFeed はフォロー中のユーザーのコンテンツを取得します
 func (r *followRepository) GetFollowingFeed(ctx context.Context, userID int64, limit, offset int) ([]*entity.Content, error) {
 	query := `
-		SELECT 
-			c.id, c.title, c.body, c.type, c.author_id, c.category_id, 
-			c.status, c.view_count, c.published_at, c.created_at, c.updated_at,
-			c.work_title, c.rating, c.recommendation_level, c.tags,
-			c.image_url, c.external_url, c.release_year, c.artist_name, c.genre
-		FROM contents c
-		INNER JOIN follows f ON c.author_id = f.following_id
-		WHERE f.follower_id = $1
-			AND c.status = 'published'
-			AND c.published_at <= NOW()
-		ORDER BY c.published_at DESC
+		SELECT ` + contentColumns + `
+		FROM contents
+		WHERE author_id IN (SELECT following_id FROM follows WHERE follower_id = $1)
+			AND status = 'published'
+			AND published_at <= NOW()
+		ORDER BY published_at DESC
 		LIMIT $2 OFFSET $3
 	`
 
@@ -319,22 +314,7 @@ func (r *followRepository) GetFollowingFeed(ctx context.Context, userID int64, l
 
 	var contents []*entity.Content
 	for rows.Next() {
-		content := &entity.Content{}
-
-		err := rows.Scan(
-			&content.ID,
-			&content.Title,
-			&content.Body,
-			&content.Type,
-			&content.AuthorID,
-			&content.CategoryID,
-			&content.Status,
-			&content.ViewCount,
-			&content.PublishedAt,
-			&content.CreatedAt,
-			&content.UpdatedAt,
-			&content.Genre,
-		)
+		content, err := scanContent(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan content: %w", err)
 		}
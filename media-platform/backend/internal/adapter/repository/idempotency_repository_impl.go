@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"media-platform/internal/domain/entity"
+	"media-platform/internal/domain/repository"
+)
+
+type idempotencyRepository struct {
+	db *sql.DB
+}
+
+// NewIdempotencyRepository はIdempotencyRepositoryを作成します
+func NewIdempotencyRepository(db *sql.DB) repository.IdempotencyRepository {
+	return &idempotencyRepository{db: db}
+}
+
+// Reserve はキーを処理中として確保します
+func (r *idempotencyRepository) Reserve(ctx context.Context, userID int64, key, requestHash string, expiredBefore time.Time) (*entity.IdempotencyRecord, bool, error) {
+	// 新規のキー、または期限切れのキーのみ確保できる
+	query := `
+		INSERT INTO idempotency_keys (user_id, key, request_hash, created_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (user_id, key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			status_code = NULL,
+			content_type = NULL,
+			response_body = NULL,
+			created_at = EXCLUDED.created_at
+		WHERE idempotency_keys.created_at < $4
+		RETURNING user_id
+	`
+
+	var reservedUserID int64
+	err := r.db.QueryRowContext(ctx, query, userID, key, requestHash, expiredBefore).Scan(&reservedUserID)
+	if err == nil {
+		return nil, true, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, false, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+
+	// 既存のキーを取得
+	record := &entity.IdempotencyRecord{}
+	var statusCode sql.NullInt64
+	var contentType sql.NullString
+	err = r.db.QueryRowContext(ctx, `
+		SELECT user_id, key, request_hash, status_code, content_type, response_body, created_at
+		FROM idempotency_keys
+		WHERE user_id = $1 AND key = $2
+	`, userID, key).Scan(
+		&record.UserID,
+		&record.Key,
+		&record.RequestHash,
+		&statusCode,
+		&contentType,
+		&record.ResponseBody,
+		&record.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// 確保と取得の間に解放された場合は再試行する
+			return r.Reserve(ctx, userID, key, requestHash, expiredBefore)
+		}
+		return nil, false, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	if statusCode.Valid {
+		code := int(statusCode.Int64)
+		record.StatusCode = &code
+	}
+	record.ContentType = contentType.String
+
+	return record, false, nil
+}
+
+// Complete は処理中のキーにレスポンスを保存します
+func (r *idempotencyRepository) Complete(ctx context.Context, userID int64, key string, statusCode int, contentType string, body []byte) error {
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = $3, content_type = $4, response_body = $5
+		WHERE user_id = $1 AND key = $2 AND status_code IS NULL
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, key, statusCode, contentType, body); err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release は処理中のキーを解放します
+func (r *idempotencyRepository) Release(ctx context.Context, userID int64, key string) error {
+	query := `DELETE FROM idempotency_keys WHERE user_id = $1 AND key = $2 AND status_code IS NULL`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, key); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired は期限切れのキーを削除します
+func (r *idempotencyRepository) DeleteExpired(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE created_at < $1`, expiredBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,328 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+
+	"github.com/lib/pq"
+)
+
+type libraryRepository struct {
+	db *sql.DB
+}
+
+// NewLibraryRepository はLibraryRepositoryを作成します
+func NewLibraryRepository(db *sql.DB) repository.LibraryRepository {
+	return &libraryRepository{db: db}
+}
+
+// librarySelect はライブラリエントリ取得の共通SELECT句です（対象のタイトル・種類を結合）
+const librarySelect = `
+		SELECT
+			l.id, l.user_id, l.work_id, l.content_id, l.status,
+			l.progress, l.progress_total, l.progress_unit,
+			l.started_on, l.finished_on, l.note, l.created_at, l.updated_at,
+			COALESCE(w.title, c.title, ''), COALESCE(w.type, c.type, '')
+		FROM library_entries l
+		LEFT JOIN works w ON l.work_id = w.id
+		LEFT JOIN contents c ON l.content_id = c.id
+`
+
+// Find は指定されたIDのライブラリエントリを取得します
+func (r *libraryRepository) Find(ctx context.Context, id int64) (*entity.LibraryEntry, error) {
+	query := librarySelect + `WHERE l.id = $1`
+
+	entry, err := scanLibraryEntry(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.NewNotFoundError("library entry", id)
+		}
+		return nil, fmt.Errorf("failed to find library entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// FindByUser はユーザーのライブラリエントリを絞り込み条件付きで取得します
+func (r *libraryRepository) FindByUser(ctx context.Context, userID int64, filter *entity.LibraryFilter, limit, offset int) ([]*entity.LibraryEntry, int, error) {
+	conditions := []string{"l.user_id = $1"}
+	args := []interface{}{userID}
+
+	if filter != nil {
+		if filter.Status != "" {
+			args = append(args, string(filter.Status))
+			conditions = append(conditions, fmt.Sprintf("l.status = $%d", len(args)))
+		}
+		if filter.Type != "" {
+			args = append(args, string(filter.Type))
+			conditions = append(conditions, fmt.Sprintf("COALESCE(w.type, c.type) = $%d", len(args)))
+		}
+		if filter.FinishedYear != nil {
+			args = append(args, *filter.FinishedYear)
+			conditions = append(conditions, fmt.Sprintf("EXTRACT(YEAR FROM l.finished_on) = $%d", len(args)))
+		}
+		switch filter.Target {
+		case "work":
+			conditions = append(conditions, "l.work_id IS NOT NULL")
+		case "content":
+			conditions = append(conditions, "l.content_id IS NOT NULL")
+		}
+	}
+
+	where := " WHERE " + strings.Join(conditions, " AND ")
+
+	// 総件数
+	countQuery := `
+		SELECT COUNT(*)
+		FROM library_entries l
+		LEFT JOIN works w ON l.work_id = w.id
+		LEFT JOIN contents c ON l.content_id = c.id
+	` + where
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count library entries: %w", err)
+	}
+
+	args = append(args, limit, offset)
+	query := librarySelect + where + fmt.Sprintf(`
+		ORDER BY l.updated_at DESC, l.id DESC
+		LIMIT $%d OFFSET $%d
+	`, len(args)-1, len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query library entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*entity.LibraryEntry
+	for rows.Next() {
+		entry, err := scanLibraryEntry(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan library entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return entries, total, nil
+}
+
+// Create は新しいライブラリエントリを作成します
+func (r *libraryRepository) Create(ctx context.Context, entry *entity.LibraryEntry) error {
+	query := `
+		INSERT INTO library_entries (
+			user_id, work_id, content_id, status, progress, progress_total, progress_unit,
+			started_on, finished_on, note, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		entry.UserID,
+		entry.WorkID,
+		entry.ContentID,
+		string(entry.Status),
+		entry.Progress,
+		entry.ProgressTotal,
+		string(entry.ProgressUnit),
+		entry.StartedOn,
+		entry.FinishedOn,
+		entry.Note,
+		entry.CreatedAt,
+		entry.UpdatedAt,
+	).Scan(&entry.ID)
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23505": // unique_violation
+				return domainErrors.NewConflictError("library entry", "already in library")
+			case "23503": // foreign_key_violation
+				return domainErrors.NewValidationError("指定された作品またはコンテンツが存在しません")
+			}
+		}
+		return fmt.Errorf("failed to create library entry: %w", err)
+	}
+
+	return nil
+}
+
+// Update は既存のライブラリエントリを更新します
+func (r *libraryRepository) Update(ctx context.Context, entry *entity.LibraryEntry) error {
+	query := `
+		UPDATE library_entries
+		SET status = $1, progress = $2, progress_total = $3, progress_unit = $4,
+		    started_on = $5, finished_on = $6, note = $7, updated_at = $8
+		WHERE id = $9
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		string(entry.Status),
+		entry.Progress,
+		entry.ProgressTotal,
+		string(entry.ProgressUnit),
+		entry.StartedOn,
+		entry.FinishedOn,
+		entry.Note,
+		entry.UpdatedAt,
+		entry.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update library entry: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("library entry", entry.ID)
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDのライブラリエントリを削除します
+func (r *libraryRepository) Delete(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM library_entries WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete library entry: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("library entry", id)
+	}
+
+	return nil
+}
+
+// GetStats はユーザーのライブラリ集計を取得します
+func (r *libraryRepository) GetStats(ctx context.Context, userID int64, year *int) (*entity.LibraryStats, error) {
+	stats := entity.NewLibraryStats(year)
+
+	// ステータス別件数（現在の状態）
+	statusRows, err := r.db.QueryContext(ctx, `
+		SELECT status, COUNT(*)
+		FROM library_entries
+		WHERE user_id = $1
+		GROUP BY status
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get library status stats: %w", err)
+	}
+	defer statusRows.Close()
+
+	for statusRows.Next() {
+		var status string
+		var count int
+		if err := statusRows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan library status stats: %w", err)
+		}
+		stats.ByStatus[entity.LibraryStatus(status)] = count
+		stats.Total += count
+	}
+	if err := statusRows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	// 種類別の完了数（年指定時はその年に終了したもの）
+	typeRows, err := r.db.QueryContext(ctx, `
+		SELECT COALESCE(w.type, c.type, ''), COUNT(*)
+		FROM library_entries l
+		LEFT JOIN works w ON l.work_id = w.id
+		LEFT JOIN contents c ON l.content_id = c.id
+		WHERE l.user_id = $1
+			AND l.status = 'completed'
+			AND ($2::int IS NULL OR EXTRACT(YEAR FROM l.finished_on) = $2::int)
+		GROUP BY 1
+	`, userID, year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get library completion stats: %w", err)
+	}
+	defer typeRows.Close()
+
+	for typeRows.Next() {
+		var itemType string
+		var count int
+		if err := typeRows.Scan(&itemType, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan library completion stats: %w", err)
+		}
+		stats.CompletedByType[entity.ContentType(itemType)] = count
+	}
+	if err := typeRows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return stats, nil
+}
+
+// scanLibraryEntry はlibrarySelectの順でライブラリエントリを読み込みます
+func scanLibraryEntry(row rowScanner) (*entity.LibraryEntry, error) {
+	var entry entity.LibraryEntry
+	var workID, contentID, progressTotal sql.NullInt64
+	var startedOn, finishedOn sql.NullTime
+	var status, progressUnit, itemType string
+
+	err := row.Scan(
+		&entry.ID,
+		&entry.UserID,
+		&workID,
+		&contentID,
+		&status,
+		&entry.Progress,
+		&progressTotal,
+		&progressUnit,
+		&startedOn,
+		&finishedOn,
+		&entry.Note,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+		&entry.ItemTitle,
+		&itemType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Status = entity.LibraryStatus(status)
+	entry.ProgressUnit = entity.ProgressUnit(progressUnit)
+	entry.ItemType = entity.ContentType(itemType)
+	if workID.Valid {
+		id := workID.Int64
+		entry.WorkID = &id
+	}
+	if contentID.Valid {
+		id := contentID.Int64
+		entry.ContentID = &id
+	}
+	if progressTotal.Valid {
+		total := int(progressTotal.Int64)
+		entry.ProgressTotal = &total
+	}
+	if startedOn.Valid {
+		entry.StartedOn = &startedOn.Time
+	}
+	if finishedOn.Valid {
+		entry.FinishedOn = &finishedOn.Time
+	}
+
+	return &entry, nil
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+
+	"github.com/lib/pq"
+)
+
+type mentionRepository struct {
+	db *sql.DB
+}
+
+// NewMentionRepository はMentionRepositoryを作成します
+func NewMentionRepository(db *sql.DB) repository.MentionRepository {
+	return &mentionRepository{db: db}
+}
+
+// mentionSourceColumn はメンションを含む本文の種類に対応するカラム名を返します
+func mentionSourceColumn(source entity.MentionSource) (string, error) {
+	switch source {
+	case entity.MentionSourceContent:
+		return "content_id", nil
+	case entity.MentionSourceComment:
+		return "comment_id", nil
+	}
+	return "", domainErrors.NewValidationError("メンションの対象は content または comment である必要があります")
+}
+
+// Replace は本文のメンションを指定されたユーザーに置き換えます
+// 既に記録されているユーザーは通知済みの状態を保つため、編集で残ったメンションが再通知されることはありません
+func (r *mentionRepository) Replace(ctx context.Context, source entity.MentionSource, sourceID int64, userIDs []int64) error {
+	column, err := mentionSourceColumn(source)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		`DELETE FROM mentions WHERE `+column+` = $1 AND NOT (user_id = ANY($2))`,
+		sourceID, pq.Array(userIDs),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete mentions: %w", err)
+	}
+
+	if len(userIDs) > 0 {
+		query := `
+			INSERT INTO mentions (` + column + `, user_id)
+			SELECT $1, u FROM UNNEST($2::bigint[]) AS u
+			ON CONFLICT (` + column + `, user_id) DO NOTHING
+		`
+
+		if _, err := tx.ExecContext(ctx, query, sourceID, pq.Array(userIDs)); err != nil {
+			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" { // foreign_key_violation
+				return domainErrors.NewValidationError("メンションの対象が存在しません")
+			}
+			return fmt.Errorf("failed to insert mentions: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit mentions: %w", err)
+	}
+
+	return nil
+}
+
+// MarkNotified は本文のまだ通知していないメンションを通知済みにし、そのユーザーのIDを返します
+// 1回のUPDATEで記録するため、同時に呼ばれても同じユーザーが二重に返ることはありません
+func (r *mentionRepository) MarkNotified(ctx context.Context, source entity.MentionSource, sourceID int64) ([]int64, error) {
+	column, err := mentionSourceColumn(source)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		UPDATE mentions SET notified_at = NOW()
+		WHERE ` + column + ` = $1 AND notified_at IS NULL
+		RETURNING user_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark mentions notified: %w", err)
+	}
+	defer rows.Close()
+
+	userIDs := []int64{}
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan mention: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+// FindBySources は複数の本文のメンションをユーザー名付きで一括取得します（キーは本文のID）
+func (r *mentionRepository) FindBySources(ctx context.Context, source entity.MentionSource, sourceIDs []int64) (map[int64][]*entity.Mention, error) {
+	result := make(map[int64][]*entity.Mention, len(sourceIDs))
+	if len(sourceIDs) == 0 {
+		return result, nil
+	}
+
+	column, err := mentionSourceColumn(source)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT m.id, m.` + column + `, m.user_id, u.username, m.created_at
+		FROM mentions m
+		JOIN users u ON m.user_id = u.id
+		WHERE m.` + column + ` = ANY($1)
+		ORDER BY m.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(sourceIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query mentions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		mention := &entity.Mention{Source: source}
+		if err := rows.Scan(&mention.ID, &mention.SourceID, &mention.UserID, &mention.Username, &mention.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan mention: %w", err)
+		}
+		result[mention.SourceID] = append(result[mention.SourceID], mention)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"media-platform/internal/domain/entity"
+	"media-platform/internal/domain/repository"
+)
+
+type metadataCacheRepository struct {
+	db *sql.DB
+}
+
+// NewMetadataCacheRepository はMetadataCacheRepositoryを作成します
+func NewMetadataCacheRepository(db *sql.DB) repository.MetadataCacheRepository {
+	return &metadataCacheRepository{db: db}
+}
+
+// Get は有効期限内のキャッシュを取得します
+func (r *metadataCacheRepository) Get(ctx context.Context, provider, lookupKey string) ([]*entity.WorkMetadata, bool, error) {
+	query := `
+		SELECT results
+		FROM work_metadata_cache
+		WHERE provider = $1 AND lookup_key = $2 AND expires_at > NOW()
+	`
+
+	var raw []byte
+	err := r.db.QueryRowContext(ctx, query, provider, lookupKey).Scan(&raw)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to get metadata cache: %w", err)
+	}
+
+	var results []*entity.WorkMetadata
+	if err := json.Unmarshal(raw, &results); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal metadata cache: %w", err)
+	}
+
+	return results, true, nil
+}
+
+// Put は検索結果を保存します
+func (r *metadataCacheRepository) Put(ctx context.Context, provider, lookupKey string, results []*entity.WorkMetadata, ttl time.Duration) error {
+	if results == nil {
+		results = []*entity.WorkMetadata{}
+	}
+	raw, err := json.Marshal(results)
+	if err != nil {
+		return fmt.Errorf("failed to marshal metadata cache: %w", err)
+	}
+
+	query := `
+		INSERT INTO work_metadata_cache (provider, lookup_key, results, fetched_at, expires_at)
+		VALUES ($1, $2, $3, NOW(), $4)
+		ON CONFLICT (provider, lookup_key)
+		DO UPDATE SET results = EXCLUDED.results, fetched_at = EXCLUDED.fetched_at, expires_at = EXCLUDED.expires_at
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, provider, lookupKey, raw, time.Now().Add(ttl)); err != nil {
+		return fmt.Errorf("failed to put metadata cache: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpired は有効期限切れのキャッシュを削除します
+func (r *metadataCacheRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM work_metadata_cache WHERE expires_at <= NOW()`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired metadata cache: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
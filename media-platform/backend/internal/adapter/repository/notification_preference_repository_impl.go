@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"media-platform/internal/domain/entity"
+	"media-platform/internal/domain/repository"
+)
+
+type notificationPreferenceRepository struct {
+	db *sql.DB
+}
+
+// NewNotificationPreferenceRepository はNotificationPreferenceRepositoryを作成します
+func NewNotificationPreferenceRepository(db *sql.DB) repository.NotificationPreferenceRepository {
+	return &notificationPreferenceRepository{db: db}
+}
+
+// Find はユーザーの設定を取得します（保存されていない場合はデフォルトの設定）
+func (r *notificationPreferenceRepository) Find(ctx context.Context, userID int64) (*entity.NotificationPreference, error) {
+	query := `
+		SELECT user_id, email_follow, email_reply, email_mention, email_digest, locale, updated_at
+		FROM notification_preferences
+		WHERE user_id = $1
+	`
+
+	var preference entity.NotificationPreference
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&preference.UserID,
+		&preference.EmailFollow,
+		&preference.EmailReply,
+		&preference.EmailMention,
+		&preference.EmailDigest,
+		&preference.Locale,
+		&preference.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entity.NewNotificationPreference(userID), nil
+		}
+		return nil, fmt.Errorf("failed to find notification preference: %w", err)
+	}
+
+	return &preference, nil
+}
+
+// Upsert はユーザーの設定を保存します
+func (r *notificationPreferenceRepository) Upsert(ctx context.Context, preference *entity.NotificationPreference) error {
+	query := `
+		INSERT INTO notification_preferences (user_id, email_follow, email_reply, email_mention, email_digest, locale, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id) DO UPDATE SET
+			email_follow = EXCLUDED.email_follow,
+			email_reply = EXCLUDED.email_reply,
+			email_mention = EXCLUDED.email_mention,
+			email_digest = EXCLUDED.email_digest,
+			locale = EXCLUDED.locale,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		preference.UserID,
+		preference.EmailFollow,
+		preference.EmailReply,
+		preference.EmailMention,
+		preference.EmailDigest,
+		preference.Locale,
+		preference.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert notification preference: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimDueDigestRecipients は送信時期になった週間ダイジェストの受信者をID順に最大limit件取得し、leaseUntilまで他のインスタンスが取得しないようにします
+// 設定を保存していないユーザーはダイジェストを受け取る扱いです
+// 複数のインスタンスで同時に実行しても同じユーザーを取得しないよう、候補のユーザーの行を SKIP LOCKED でロックしてから記録を更新します
+func (r *notificationPreferenceRepository) ClaimDueDigestRecipients(ctx context.Context, dueBefore, now, leaseUntil time.Time, limit int) ([]*entity.EmailRecipient, error) {
+	query := `
+		WITH due AS (
+			SELECT u.id
+			FROM users u
+			LEFT JOIN notification_preferences p ON p.user_id = u.id
+			LEFT JOIN digest_deliveries d ON d.user_id = u.id
+			WHERE u.email <> '' AND COALESCE(p.email_digest, TRUE)
+			  AND (d.last_sent_at IS NULL OR d.last_sent_at <= $1)
+			  AND (d.claimed_until IS NULL OR d.claimed_until <= $2)
+			ORDER BY u.id
+			LIMIT $4
+			FOR NO KEY UPDATE OF u SKIP LOCKED
+		), claimed AS (
+			INSERT INTO digest_deliveries (user_id, claimed_until)
+			SELECT id, $3 FROM due
+			ON CONFLICT (user_id) DO UPDATE SET claimed_until = EXCLUDED.claimed_until
+			WHERE digest_deliveries.claimed_until IS NULL OR digest_deliveries.claimed_until <= $2
+			RETURNING user_id
+		)
+		SELECT u.id, u.username, u.email, COALESCE(p.locale, 'ja')
+		FROM claimed c
+		JOIN users u ON u.id = c.user_id
+		LEFT JOIN notification_preferences p ON p.user_id = u.id
+		ORDER BY u.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, dueBefore, now, leaseUntil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim digest recipients: %w", err)
+	}
+	defer rows.Close()
+
+	recipients := []*entity.EmailRecipient{}
+	for rows.Next() {
+		var recipient entity.EmailRecipient
+		if err := rows.Scan(&recipient.UserID, &recipient.Username, &recipient.Email, &recipient.Locale); err != nil {
+			return nil, fmt.Errorf("failed to scan digest recipient: %w", err)
+		}
+		recipients = append(recipients, &recipient)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return recipients, nil
+}
+
+// MarkDigestSent はユーザーのダイジェストを処理した日時を記録し、処理中の状態を解除します
+func (r *notificationPreferenceRepository) MarkDigestSent(ctx context.Context, userID int64, sentAt time.Time) error {
+	query := `
+		INSERT INTO digest_deliveries (user_id, last_sent_at, claimed_until)
+		VALUES ($1, $2, NULL)
+		ON CONFLICT (user_id) DO UPDATE SET last_sent_at = EXCLUDED.last_sent_at, claimed_until = NULL
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, sentAt); err != nil {
+		return fmt.Errorf("failed to mark digest sent: %w", err)
+	}
+	return nil
+}
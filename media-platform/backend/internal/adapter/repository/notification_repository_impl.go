@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+)
+
+type notificationRepository struct {
+	db *sql.DB
+}
+
+// NewNotificationRepository はNotificationRepositoryを作成します
+func NewNotificationRepository(db *sql.DB) repository.NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+// Record は通知を記録します
+// 同じ種類・同じまとめる単位の未読の通知があればそこにactorIDを追加し、まだ数えていないユーザーの場合のみtrueを返します
+func (r *notificationRepository) Record(ctx context.Context, notification *entity.Notification, actorID int64) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 未読の通知があれば行をロックしてそのIDを使う
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO notifications (user_id, type, group_key, content_id, comment_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $6)
+		ON CONFLICT (user_id, type, group_key) WHERE NOT is_read
+		DO UPDATE SET updated_at = notifications.updated_at
+		RETURNING id, created_at
+	`,
+		notification.UserID,
+		notification.Type,
+		notification.GroupKey,
+		notification.ContentID,
+		notification.CommentID,
+		notification.CreatedAt,
+	).Scan(&notification.ID, &notification.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to upsert notification: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx,
+		`INSERT INTO notification_actors (notification_id, actor_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+		notification.ID, actorID,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert notification actor: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		// 同じユーザーによる同じ通知（いいねの取り消しと再いいねなど）は数えない
+		return false, tx.Commit()
+	}
+
+	notification.UpdatedAt = time.Now()
+	err = tx.QueryRowContext(ctx, `
+		UPDATE notifications
+		SET actor_count = actor_count + 1,
+		    latest_actor_id = $2,
+		    comment_id = COALESCE($3, comment_id),
+		    updated_at = $4
+		WHERE id = $1
+		RETURNING actor_count
+	`, notification.ID, actorID, notification.CommentID, notification.UpdatedAt).Scan(&notification.ActorCount)
+	if err != nil {
+		return false, fmt.Errorf("failed to update notification: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit notification: %w", err)
+	}
+
+	return true, nil
+}
+
+const notificationSelect = `
+	SELECT n.id, n.user_id, n.type, n.group_key, n.content_id, n.comment_id, COALESCE(c.title, ''),
+	       u.id, u.username, u.avatar,
+	       n.actor_count, n.is_read, n.read_at, n.created_at, n.updated_at
+	FROM notifications n
+	LEFT JOIN contents c ON n.content_id = c.id
+	LEFT JOIN users u ON n.latest_actor_id = u.id
+`
+
+func scanNotification(row rowScanner) (*entity.Notification, error) {
+	var (
+		notification  entity.Notification
+		contentID     sql.NullInt64
+		commentID     sql.NullInt64
+		actorID       sql.NullInt64
+		actorUsername sql.NullString
+		actorAvatar   sql.NullString
+		readAt        sql.NullTime
+	)
+	err := row.Scan(
+		&notification.ID,
+		&notification.UserID,
+		&notification.Type,
+		&notification.GroupKey,
+		&contentID,
+		&commentID,
+		&notification.ContentTitle,
+		&actorID,
+		&actorUsername,
+		&actorAvatar,
+		&notification.ActorCount,
+		&notification.IsRead,
+		&readAt,
+		&notification.CreatedAt,
+		&notification.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if contentID.Valid {
+		notification.ContentID = &contentID.Int64
+	}
+	if commentID.Valid {
+		notification.CommentID = &commentID.Int64
+	}
+	if actorID.Valid {
+		notification.LatestActor = &entity.NotificationActor{
+			ID:       actorID.Int64,
+			Username: actorUsername.String,
+			Avatar:   actorAvatar.String,
+		}
+	}
+	if readAt.Valid {
+		notification.ReadAt = &readAt.Time
+	}
+
+	return &notification, nil
+}
+
+// Find は指定したIDの通知を取得します
+func (r *notificationRepository) Find(ctx context.Context, id int64) (*entity.Notification, error) {
+	notification, err := scanNotification(r.db.QueryRowContext(ctx, notificationSelect+` WHERE n.id = $1`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.NewNotFoundError("notification", id)
+		}
+		return nil, fmt.Errorf("failed to find notification: %w", err)
+	}
+
+	return notification, nil
+}
+
+// FindByUser はユーザーの通知を更新日時の新しい順に取得します（unreadOnlyがtrueの場合は未読のみ）
+func (r *notificationRepository) FindByUser(ctx context.Context, userID int64, unreadOnly bool, limit, offset int) ([]*entity.Notification, error) {
+	query := notificationSelect + `
+		WHERE n.user_id = $1 AND n.actor_count > 0 AND (NOT $2 OR NOT n.is_read)
+		ORDER BY n.updated_at DESC, n.id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notifications: %w", err)
+	}
+	defer rows.Close()
+
+	notifications := []*entity.Notification{}
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification: %w", err)
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// CountByUser はユーザーの通知数を取得します（unreadOnlyがtrueの場合は未読のみ）
+func (r *notificationRepository) CountByUser(ctx context.Context, userID int64, unreadOnly bool) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM notifications
+		WHERE user_id = $1 AND actor_count > 0 AND (NOT $2 OR NOT is_read)
+	`
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, userID, unreadOnly).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	return count, nil
+}
+
+// MarkRead はユーザーの通知を既読にします（ユーザーの通知でない場合はNotFoundError）
+func (r *notificationRepository) MarkRead(ctx context.Context, id, userID int64) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE notifications
+		SET is_read = TRUE, read_at = COALESCE(read_at, NOW())
+		WHERE id = $1 AND user_id = $2
+	`, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to mark notification as read: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("notification", id)
+	}
+
+	return nil
+}
+
+// MarkAllRead はユーザーの未読の通知をすべて既読にし、既読にした件数を返します
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID int64) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE notifications
+		SET is_read = TRUE, read_at = NOW()
+		WHERE user_id = $1 AND NOT is_read
+	`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications as read: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
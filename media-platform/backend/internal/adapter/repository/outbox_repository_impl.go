@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+)
+
+type outboxRepository struct {
+	db *sql.DB
+}
+
+// NewOutboxRepository はOutboxRepositoryを作成します
+func NewOutboxRepository(db *sql.DB) repository.OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+const outboxEventColumns = `id, event_id, event_type, payload, status, attempts, next_attempt_at, last_error, dispatched_at, occurred_at`
+
+func scanOutboxEvent(row rowScanner) (*entity.OutboxEvent, error) {
+	var (
+		event        entity.OutboxEvent
+		payload      []byte
+		dispatchedAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&event.ID,
+		&event.EventID,
+		&event.Type,
+		&payload,
+		&event.Status,
+		&event.Attempts,
+		&event.NextAttemptAt,
+		&event.LastError,
+		&dispatchedAt,
+		&event.OccurredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	event.Payload = payload
+	if dispatchedAt.Valid {
+		event.DispatchedAt = &dispatchedAt.Time
+	}
+
+	return &event, nil
+}
+
+// Create はイベントを保存します
+// コンテキストにトランザクションがある場合はそのトランザクションで保存します
+func (r *outboxRepository) Create(ctx context.Context, events []*entity.OutboxEvent) error {
+	query := `
+		INSERT INTO outbox_events (event_id, event_type, payload, status, next_attempt_at, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	executor := executorFrom(ctx, r.db)
+	for _, event := range events {
+		err := executor.QueryRowContext(ctx, query,
+			event.EventID,
+			event.Type,
+			string(event.Payload),
+			event.Status,
+			event.NextAttemptAt,
+			event.OccurredAt,
+		).Scan(&event.ID)
+		if err != nil {
+			return fmt.Errorf("failed to create outbox event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ClaimPending はnow以前に予定された配信待ちのイベントを発生順に最大limit件取得し、leaseUntilまで他のワーカーが取得しないようにします
+// 複数のインスタンスで同時に実行しても同じイベントを取得しないよう SKIP LOCKED で行をロックします
+func (r *outboxRepository) ClaimPending(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.OutboxEvent, error) {
+	query := `
+		WITH claimed AS (
+			UPDATE outbox_events
+			SET next_attempt_at = $2
+			WHERE id IN (
+				SELECT id FROM outbox_events
+				WHERE status = 'pending' AND next_attempt_at <= $1
+				ORDER BY id
+				LIMIT $3
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING ` + outboxEventColumns + `
+		)
+		SELECT ` + outboxEventColumns + ` FROM claimed ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, now, leaseUntil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []*entity.OutboxEvent{}
+	for rows.Next() {
+		event, err := scanOutboxEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return events, nil
+}
+
+// Update はディスパッチの結果を保存します
+func (r *outboxRepository) Update(ctx context.Context, event *entity.OutboxEvent) error {
+	query := `
+		UPDATE outbox_events
+		SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5, dispatched_at = $6
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		event.ID,
+		event.Status,
+		event.Attempts,
+		event.NextAttemptAt,
+		event.LastError,
+		event.DispatchedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update outbox event: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("outbox event", event.ID)
+	}
+
+	return nil
+}
+
+// DeleteDispatchedBefore はbefore以前に配信済みになったイベントを削除し、削除した件数を返します
+func (r *outboxRepository) DeleteDispatchedBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM outbox_events WHERE status = 'dispatched' AND dispatched_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete dispatched outbox events: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return deleted, nil
+}
@@ -76,38 +76,90 @@ func (r *RatingRepositoryImpl) FindByUserAndContentID(ctx context.Context, userI
 	return rating, nil
 }
 
-func (r *RatingRepositoryImpl) FindByContentID(ctx context.Context, contentID int64) ([]*entity.Rating, error) {
-	query := `
-		SELECT id, value, user_id, content_id, created_at, updated_at
-		FROM ratings
-		WHERE content_id = $1
-		ORDER BY created_at DESC
-	`
-
-	rows, err := r.db.QueryContext(ctx, query, contentID)
+func (r *RatingRepositoryImpl) FindByContentID(ctx context.Context, contentID int64, limit, offset int, includeUser bool) ([]*entity.Rating, error) {
+	rows, err := r.db.QueryContext(ctx, ratingListQuery("r.content_id", includeUser), contentID, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query ratings by content: %w", err)
 	}
 	defer rows.Close()
 
-	return r.scanRatingRows(rows)
+	return r.scanRatingRows(rows, includeUser)
 }
 
-func (r *RatingRepositoryImpl) FindByUserID(ctx context.Context, userID int64) ([]*entity.Rating, error) {
-	query := `
-		SELECT id, value, user_id, content_id, created_at, updated_at
-		FROM ratings
-		WHERE user_id = $1
-		ORDER BY created_at DESC
-	`
-
-	rows, err := r.db.QueryContext(ctx, query, userID)
+func (r *RatingRepositoryImpl) FindByUserID(ctx context.Context, userID int64, limit, offset int, includeUser bool) ([]*entity.Rating, error) {
+	rows, err := r.db.QueryContext(ctx, ratingListQuery("r.user_id", includeUser), userID, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query ratings by user: %w", err)
 	}
 	defer rows.Close()
 
-	return r.scanRatingRows(rows)
+	return r.scanRatingRows(rows, includeUser)
+}
+
+func (r *RatingRepositoryImpl) CountByUserID(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM ratings WHERE user_id = $1`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count ratings by user: %w", err)
+	}
+	return count, nil
+}
+
+// ratingListQuery は評価一覧の取得クエリを組み立てます（filterColumnは固定のカラム名のみ渡すこと）
+// includeUserがtrueの場合は評価したユーザーの概要をJOINで同時に取得します
+func ratingListQuery(filterColumn string, includeUser bool) string {
+	columns := "r.id, r.value, r.user_id, r.content_id, r.created_at, r.updated_at"
+	join := ""
+	if includeUser {
+		columns += ", u.username, u.avatar"
+		join = "JOIN users u ON u.id = r.user_id"
+	}
+
+	return fmt.Sprintf(`
+		SELECT %s
+		FROM ratings r
+		%s
+		WHERE %s = $1
+		ORDER BY r.created_at DESC, r.id DESC
+		LIMIT $2 OFFSET $3
+	`, columns, join, filterColumn)
+}
+
+// scanRatingRows は共通のスキャン処理（includeUserがtrueの場合はユーザーの概要も読み取る）
+func (r *RatingRepositoryImpl) scanRatingRows(rows *sql.Rows, includeUser bool) ([]*entity.Rating, error) {
+	ratings := []*entity.Rating{}
+	for rows.Next() {
+		rating := &entity.Rating{}
+		dest := []interface{}{
+			&rating.ID,
+			&rating.Value,
+			&rating.UserID,
+			&rating.ContentID,
+			&rating.CreatedAt,
+			&rating.UpdatedAt,
+		}
+
+		user := &entity.RatingUser{}
+		if includeUser {
+			dest = append(dest, &user.Username, &user.Avatar)
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan rating: %w", err)
+		}
+
+		if includeUser {
+			user.ID = rating.UserID
+			rating.User = user
+		}
+		ratings = append(ratings, rating)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return ratings, nil
 }
 
 func (r *RatingRepositoryImpl) Create(ctx context.Context, rating *entity.Rating) error {
@@ -158,6 +210,141 @@ func (r *RatingRepositoryImpl) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
+// likeQueries はいいね操作ごとのクエリです
+// 各クエリは (操作前のいいね数, 操作前の評価ID, 追加した評価ID, 削除したかどうか) を返します
+// CTE内の変更はメインのSELECTから見えないため、操作後のいいね数は差分から求めます
+var likeQueries = map[entity.LikeAction]string{
+	entity.LikeActionLike: `
+		WITH target AS (
+			SELECT id, like_count FROM contents WHERE id = $2
+		),
+		inserted AS (
+			INSERT INTO ratings (value, user_id, content_id, created_at, updated_at)
+			SELECT 1, $1, id, NOW(), NOW() FROM target
+			ON CONFLICT (user_id, content_id) DO NOTHING
+			RETURNING id
+		)
+		SELECT
+			(SELECT like_count FROM target),
+			(SELECT id FROM ratings WHERE user_id = $1 AND content_id = $2),
+			(SELECT id FROM inserted),
+			FALSE
+	`,
+	entity.LikeActionUnlike: `
+		WITH target AS (
+			SELECT id, like_count FROM contents WHERE id = $2
+		),
+		removed AS (
+			DELETE FROM ratings WHERE user_id = $1 AND content_id = $2
+			RETURNING id
+		)
+		SELECT
+			(SELECT like_count FROM target),
+			NULL::BIGINT,
+			NULL::BIGINT,
+			EXISTS (SELECT 1 FROM removed)
+	`,
+	entity.LikeActionToggle: `
+		WITH target AS (
+			SELECT id, like_count FROM contents WHERE id = $2
+		),
+		removed AS (
+			DELETE FROM ratings WHERE user_id = $1 AND content_id = $2
+			RETURNING id
+		),
+		inserted AS (
+			INSERT INTO ratings (value, user_id, content_id, created_at, updated_at)
+			SELECT 1, $1, id, NOW(), NOW() FROM target
+			WHERE NOT EXISTS (SELECT 1 FROM removed)
+			ON CONFLICT (user_id, content_id) DO NOTHING
+			RETURNING id
+		)
+		SELECT
+			(SELECT like_count FROM target),
+			(SELECT id FROM ratings WHERE user_id = $1 AND content_id = $2),
+			(SELECT id FROM inserted),
+			EXISTS (SELECT 1 FROM removed)
+	`,
+}
+
+func (r *RatingRepositoryImpl) ApplyLike(ctx context.Context, userID, contentID int64, action entity.LikeAction) (*entity.LikeState, error) {
+	query, ok := likeQueries[action]
+	if !ok {
+		return nil, domainErrors.NewValidationError("無効ないいね操作です")
+	}
+
+	var likeCount, existingID, insertedID sql.NullInt64
+	var removed bool
+	err := executorFrom(ctx, r.db).QueryRowContext(ctx, query, userID, contentID).Scan(&likeCount, &existingID, &insertedID, &removed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply like: %w", err)
+	}
+
+	// 対象のコンテンツが存在しない
+	if !likeCount.Valid {
+		return nil, domainErrors.NewNotFoundError("content", contentID)
+	}
+
+	state := &entity.LikeState{
+		ContentID: contentID,
+		UserID:    userID,
+		Changed:   insertedID.Valid || removed,
+		LikeCount: likeCount.Int64,
+	}
+	if insertedID.Valid {
+		state.LikeCount++
+	}
+	if removed && state.LikeCount > 0 {
+		state.LikeCount--
+	}
+
+	switch {
+	case insertedID.Valid:
+		state.Liked = true
+		state.RatingID = &insertedID.Int64
+	case removed:
+		state.Liked = false
+	case action == entity.LikeActionUnlike:
+		state.Liked = false
+	default:
+		// 既にいいね済み（トグルでは同時リクエストが先にいいねした場合）
+		state.Liked = true
+		if existingID.Valid {
+			state.RatingID = &existingID.Int64
+		}
+	}
+
+	return state, nil
+}
+
+func (r *RatingRepositoryImpl) DeleteLikeByID(ctx context.Context, id, userID int64, allowOthers bool) (*entity.LikeState, error) {
+	query := `
+		WITH removed AS (
+			DELETE FROM ratings
+			WHERE id = $1 AND (user_id = $2 OR $3)
+			RETURNING content_id, user_id
+		)
+		SELECT r.content_id, r.user_id, c.like_count
+		FROM removed r
+		JOIN contents c ON c.id = r.content_id
+	`
+
+	state := &entity.LikeState{Changed: true}
+	err := executorFrom(ctx, r.db).QueryRowContext(ctx, query, id, userID, allowOthers).Scan(&state.ContentID, &state.UserID, &state.LikeCount)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to delete like: %w", err)
+	}
+
+	if state.LikeCount > 0 {
+		state.LikeCount--
+	}
+
+	return state, nil
+}
+
 func (r *RatingRepositoryImpl) GetStatsByContentID(ctx context.Context, contentID int64) (*entity.RatingStats, error) {
 	query := `SELECT COUNT(*) FROM ratings WHERE content_id = $1 AND value = 1`
 
@@ -167,7 +354,14 @@ func (r *RatingRepositoryImpl) GetStatsByContentID(ctx context.Context, contentI
 		return nil, fmt.Errorf("failed to get rating stats: %w", err)
 	}
 
-	return entity.NewRatingStats(contentID, likeCount), nil
+	statsMap := map[int64]*entity.RatingStats{
+		contentID: entity.NewRatingStats(contentID, likeCount),
+	}
+	if err := r.addStarStats(ctx, statsMap, []int64{contentID}); err != nil {
+		return nil, err
+	}
+
+	return statsMap[contentID], nil
 }
 
 func (r *RatingRepositoryImpl) GetStatsByContentIDs(ctx context.Context, contentIDs []int64) (map[int64]*entity.RatingStats, error) {
@@ -209,9 +403,46 @@ func (r *RatingRepositoryImpl) GetStatsByContentIDs(ctx context.Context, content
 		}
 	}
 
+	if err := r.addStarStats(ctx, statsMap, contentIDs); err != nil {
+		return nil, err
+	}
+
 	return statsMap, nil
 }
 
+// addStarStats は星評価のヒストグラムを集計し、統計に加えます
+func (r *RatingRepositoryImpl) addStarStats(ctx context.Context, statsMap map[int64]*entity.RatingStats, contentIDs []int64) error {
+	query := `
+		SELECT content_id, stars, COUNT(*)
+		FROM star_ratings
+		WHERE content_id = ANY($1)
+		GROUP BY content_id, stars
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(contentIDs))
+	if err != nil {
+		return fmt.Errorf("failed to get star rating stats: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var contentID int64
+		var stars, count int
+		if err := rows.Scan(&contentID, &stars, &count); err != nil {
+			return fmt.Errorf("failed to scan star rating stats: %w", err)
+		}
+		if stats, ok := statsMap[contentID]; ok {
+			stats.AddStars(stars, count)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return nil
+}
+
 func (r *RatingRepositoryImpl) FindContentIDsByUserID(ctx context.Context, userID int64, limit, offset int) ([]int64, error) {
 	query := `
 		SELECT content_id 
@@ -261,7 +492,7 @@ func (r *RatingRepositoryImpl) CountByDateRange(ctx context.Context, contentID i
 	return count, nil
 }
 
-func (r *RatingRepositoryImpl) FindTopRatedContentIDs(ctx context.Context, limit, days int) ([]int64, error) {
+func (r *RatingRepositoryImpl) FindTopRatedContentIDs(ctx context.Context, limit, days int, rankBy entity.RatingRankMode) ([]int64, error) {
 	query := `
 		SELECT content_id, COUNT(*) as like_count
 		FROM ratings 
@@ -270,8 +501,30 @@ func (r *RatingRepositoryImpl) FindTopRatedContentIDs(ctx context.Context, limit
 		ORDER BY like_count DESC, content_id ASC
 		LIMIT $2
 	`
+	args := []interface{}{days, limit}
+
+	if rankBy == entity.RatingRankByBayesian {
+		// ベイズ平均: (C × 全体平均 + 星の合計) / (C + 件数)
+		// 全体平均は全期間の星評価から求め、評価がない場合は中央値の3とする
+		query = `
+			WITH prior AS (
+				SELECT COALESCE(AVG(stars), 3.0) AS mean FROM star_ratings
+			),
+			scored AS (
+				SELECT content_id, COUNT(*) AS star_count, SUM(stars) AS star_sum
+				FROM star_ratings
+				WHERE created_at >= NOW() - INTERVAL '1 day' * $1
+				GROUP BY content_id
+			)
+			SELECT s.content_id, (s.star_sum + $3 * p.mean) / (s.star_count + $3) AS weighted
+			FROM scored s CROSS JOIN prior p
+			ORDER BY weighted DESC, s.star_count DESC, s.content_id ASC
+			LIMIT $2
+		`
+		args = append(args, entity.BayesianPriorWeight)
+	}
 
-	rows, err := r.db.QueryContext(ctx, query, days, limit)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query top rated contents: %w", err)
 	}
@@ -280,8 +533,8 @@ func (r *RatingRepositoryImpl) FindTopRatedContentIDs(ctx context.Context, limit
 	var contentIDs []int64
 	for rows.Next() {
 		var contentID int64
-		var likeCount int64
-		if err := rows.Scan(&contentID, &likeCount); err != nil {
+		var score float64
+		if err := rows.Scan(&contentID, &score); err != nil {
 			return nil, fmt.Errorf("failed to scan content ID: %w", err)
 		}
 		contentIDs = append(contentIDs, contentID)
@@ -294,28 +547,75 @@ func (r *RatingRepositoryImpl) FindTopRatedContentIDs(ctx context.Context, limit
 	return contentIDs, nil
 }
 
-// scanRatingRows は共通のスキャン処理
-func (r *RatingRepositoryImpl) scanRatingRows(rows *sql.Rows) ([]*entity.Rating, error) {
-	var ratings []*entity.Rating
-	for rows.Next() {
-		rating := &entity.Rating{}
-		err := rows.Scan(
-			&rating.ID,
-			&rating.Value,
-			&rating.UserID,
-			&rating.ContentID,
-			&rating.CreatedAt,
-			&rating.UpdatedAt,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan rating: %w", err)
+func (r *RatingRepositoryImpl) FindStarRating(ctx context.Context, userID, contentID int64) (*entity.StarRating, error) {
+	query := `
+		SELECT id, stars, user_id, content_id, created_at, updated_at
+		FROM star_ratings
+		WHERE user_id = $1 AND content_id = $2
+	`
+
+	rating := &entity.StarRating{}
+	err := r.db.QueryRowContext(ctx, query, userID, contentID).Scan(
+		&rating.ID,
+		&rating.Stars,
+		&rating.UserID,
+		&rating.ContentID,
+		&rating.CreatedAt,
+		&rating.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // 存在しない場合はnilを返す（エラーではない）
 		}
-		ratings = append(ratings, rating)
+		return nil, fmt.Errorf("failed to find star rating: %w", err)
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("row iteration error: %w", err)
+	return rating, nil
+}
+
+func (r *RatingRepositoryImpl) UpsertStarRating(ctx context.Context, rating *entity.StarRating) error {
+	query := `
+		INSERT INTO star_ratings (stars, user_id, content_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, content_id)
+		DO UPDATE SET stars = EXCLUDED.stars, updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		rating.Stars,
+		rating.UserID,
+		rating.ContentID,
+		rating.CreatedAt,
+		rating.UpdatedAt,
+	).Scan(&rating.ID, &rating.CreatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to upsert star rating: %w", err)
 	}
 
-	return ratings, nil
+	return nil
+}
+
+func (r *RatingRepositoryImpl) DeleteStarRating(ctx context.Context, userID, contentID int64) error {
+	query := `DELETE FROM star_ratings WHERE user_id = $1 AND content_id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, userID, contentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete star rating: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("star rating", contentID)
+	}
+
+	return nil
 }
@@ -0,0 +1,321 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+
+	"github.com/lib/pq"
+)
+
+type reactionRepository struct {
+	db *sql.DB
+}
+
+// NewReactionRepository はReactionRepositoryを作成します
+func NewReactionRepository(db *sql.DB) repository.ReactionRepository {
+	return &reactionRepository{db: db}
+}
+
+// reactionKindColumns はリアクションの種類の取得クエリで共通して使用するカラムです（scanReactionKindと順序を揃えること）
+const reactionKindColumns = `id, key, emoji, label, sort_order, is_active, created_at, updated_at`
+
+// reactionTargetColumn は対象の種類に対応するカラム名を返します
+func reactionTargetColumn(targetType entity.ReactionTarget) (string, error) {
+	switch targetType {
+	case entity.ReactionTargetContent:
+		return "content_id", nil
+	case entity.ReactionTargetComment:
+		return "comment_id", nil
+	}
+	return "", domainErrors.NewValidationError("リアクションの対象は content または comment である必要があります")
+}
+
+// FindKinds はリアクションの種類を表示順で取得します
+func (r *reactionRepository) FindKinds(ctx context.Context, includeInactive bool) ([]*entity.ReactionKind, error) {
+	query := `
+		SELECT ` + reactionKindColumns + `
+		FROM reaction_kinds
+		WHERE $1 OR is_active = TRUE
+		ORDER BY sort_order, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, includeInactive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reaction kinds: %w", err)
+	}
+	defer rows.Close()
+
+	var kinds []*entity.ReactionKind
+	for rows.Next() {
+		kind, err := scanReactionKind(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reaction kind: %w", err)
+		}
+		kinds = append(kinds, kind)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return kinds, nil
+}
+
+// FindKind は指定されたIDのリアクションの種類を取得します
+func (r *reactionRepository) FindKind(ctx context.Context, id int64) (*entity.ReactionKind, error) {
+	query := `SELECT ` + reactionKindColumns + ` FROM reaction_kinds WHERE id = $1`
+
+	kind, err := scanReactionKind(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.NewNotFoundError("reaction kind", id)
+		}
+		return nil, fmt.Errorf("failed to find reaction kind: %w", err)
+	}
+
+	return kind, nil
+}
+
+// FindKindByKey は指定されたキーのリアクションの種類を取得します
+func (r *reactionRepository) FindKindByKey(ctx context.Context, key string) (*entity.ReactionKind, error) {
+	query := `SELECT ` + reactionKindColumns + ` FROM reaction_kinds WHERE key = $1`
+
+	kind, err := scanReactionKind(r.db.QueryRowContext(ctx, query, key))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.NewNotFoundError("reaction kind", key)
+		}
+		return nil, fmt.Errorf("failed to find reaction kind: %w", err)
+	}
+
+	return kind, nil
+}
+
+// CreateKind は新しいリアクションの種類を作成します
+func (r *reactionRepository) CreateKind(ctx context.Context, kind *entity.ReactionKind) error {
+	query := `
+		INSERT INTO reaction_kinds (key, emoji, label, sort_order, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		kind.Key,
+		kind.Emoji,
+		kind.Label,
+		kind.SortOrder,
+		kind.IsActive,
+		kind.CreatedAt,
+		kind.UpdatedAt,
+	).Scan(&kind.ID)
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return domainErrors.NewConflictError("reaction kind", "key or emoji already exists")
+		}
+		return fmt.Errorf("failed to create reaction kind: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateKind は既存のリアクションの種類を更新します
+func (r *reactionRepository) UpdateKind(ctx context.Context, kind *entity.ReactionKind) error {
+	query := `
+		UPDATE reaction_kinds
+		SET emoji = $1, label = $2, sort_order = $3, is_active = $4, updated_at = $5
+		WHERE id = $6
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		kind.Emoji,
+		kind.Label,
+		kind.SortOrder,
+		kind.IsActive,
+		kind.UpdatedAt,
+		kind.ID,
+	)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return domainErrors.NewConflictError("reaction kind", "emoji already exists")
+		}
+		return fmt.Errorf("failed to update reaction kind: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("reaction kind", kind.ID)
+	}
+
+	return nil
+}
+
+// DeleteKind は指定されたIDのリアクションの種類を削除します
+func (r *reactionRepository) DeleteKind(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM reaction_kinds WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete reaction kind: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("reaction kind", id)
+	}
+
+	return nil
+}
+
+// Find はユーザーが対象に付けた指定の種類のリアクションを取得します
+func (r *reactionRepository) Find(ctx context.Context, userID, kindID int64, targetType entity.ReactionTarget, targetID int64) (*entity.Reaction, error) {
+	column, err := reactionTargetColumn(targetType)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT id, user_id, kind_id, created_at
+		FROM reactions
+		WHERE user_id = $1 AND kind_id = $2 AND ` + column + ` = $3
+	`
+
+	reaction := &entity.Reaction{TargetType: targetType, TargetID: targetID}
+	err = r.db.QueryRowContext(ctx, query, userID, kindID, targetID).Scan(
+		&reaction.ID,
+		&reaction.UserID,
+		&reaction.KindID,
+		&reaction.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // 存在しない場合はnilを返す（エラーではない）
+		}
+		return nil, fmt.Errorf("failed to find reaction: %w", err)
+	}
+
+	return reaction, nil
+}
+
+// Create は新しいリアクションを作成します
+func (r *reactionRepository) Create(ctx context.Context, reaction *entity.Reaction) error {
+	column, err := reactionTargetColumn(reaction.TargetType)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO reactions (user_id, kind_id, ` + column + `, created_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+
+	err = r.db.QueryRowContext(ctx, query,
+		reaction.UserID,
+		reaction.KindID,
+		reaction.TargetID,
+		reaction.CreatedAt,
+	).Scan(&reaction.ID)
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23505":
+				return domainErrors.NewConflictError("reaction", "already reacted")
+			case "23503":
+				return domainErrors.NewValidationError("リアクションの対象が存在しません")
+			}
+		}
+		return fmt.Errorf("failed to create reaction: %w", err)
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDのリアクションを削除します
+func (r *reactionRepository) Delete(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM reactions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete reaction: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("reaction", id)
+	}
+
+	return nil
+}
+
+// CountByTargets は複数の対象のリアクション数を種類ごとに一括取得します
+func (r *reactionRepository) CountByTargets(ctx context.Context, targetType entity.ReactionTarget, targetIDs []int64) ([]*entity.ReactionCount, error) {
+	if len(targetIDs) == 0 {
+		return []*entity.ReactionCount{}, nil
+	}
+
+	column, err := reactionTargetColumn(targetType)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + column + `, kind_id, COUNT(*)
+		FROM reactions
+		WHERE ` + column + ` = ANY($1)
+		GROUP BY ` + column + `, kind_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(targetIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count reactions: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []*entity.ReactionCount
+	for rows.Next() {
+		count := &entity.ReactionCount{}
+		if err := rows.Scan(&count.TargetID, &count.KindID, &count.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan reaction count: %w", err)
+		}
+		counts = append(counts, count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return counts, nil
+}
+
+func scanReactionKind(row rowScanner) (*entity.ReactionKind, error) {
+	kind := &entity.ReactionKind{}
+	err := row.Scan(
+		&kind.ID,
+		&kind.Key,
+		&kind.Emoji,
+		&kind.Label,
+		&kind.SortOrder,
+		&kind.IsActive,
+		&kind.CreatedAt,
+		&kind.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return kind, nil
+}
@@ -0,0 +1,294 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+
+	"github.com/lib/pq"
+)
+
+type scoreAxisRepository struct {
+	db *sql.DB
+}
+
+// NewScoreAxisRepository はScoreAxisRepositoryを作成します
+func NewScoreAxisRepository(db *sql.DB) repository.ScoreAxisRepository {
+	return &scoreAxisRepository{db: db}
+}
+
+// scoreAxisColumns は評価軸取得クエリで共通して使用するカラムです（scanScoreAxisと順序を揃えること）
+const scoreAxisColumns = `id, content_type, key, label, sort_order, created_at, updated_at`
+
+// FindByType は指定されたコンテンツタイプの評価軸を表示順で取得します
+func (r *scoreAxisRepository) FindByType(ctx context.Context, contentType entity.ContentType) ([]*entity.ScoreAxis, error) {
+	query := `
+		SELECT ` + scoreAxisColumns + `
+		FROM score_axes
+		WHERE content_type = $1
+		ORDER BY sort_order, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, string(contentType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query score axes: %w", err)
+	}
+	defer rows.Close()
+
+	var axes []*entity.ScoreAxis
+	for rows.Next() {
+		axis, err := scanScoreAxis(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan score axis: %w", err)
+		}
+		axes = append(axes, axis)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return axes, nil
+}
+
+// Find は指定されたIDの評価軸を取得します
+func (r *scoreAxisRepository) Find(ctx context.Context, id int64) (*entity.ScoreAxis, error) {
+	query := `SELECT ` + scoreAxisColumns + ` FROM score_axes WHERE id = $1`
+
+	axis, err := scanScoreAxis(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.NewNotFoundError("score axis", id)
+		}
+		return nil, fmt.Errorf("failed to find score axis: %w", err)
+	}
+
+	return axis, nil
+}
+
+// Create は新しい評価軸を作成します
+func (r *scoreAxisRepository) Create(ctx context.Context, axis *entity.ScoreAxis) error {
+	query := `
+		INSERT INTO score_axes (content_type, key, label, sort_order, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		string(axis.ContentType),
+		axis.Key,
+		axis.Label,
+		axis.SortOrder,
+		axis.CreatedAt,
+		axis.UpdatedAt,
+	).Scan(&axis.ID)
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23505": // unique_violation
+				return domainErrors.NewConflictError("score axis", "score axis already exists")
+			case "23503": // foreign_key_violation
+				return domainErrors.NewValidationError("無効なコンテンツタイプです")
+			}
+		}
+		return fmt.Errorf("failed to create score axis: %w", err)
+	}
+
+	return nil
+}
+
+// Update は既存の評価軸を更新します
+func (r *scoreAxisRepository) Update(ctx context.Context, axis *entity.ScoreAxis) error {
+	query := `
+		UPDATE score_axes
+		SET label = $1, sort_order = $2, updated_at = $3
+		WHERE id = $4
+	`
+
+	result, err := r.db.ExecContext(ctx, query, axis.Label, axis.SortOrder, axis.UpdatedAt, axis.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update score axis: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("score axis", axis.ID)
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDの評価軸を削除します
+func (r *scoreAxisRepository) Delete(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM score_axes WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete score axis: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("score axis", id)
+	}
+
+	return nil
+}
+
+// FindScoresByContent はコンテンツの軸別スコアを評価軸の表示順で取得します
+func (r *scoreAxisRepository) FindScoresByContent(ctx context.Context, contentID int64) ([]*entity.AxisScore, error) {
+	query := `
+		SELECT a.id, a.key, a.label, s.score::float8
+		FROM content_axis_scores s
+		JOIN score_axes a ON s.axis_id = a.id
+		WHERE s.content_id = $1
+		ORDER BY a.sort_order, a.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, contentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query axis scores: %w", err)
+	}
+	defer rows.Close()
+
+	var scores []*entity.AxisScore
+	for rows.Next() {
+		var score entity.AxisScore
+		if err := rows.Scan(&score.AxisID, &score.Key, &score.Label, &score.Score); err != nil {
+			return nil, fmt.Errorf("failed to scan axis score: %w", err)
+		}
+		scores = append(scores, &score)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return scores, nil
+}
+
+// ReplaceContentScores はコンテンツの軸別スコアを1トランザクションで置き換えます
+func (r *scoreAxisRepository) ReplaceContentScores(ctx context.Context, contentID int64, scores []*entity.AxisScore) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM content_axis_scores WHERE content_id = $1`, contentID); err != nil {
+		return fmt.Errorf("failed to delete axis scores: %w", err)
+	}
+
+	for _, score := range scores {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO content_axis_scores (content_id, axis_id, score) VALUES ($1, $2, $3)`,
+			contentID, score.AxisID, score.Score,
+		)
+		if err != nil {
+			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" { // foreign_key_violation
+				return domainErrors.NewValidationError("指定された評価軸が存在しません")
+			}
+			return fmt.Errorf("failed to insert axis score: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit axis scores: %w", err)
+	}
+
+	return nil
+}
+
+// GetWorkAxisStats は作品に紐づく公開済みレビューの軸別スコアを集計します
+func (r *scoreAxisRepository) GetWorkAxisStats(ctx context.Context, workID int64, axes []*entity.ScoreAxis) ([]*entity.AxisScoreStats, error) {
+	statsByAxis := make(map[int64]*entity.AxisScoreStats, len(axes))
+	result := make([]*entity.AxisScoreStats, 0, len(axes))
+	for _, axis := range axes {
+		stats := entity.NewAxisScoreStats(axis)
+		statsByAxis[axis.ID] = stats
+		result = append(result, stats)
+	}
+	if len(axes) == 0 {
+		return result, nil
+	}
+
+	// 軸ごと・整数部ごとの件数と合計（平均は合計から算出）
+	query := `
+		SELECT s.axis_id, LEAST(FLOOR(s.score), 5)::int AS bucket, COUNT(*), SUM(s.score)::float8
+		FROM content_axis_scores s
+		JOIN contents c ON s.content_id = c.id
+		WHERE c.work_id = $1 AND c.status = 'published' AND c.published_at <= NOW()
+		GROUP BY s.axis_id, bucket
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work axis stats: %w", err)
+	}
+	defer rows.Close()
+
+	sums := make(map[int64]float64, len(axes))
+	for rows.Next() {
+		var axisID int64
+		var bucket, count int
+		var sum float64
+		if err := rows.Scan(&axisID, &bucket, &count, &sum); err != nil {
+			return nil, fmt.Errorf("failed to scan work axis stats: %w", err)
+		}
+
+		stats, ok := statsByAxis[axisID]
+		if !ok {
+			// 作品の種類と異なる軸のスコア（種類変更前のレビューなど）は集計しない
+			continue
+		}
+		stats.Distribution[entity.AxisScoreBucket(float64(bucket))] += count
+		stats.Count += count
+		sums[axisID] += sum
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	for axisID, stats := range statsByAxis {
+		if stats.Count > 0 {
+			average := sums[axisID] / float64(stats.Count)
+			stats.Average = &average
+		}
+	}
+
+	return result, nil
+}
+
+// scanScoreAxis はscoreAxisColumnsの順で評価軸を読み込みます
+func scanScoreAxis(row rowScanner) (*entity.ScoreAxis, error) {
+	var axis entity.ScoreAxis
+	var contentType string
+
+	err := row.Scan(
+		&axis.ID,
+		&contentType,
+		&axis.Key,
+		&axis.Label,
+		&axis.SortOrder,
+		&axis.CreatedAt,
+		&axis.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	axis.ContentType = entity.ContentType(contentType)
+	return &axis, nil
+}
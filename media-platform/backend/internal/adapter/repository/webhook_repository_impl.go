@@ -0,0 +1,408 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+
+	"github.com/lib/pq"
+)
+
+type webhookRepository struct {
+	db *sql.DB
+}
+
+// NewWebhookRepository はWebhookRepositoryを作成します
+func NewWebhookRepository(db *sql.DB) repository.WebhookRepository {
+	return &webhookRepository{db: db}
+}
+
+const webhookColumns = `id, owner_id, url, secret, events, description, is_active, created_at, updated_at`
+
+func scanWebhook(row rowScanner) (*entity.Webhook, error) {
+	var (
+		webhook entity.Webhook
+		events  pq.StringArray
+	)
+	err := row.Scan(
+		&webhook.ID,
+		&webhook.OwnerID,
+		&webhook.URL,
+		&webhook.Secret,
+		&events,
+		&webhook.Description,
+		&webhook.IsActive,
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.Events = make([]entity.WebhookEventType, len(events))
+	for i, event := range events {
+		webhook.Events[i] = entity.WebhookEventType(event)
+	}
+	return &webhook, nil
+}
+
+func webhookEventsArray(events []entity.WebhookEventType) pq.StringArray {
+	array := make(pq.StringArray, len(events))
+	for i, event := range events {
+		array[i] = string(event)
+	}
+	return array
+}
+
+// Find は指定されたIDのWebhookを取得します
+func (r *webhookRepository) Find(ctx context.Context, id int64) (*entity.Webhook, error) {
+	webhook, err := scanWebhook(r.db.QueryRowContext(ctx, `SELECT `+webhookColumns+` FROM webhooks WHERE id = $1`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.NewNotFoundError("webhook", id)
+		}
+		return nil, fmt.Errorf("failed to find webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// FindAll はWebhookを作成日時の新しい順に取得します
+func (r *webhookRepository) FindAll(ctx context.Context, limit, offset int) ([]*entity.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhooks ORDER BY created_at DESC, id DESC LIMIT $1 OFFSET $2`
+	return r.queryWebhooks(ctx, query, limit, offset)
+}
+
+// Count はWebhookの件数を取得します
+func (r *webhookRepository) Count(ctx context.Context) (int64, error) {
+	var count int64
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM webhooks`).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count webhooks: %w", err)
+	}
+	return count, nil
+}
+
+// FindSubscribed はイベントを購読している有効なWebhookを取得します
+func (r *webhookRepository) FindSubscribed(ctx context.Context, eventType entity.WebhookEventType) ([]*entity.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE is_active AND events @> ARRAY[$1]::TEXT[] ORDER BY id`
+	return r.queryWebhooks(ctx, query, string(eventType))
+}
+
+func (r *webhookRepository) queryWebhooks(ctx context.Context, query string, args ...interface{}) ([]*entity.Webhook, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	webhooks := []*entity.Webhook{}
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %w", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// Create は新しいWebhookを作成します
+func (r *webhookRepository) Create(ctx context.Context, webhook *entity.Webhook) error {
+	query := `
+		INSERT INTO webhooks (owner_id, url, secret, events, description, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		webhook.OwnerID,
+		webhook.URL,
+		webhook.Secret,
+		webhookEventsArray(webhook.Events),
+		webhook.Description,
+		webhook.IsActive,
+		webhook.CreatedAt,
+		webhook.UpdatedAt,
+	).Scan(&webhook.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return nil
+}
+
+// Update は既存のWebhookを更新します
+func (r *webhookRepository) Update(ctx context.Context, webhook *entity.Webhook) error {
+	query := `
+		UPDATE webhooks
+		SET url = $2, secret = $3, events = $4, description = $5, is_active = $6, updated_at = $7
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		webhook.ID,
+		webhook.URL,
+		webhook.Secret,
+		webhookEventsArray(webhook.Events),
+		webhook.Description,
+		webhook.IsActive,
+		webhook.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("webhook", webhook.ID)
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDのWebhookを配信ログとともに削除します
+func (r *webhookRepository) Delete(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM webhooks WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("webhook", id)
+	}
+
+	return nil
+}
+
+// ========== 配信ログ ==========
+
+const webhookDeliveryColumns = `
+	id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_attempt_at,
+	response_status, response_body, last_error, delivered_at, created_at
+`
+
+func scanWebhookDelivery(row rowScanner) (*entity.WebhookDelivery, error) {
+	var (
+		delivery       entity.WebhookDelivery
+		lastAttemptAt  sql.NullTime
+		responseStatus sql.NullInt64
+		deliveredAt    sql.NullTime
+	)
+	err := row.Scan(
+		&delivery.ID,
+		&delivery.WebhookID,
+		&delivery.EventID,
+		&delivery.EventType,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&lastAttemptAt,
+		&responseStatus,
+		&delivery.ResponseBody,
+		&delivery.LastError,
+		&deliveredAt,
+		&delivery.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if lastAttemptAt.Valid {
+		delivery.LastAttemptAt = &lastAttemptAt.Time
+	}
+	if responseStatus.Valid {
+		status := int(responseStatus.Int64)
+		delivery.ResponseStatus = &status
+	}
+	if deliveredAt.Valid {
+		delivery.DeliveredAt = &deliveredAt.Time
+	}
+	return &delivery, nil
+}
+
+// CreateDeliveries は配信をまとめて作成します（同じWebhookへの同じイベントの配信がすでにある場合は作成しません）
+func (r *webhookRepository) CreateDeliveries(ctx context.Context, deliveries []*entity.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload, status, next_attempt_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (webhook_id, event_id) DO NOTHING
+		RETURNING id
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, delivery := range deliveries {
+		err := stmt.QueryRowContext(ctx,
+			delivery.WebhookID,
+			delivery.EventID,
+			delivery.EventType,
+			string(delivery.Payload),
+			delivery.Status,
+			delivery.NextAttemptAt,
+			delivery.CreatedAt,
+		).Scan(&delivery.ID)
+		if err == sql.ErrNoRows {
+			continue // 同じイベントの配信は作成済み
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create webhook delivery: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// FindDelivery は指定されたIDの配信を取得します
+func (r *webhookRepository) FindDelivery(ctx context.Context, id int64) (*entity.WebhookDelivery, error) {
+	delivery, err := scanWebhookDelivery(r.db.QueryRowContext(ctx, `SELECT `+webhookDeliveryColumns+` FROM webhook_deliveries WHERE id = $1`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.NewNotFoundError("webhook delivery", id)
+		}
+		return nil, fmt.Errorf("failed to find webhook delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// FindDeliveries はWebhookの配信を作成日時の新しい順に取得します（statusが空の場合はすべて）
+func (r *webhookRepository) FindDeliveries(ctx context.Context, webhookID int64, status entity.WebhookDeliveryStatus, limit, offset int) ([]*entity.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE webhook_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, webhookID, string(status), limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	return scanWebhookDeliveries(rows)
+}
+
+// CountDeliveries はWebhookの配信の件数を取得します（statusが空の場合はすべて）
+func (r *webhookRepository) CountDeliveries(ctx context.Context, webhookID int64, status entity.WebhookDeliveryStatus) (int64, error) {
+	query := `SELECT COUNT(*) FROM webhook_deliveries WHERE webhook_id = $1 AND ($2 = '' OR status = $2)`
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, webhookID, string(status)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+	return count, nil
+}
+
+// ClaimDueDeliveries はnow以前に予定された配信待ちを最大limit件取得し、leaseUntilまで他のワーカーが取得しないようにします
+// 複数のインスタンスで同時に実行しても同じ配信を取得しないよう SKIP LOCKED で行をロックします
+func (r *webhookRepository) ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries
+		SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + webhookDeliveryColumns
+
+	rows, err := r.db.QueryContext(ctx, query, now, leaseUntil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	return scanWebhookDeliveries(rows)
+}
+
+func scanWebhookDeliveries(rows *sql.Rows) ([]*entity.WebhookDelivery, error) {
+	deliveries := []*entity.WebhookDelivery{}
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// UpdateDelivery は配信の試行結果を保存します
+func (r *webhookRepository) UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, last_attempt_at = $5,
+		    response_status = $6, response_body = $7, last_error = $8, delivered_at = $9
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		delivery.ID,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.LastAttemptAt,
+		delivery.ResponseStatus,
+		delivery.ResponseBody,
+		delivery.LastError,
+		delivery.DeliveredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("webhook delivery", delivery.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,313 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+
+	"github.com/lib/pq"
+)
+
+type workRepository struct {
+	db *sql.DB
+}
+
+// NewWorkRepository はWorkRepositoryを作成します
+func NewWorkRepository(db *sql.DB) repository.WorkRepository {
+	return &workRepository{db: db}
+}
+
+// workColumns は作品取得クエリで共通して使用するカラムです（scanWorkと順序を揃えること）
+const workColumns = `
+			id, type, title, alternate_titles, creator, release_year,
+			external_ids, created_at, updated_at`
+
+// Find は指定されたIDの作品を取得します
+func (r *workRepository) Find(ctx context.Context, id int64) (*entity.Work, error) {
+	query := `
+		SELECT ` + workColumns + `
+		FROM works
+		WHERE id = $1
+	`
+
+	work, err := scanWork(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.NewNotFoundError("work", id)
+		}
+		return nil, fmt.Errorf("failed to find work: %w", err)
+	}
+
+	return work, nil
+}
+
+// FindByExternalID は外部IDで作品を取得します
+func (r *workRepository) FindByExternalID(ctx context.Context, key, value string) (*entity.Work, error) {
+	query := `
+		SELECT ` + workColumns + `
+		FROM works
+		WHERE external_ids ->> $1 = $2
+		ORDER BY id
+		LIMIT 1
+	`
+
+	work, err := scanWork(r.db.QueryRowContext(ctx, query, key, value))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domainErrors.NewNotFoundError("work", key+":"+value)
+		}
+		return nil, fmt.Errorf("failed to find work by external id: %w", err)
+	}
+
+	return work, nil
+}
+
+// Search はタイトル・別タイトル・作者名で作品を検索します
+func (r *workRepository) Search(ctx context.Context, keyword string, workType entity.ContentType, limit, offset int) ([]*entity.Work, error) {
+	keyword = strings.TrimSpace(keyword)
+
+	query := `
+		SELECT ` + workColumns + `
+		FROM works
+		WHERE ($1 = '' OR type = $1)
+			AND (
+				$2 = ''
+				OR title ILIKE $3
+				OR creator ILIKE $3
+				OR EXISTS (SELECT 1 FROM unnest(alternate_titles) AS alt WHERE alt ILIKE $3)
+			)
+		ORDER BY
+			CASE WHEN LOWER(title) = LOWER($2) THEN 0 ELSE 1 END,
+			title
+		LIMIT $4 OFFSET $5
+	`
+
+	likePattern := "%" + keyword + "%"
+	rows, err := r.db.QueryContext(ctx, query, string(workType), keyword, likePattern, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search works: %w", err)
+	}
+	defer rows.Close()
+
+	var works []*entity.Work
+	for rows.Next() {
+		work, err := scanWork(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan work: %w", err)
+		}
+		works = append(works, work)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return works, nil
+}
+
+// Create は新しい作品を作成します
+func (r *workRepository) Create(ctx context.Context, work *entity.Work) error {
+	externalIDs, err := json.Marshal(work.ExternalIDs)
+	if err != nil {
+		return fmt.Errorf("failed to marshal external ids: %w", err)
+	}
+
+	query := `
+		INSERT INTO works (
+			type, title, alternate_titles, creator, release_year,
+			external_ids, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`
+
+	err = r.db.QueryRowContext(ctx, query,
+		work.Type,
+		work.Title,
+		pq.Array(work.AlternateTitles),
+		work.Creator,
+		work.ReleaseYear,
+		externalIDs,
+		work.CreatedAt,
+		work.UpdatedAt,
+	).Scan(&work.ID)
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23505": // unique_violation
+				return domainErrors.NewConflictError("work", "work already exists")
+			case "23503": // foreign_key_violation
+				return domainErrors.NewValidationError("無効な作品タイプです")
+			}
+		}
+		return fmt.Errorf("failed to create work: %w", err)
+	}
+
+	return nil
+}
+
+// Update は既存の作品情報を更新します
+func (r *workRepository) Update(ctx context.Context, work *entity.Work) error {
+	externalIDs, err := json.Marshal(work.ExternalIDs)
+	if err != nil {
+		return fmt.Errorf("failed to marshal external ids: %w", err)
+	}
+
+	query := `
+		UPDATE works
+		SET type = $1, title = $2, alternate_titles = $3, creator = $4,
+		    release_year = $5, external_ids = $6, updated_at = $7
+		WHERE id = $8
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		work.Type,
+		work.Title,
+		pq.Array(work.AlternateTitles),
+		work.Creator,
+		work.ReleaseYear,
+		externalIDs,
+		work.UpdatedAt,
+		work.ID,
+	)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23505": // unique_violation
+				return domainErrors.NewConflictError("work", "work already exists")
+			case "23503": // foreign_key_violation
+				return domainErrors.NewValidationError("無効な作品タイプです")
+			}
+		}
+		return fmt.Errorf("failed to update work: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("work", work.ID)
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDの作品を削除します
+func (r *workRepository) Delete(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM works WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete work: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domainErrors.NewNotFoundError("work", id)
+	}
+
+	return nil
+}
+
+// GetReviewStats は作品に紐づく公開済みレビューの件数・平均スコア・おすすめ度分布を取得します
+func (r *workRepository) GetReviewStats(ctx context.Context, workID int64) (*entity.WorkReviewStats, error) {
+	stats := entity.NewWorkReviewStats(workID)
+
+	summaryQuery := `
+		SELECT COUNT(*), COUNT(rating), AVG(rating)::float8
+		FROM contents
+		WHERE work_id = $1 AND status = 'published' AND published_at <= NOW()
+	`
+
+	var averageScore sql.NullFloat64
+	err := r.db.QueryRowContext(ctx, summaryQuery, workID).Scan(
+		&stats.ReviewCount,
+		&stats.ScoredCount,
+		&averageScore,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work review summary: %w", err)
+	}
+	if averageScore.Valid {
+		average := averageScore.Float64
+		stats.AverageScore = &average
+	}
+
+	distributionQuery := `
+		SELECT recommendation_level, COUNT(*)
+		FROM contents
+		WHERE work_id = $1 AND status = 'published' AND published_at <= NOW()
+			AND recommendation_level IS NOT NULL AND recommendation_level <> ''
+		GROUP BY recommendation_level
+	`
+
+	rows, err := r.db.QueryContext(ctx, distributionQuery, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work recommendation distribution: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var level string
+		var count int
+		if err := rows.Scan(&level, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan recommendation distribution: %w", err)
+		}
+		stats.RecommendationDistribution[entity.RecommendationLevel(level)] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return stats, nil
+}
+
+// scanWork はworkColumnsの順で作品を読み込みます
+func scanWork(row rowScanner) (*entity.Work, error) {
+	var work entity.Work
+	var alternateTitles pq.StringArray
+	var releaseYear sql.NullInt64
+	var externalIDs []byte
+
+	err := row.Scan(
+		&work.ID,
+		&work.Type,
+		&work.Title,
+		&alternateTitles,
+		&work.Creator,
+		&releaseYear,
+		&externalIDs,
+		&work.CreatedAt,
+		&work.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	work.AlternateTitles = []string(alternateTitles)
+	if releaseYear.Valid {
+		year := int(releaseYear.Int64)
+		work.ReleaseYear = &year
+	}
+
+	work.ExternalIDs = map[string]string{}
+	if len(externalIDs) > 0 {
+		if err := json.Unmarshal(externalIDs, &work.ExternalIDs); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal external ids: %w", err)
+		}
+	}
+
+	return &work, nil
+}
@@ -1,35 +1,49 @@
 package router
 
 import (
+	"context"
 	"log"
 	"net/http"
+	"os"
+	"time"
 
 	"media-platform/internal/adapter/controller"
 	"media-platform/internal/adapter/middleware"
 	"media-platform/internal/adapter/presenter"
 	"media-platform/internal/adapter/repository"
+	"media-platform/internal/domain/entity"
 	"media-platform/internal/infrastructure/database"
+	"media-platform/internal/infrastructure/imaging"
+	"media-platform/internal/infrastructure/jobs"
+	"media-platform/internal/infrastructure/mail"
+	"media-platform/internal/infrastructure/metadata"
+	"media-platform/internal/infrastructure/realtime"
+	"media-platform/internal/infrastructure/storage"
+	"media-platform/internal/infrastructure/webhook"
 	"media-platform/internal/usecase/service"
 
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
 )
 
+// allowedOrigins はCORSとWebSocketの接続元の確認で許可するOriginです
+var allowedOrigins = []string{
+	"http://localhost:3000", // React開発サーバー（デフォルト）
+	"http://localhost:3001", // React開発サーバー（現在のポート）
+	"http://localhost:3002", // その他のポート
+}
+
 // SetupRouter はEcho APIルーターを設定します
 func SetupRouter(e *echo.Echo, dbConn database.DBConn, jwtConfig *middleware.JWTConfig) {
 	// CORS設定
 	e.Use(echomiddleware.CORSWithConfig(echomiddleware.CORSConfig{
-		AllowOrigins: []string{
-			"http://localhost:3000", // React開発サーバー（デフォルト）
-			"http://localhost:3001", // React開発サーバー（現在のポート）
-			"http://localhost:3002", // その他のポート
-		},
+		AllowOrigins: allowedOrigins,
 		AllowMethods: []string{
 			echo.GET, echo.POST, echo.PUT, echo.PATCH, echo.DELETE, echo.HEAD, echo.OPTIONS,
 		},
 		AllowHeaders: []string{
 			"Origin", "Content-Length", "Content-Type", "Authorization",
-			"X-Requested-With", "Access-Control-Allow-Origin",
+			"X-Requested-With", "Access-Control-Allow-Origin", middleware.IdempotencyKeyHeader,
 		},
 		AllowCredentials: true,
 	}))
@@ -62,6 +76,20 @@ func setupDependencies(e *echo.Echo, dbConn database.DBConn, jwtConfig *middlewa
 	commentRepo := repository.NewCommentRepository(dbConn.GetDB())
 	ratingRepo := repository.NewRatingRepository(dbConn.GetDB())
 	followRepo := repository.NewFollowRepository(dbConn.GetDB()) // 🆕 フォロー機能
+	workRepo := repository.NewWorkRepository(dbConn.GetDB())
+	metadataCacheRepo := repository.NewMetadataCacheRepository(dbConn.GetDB())
+	libraryRepo := repository.NewLibraryRepository(dbConn.GetDB())
+	contentTypeRepo := repository.NewContentTypeRepository(dbConn.GetDB())
+	scoreAxisRepo := repository.NewScoreAxisRepository(dbConn.GetDB())
+	reactionRepo := repository.NewReactionRepository(dbConn.GetDB())
+	idempotencyRepo := repository.NewIdempotencyRepository(dbConn.GetDB())
+	mentionRepo := repository.NewMentionRepository(dbConn.GetDB())
+	annotationRepo := repository.NewAnnotationRepository(dbConn.GetDB())
+	notificationRepo := repository.NewNotificationRepository(dbConn.GetDB())
+	notificationPreferenceRepo := repository.NewNotificationPreferenceRepository(dbConn.GetDB())
+	webhookRepo := repository.NewWebhookRepository(dbConn.GetDB())
+	outboxRepo := repository.NewOutboxRepository(dbConn.GetDB())
+	transactor := repository.NewTransactor(dbConn.GetDB())
 
 	// ========== Presenter層の初期化（Adapter Layer） ==========
 	userPresenter := presenter.NewUserPresenter()
@@ -70,17 +98,137 @@ func setupDependencies(e *echo.Echo, dbConn database.DBConn, jwtConfig *middlewa
 	commentPresenter := presenter.NewCommentPresenter()
 	ratingPresenter := presenter.NewRatingPresenter()
 	followPresenter := presenter.NewFollowPresenter() // 🆕 フォロー機能
+	workPresenter := presenter.NewWorkPresenter()
+	libraryPresenter := presenter.NewLibraryPresenter()
+	contentTypePresenter := presenter.NewContentTypePresenter()
+	reactionPresenter := presenter.NewReactionPresenter()
+	annotationPresenter := presenter.NewAnnotationPresenter()
+	notificationPresenter := presenter.NewNotificationPresenter()
+	webhookPresenter := presenter.NewWebhookPresenter()
 
 	// JWT Generator
 	jwtGenerator := middleware.NewJWTGenerator(jwtConfig.SecretKey)
 
+	// ========== ファイルストレージ（アバター画像など） ==========
+	storageConfig := storage.LoadConfigFromEnv()
+	fileStorage, err := storage.NewLocalStorage(storageConfig)
+	if err != nil {
+		log.Fatalf("❌ Failed to initialize file storage: %v", err)
+	}
+	e.Static(storageConfig.URLPath, storageConfig.Dir)
+	imageProcessor := imaging.NewProcessor()
+
+	// ========== 外部メタデータプロバイダー（作品ルックアップ） ==========
+	metadataProvider := newMetadataProvider(metadata.LoadConfigFromEnv())
+
+	// ========== メール送信（通知メール・週間ダイジェスト） ==========
+	mailConfig := mail.LoadConfigFromEnv()
+	mailer := newMailer(mailConfig)
+	mailRenderer, err := mail.NewTemplateRenderer()
+	if err != nil {
+		log.Fatalf("❌ Failed to load email templates: %v", err)
+	}
+
 	// ========== Service層の初期化（Use Case Layer） ==========
-	userService := service.NewUserService(userRepo, jwtGenerator)
+	userService := service.NewUserService(userRepo, jwtGenerator, fileStorage, imageProcessor, storageConfig.URLPath)
 	categoryService := service.NewCategoryService(categoryRepo)
-	contentService := service.NewContentService(contentRepo, categoryRepo, userRepo)
-	commentService := service.NewCommentService(commentRepo, contentRepo, userRepo)
-	ratingService := service.NewRatingService(ratingRepo, contentRepo, userRepo)
-	followService := service.NewFollowService(followRepo, userRepo) // 🆕 フォロー機能
+	streamConfig := realtime.LoadConfigFromEnv()
+	streamService := service.NewStreamService(realtime.NewMemoryHub(streamConfig), contentRepo)
+	eventService := service.NewEventService(outboxRepo, transactor)
+	webhookService := service.NewWebhookService(webhookRepo, userRepo, contentRepo, commentRepo, webhook.NewHTTPSender(webhook.LoadConfigFromEnv()))
+	emailService := service.NewEmailService(mailer, mailRenderer, notificationPreferenceRepo, userRepo, contentRepo, commentRepo, followRepo, mailConfig.BaseURL)
+	notificationService := service.NewNotificationService(notificationRepo, notificationPreferenceRepo, contentRepo, commentRepo, streamService, emailService)
+	mentionService := service.NewMentionService(mentionRepo, userRepo, notificationService)
+	annotationService := service.NewAnnotationService(annotationRepo, contentRepo, userRepo, followRepo)
+	contentService := service.NewContentService(contentRepo, categoryRepo, userRepo, workRepo, contentTypeRepo, scoreAxisRepo, mentionService, annotationService, eventService)
+	commentService := service.NewCommentService(commentRepo, contentRepo, userRepo, followRepo, mentionService, notificationService, streamService, eventService, os.Getenv("COMMENT_REVISIONS_PUBLIC") == "true")
+	ratingService := service.NewRatingService(ratingRepo, contentRepo, userRepo, notificationService, streamService, eventService)
+	followService := service.NewFollowService(followRepo, userRepo, notificationService, eventService, storageConfig.URLPath) // 🆕 フォロー機能
+	workService := service.NewWorkService(workRepo, contentRepo, metadataCacheRepo, scoreAxisRepo, metadataProvider)
+	libraryService := service.NewLibraryService(libraryRepo, contentRepo)
+	contentTypeService := service.NewContentTypeService(contentTypeRepo, categoryRepo, scoreAxisRepo)
+	reactionService := service.NewReactionService(reactionRepo, contentRepo, commentRepo)
+
+	// ========== ドメインイベントの購読 ==========
+	eventService.Subscribe("webhooks", webhookService.HandleEvent, service.WebhookDomainEvents...)
+
+	// ========== バックグラウンドジョブ ==========
+	// トリガーで更新しているカウンターのずれを定期的に補正する
+	jobs.Start(context.Background(), jobs.Job{
+		Name:     "reconcile-content-counters",
+		Interval: jobs.IntervalFromEnv("COUNTER_RECONCILE_INTERVAL", time.Hour),
+		Run: func(ctx context.Context) error {
+			_, err := contentService.ReconcileCounters(ctx)
+			return err
+		},
+	})
+
+	jobs.Start(context.Background(), jobs.Job{
+		Name:     "cleanup-idempotency-keys",
+		Interval: jobs.IntervalFromEnv("IDEMPOTENCY_CLEANUP_INTERVAL", time.Hour),
+		Run: func(ctx context.Context) error {
+			_, err := idempotencyRepo.DeleteExpired(ctx, time.Now().Add(-entity.IdempotencyKeyTTL))
+			return err
+		},
+	})
+
+	// 外部メタデータのキャッシュは参照時に期限を確認するだけなので、期限切れの行を定期的に削除する
+	jobs.Start(context.Background(), jobs.Job{
+		Name:     "purge-metadata-cache",
+		Interval: jobs.IntervalFromEnv("METADATA_CACHE_CLEANUP_INTERVAL", time.Hour),
+		Run: func(ctx context.Context) error {
+			_, err := metadataCacheRepo.DeleteExpired(ctx)
+			return err
+		},
+	})
+
+	jobs.Start(context.Background(), jobs.Job{
+		Name:     "purge-comment-tombstones",
+		Interval: jobs.IntervalFromEnv("COMMENT_TOMBSTONE_CLEANUP_INTERVAL", time.Hour),
+		Run: func(ctx context.Context) error {
+			_, err := commentService.PurgeOrphanTombstones(ctx)
+			return err
+		},
+	})
+
+	// フォロー中のユーザーの人気投稿を週1回メールで届ける（送信時期になったユーザーを1時間ごとに確認する）
+	jobs.Start(context.Background(), jobs.Job{
+		Name:     "send-weekly-digests",
+		Interval: jobs.IntervalFromEnv("WEEKLY_DIGEST_CHECK_INTERVAL", entity.DigestCheckInterval),
+		Run: func(ctx context.Context) error {
+			_, err := emailService.SendWeeklyDigests(ctx)
+			return err
+		},
+	})
+
+	// アウトボックスのドメインイベントを購読者に配信し、失敗したものは指数バックオフで再試行する
+	jobs.Start(context.Background(), jobs.Job{
+		Name:     "dispatch-domain-events",
+		Interval: jobs.IntervalFromEnv("OUTBOX_DISPATCH_INTERVAL", 2*time.Second),
+		Run: func(ctx context.Context) error {
+			_, err := eventService.DispatchPending(ctx)
+			return err
+		},
+	})
+
+	jobs.Start(context.Background(), jobs.Job{
+		Name:     "purge-domain-events",
+		Interval: jobs.IntervalFromEnv("OUTBOX_CLEANUP_INTERVAL", time.Hour),
+		Run: func(ctx context.Context) error {
+			_, err := eventService.PurgeDispatched(ctx)
+			return err
+		},
+	})
+
+	// Webhookの配信待ちを送信し、失敗したものは指数バックオフで再送する
+	jobs.Start(context.Background(), jobs.Job{
+		Name:     "deliver-webhooks",
+		Interval: jobs.IntervalFromEnv("WEBHOOK_DELIVERY_INTERVAL", 10*time.Second),
+		Run: func(ctx context.Context) error {
+			_, err := webhookService.DeliverDue(ctx)
+			return err
+		},
+	})
 
 	// ========== Controller層の初期化（Adapter Layer） ==========
 	userController := controller.NewUserController(userService, userPresenter)
@@ -89,10 +237,22 @@ func setupDependencies(e *echo.Echo, dbConn database.DBConn, jwtConfig *middlewa
 	commentController := controller.NewCommentController(commentService, commentPresenter)
 	ratingController := controller.NewRatingController(ratingService, ratingPresenter)
 	followController := controller.NewFollowController(followService, followPresenter) // 🆕 フォロー機能
+	workController := controller.NewWorkController(workService, workPresenter)
+	libraryController := controller.NewLibraryController(libraryService, libraryPresenter)
+	contentTypeController := controller.NewContentTypeController(contentTypeService, contentTypePresenter)
+	reactionController := controller.NewReactionController(reactionService, reactionPresenter)
+	annotationController := controller.NewAnnotationController(annotationService, annotationPresenter)
+	notificationController := controller.NewNotificationController(notificationService, notificationPresenter)
+	webhookController := controller.NewWebhookController(webhookService, webhookPresenter)
+	streamController := controller.NewStreamController(streamService, streamConfig.HeartbeatInterval)
+	liveController := controller.NewLiveController(streamService, streamConfig.HeartbeatInterval, allowedOrigins)
 
 	// ========== ミドルウェアの設定 ==========
 	authMiddleware := jwtConfig.AuthMiddleware()
+	optionalAuthMiddleware := jwtConfig.OptionalAuthMiddleware()
+	webSocketAuthMiddleware := jwtConfig.WebSocketAuthMiddleware()
 	adminMiddleware := middleware.AdminMiddleware()
+	idempotencyMiddleware := middleware.IdempotencyMiddleware(idempotencyRepo)
 
 	// ========== APIグループ設定 ==========
 	api := e.Group("/api")
@@ -114,6 +274,15 @@ func setupDependencies(e *echo.Echo, dbConn database.DBConn, jwtConfig *middlewa
 		// 認証必要エンドポイント - 現在のユーザー情報
 		userRoutes.GET("/me", userController.GetCurrentUser, authMiddleware)
 		userRoutes.PUT("/me", userController.UpdateCurrentUser, authMiddleware)
+		userRoutes.PUT("/me/avatar", userController.UploadAvatar, authMiddleware, echomiddleware.BodyLimit("6M"))
+		userRoutes.DELETE("/me/avatar", userController.DeleteAvatar, authMiddleware)
+
+		// ライブラリ（消費記録）
+		userRoutes.GET("/me/library", libraryController.GetLibrary, authMiddleware)
+		userRoutes.GET("/me/library/stats", libraryController.GetLibraryStats, authMiddleware)
+		userRoutes.POST("/me/library", libraryController.AddToLibrary, authMiddleware)
+		userRoutes.PUT("/me/library/:entryId", libraryController.UpdateLibraryEntry, authMiddleware)
+		userRoutes.DELETE("/me/library/:entryId", libraryController.RemoveFromLibrary, authMiddleware)
 
 		// 🆕 フォロー機能 - フィード（認証必要）
 		userRoutes.GET("/following-feed", followController.GetFollowingFeed, authMiddleware)
@@ -149,6 +318,23 @@ func setupDependencies(e *echo.Echo, dbConn database.DBConn, jwtConfig *middlewa
 		categoryRoutes.DELETE("/:id", categoryController.DeleteCategory, authMiddleware, adminMiddleware)
 	}
 
+	// ========== コンテンツタイプAPI ==========
+	contentTypeRoutes := api.Group("/content-types")
+	{
+		// 認証不要エンドポイント
+		contentTypeRoutes.GET("", contentTypeController.GetContentTypes)
+		contentTypeRoutes.GET("/:id", contentTypeController.GetContentType)
+		contentTypeRoutes.GET("/:id/axes", contentTypeController.GetScoreAxes)
+
+		// 管理者限定エンドポイント
+		contentTypeRoutes.POST("", contentTypeController.CreateContentType, authMiddleware, adminMiddleware)
+		contentTypeRoutes.PUT("/:id", contentTypeController.UpdateContentType, authMiddleware, adminMiddleware)
+		contentTypeRoutes.DELETE("/:id", contentTypeController.DeleteContentType, authMiddleware, adminMiddleware)
+		contentTypeRoutes.POST("/:id/axes", contentTypeController.CreateScoreAxis, authMiddleware, adminMiddleware)
+		contentTypeRoutes.PUT("/:id/axes/:axisId", contentTypeController.UpdateScoreAxis, authMiddleware, adminMiddleware)
+		contentTypeRoutes.DELETE("/:id/axes/:axisId", contentTypeController.DeleteScoreAxis, authMiddleware, adminMiddleware)
+	}
+
 	// ========== コンテンツAPI ==========
 	contentRoutes := api.Group("/contents")
 	{
@@ -162,12 +348,27 @@ func setupDependencies(e *echo.Echo, dbConn database.DBConn, jwtConfig *middlewa
 		contentRoutes.GET("/:id", contentController.GetContent)
 
 		// コメント関連（コンテンツに紐づく）
-		contentRoutes.GET("/:contentId/comments", commentController.GetCommentsByContent)
+		contentRoutes.GET("/:contentId/comments", commentController.GetCommentsByContent, optionalAuthMiddleware)
+		contentRoutes.GET("/:contentId/comments/tree", commentController.GetCommentTree, optionalAuthMiddleware)
+		contentRoutes.GET("/:contentId/comments/pending", commentController.GetPendingComments, authMiddleware)
+		contentRoutes.PUT("/:contentId/comments/pin", commentController.PinComment, authMiddleware)
+		contentRoutes.DELETE("/:contentId/comments/pin", commentController.UnpinComment, authMiddleware)
+
+		// ライブスレッド（WebSocket、JWTはtokenクエリでも指定可能）
+		contentRoutes.GET("/:id/live", liveController.Live, webSocketAuthMiddleware)
+
+		// 注釈関連（本文の段落・範囲に付ける注釈）
+		contentRoutes.GET("/:contentId/annotations", annotationController.GetAnnotations)
+		contentRoutes.POST("/:contentId/annotations", annotationController.CreateAnnotation, authMiddleware)
 
 		// 評価関連（コンテンツに紐づく）
 		contentRoutes.GET("/:contentId/ratings", ratingController.GetRatingsByContentID)
 		contentRoutes.GET("/:contentId/ratings/stats", ratingController.GetGoodStatsByContentID)
 		contentRoutes.GET("/:contentId/ratings/user-status", ratingController.GetUserRatingStatus, authMiddleware)
+		contentRoutes.PUT("/:contentId/ratings/stars", ratingController.RateWithStars, authMiddleware)
+		contentRoutes.DELETE("/:contentId/ratings/stars", ratingController.RemoveStarRating, authMiddleware)
+		contentRoutes.PUT("/:contentId/like", ratingController.LikeContent, authMiddleware, idempotencyMiddleware)
+		contentRoutes.DELETE("/:contentId/like", ratingController.UnlikeContent, authMiddleware, idempotencyMiddleware)
 
 		// 認証必要エンドポイント
 		contentRoutes.POST("", contentController.CreateContent, authMiddleware)
@@ -180,28 +381,85 @@ func setupDependencies(e *echo.Echo, dbConn database.DBConn, jwtConfig *middlewa
 	commentRoutes := api.Group("/comments")
 	{
 		// 認証不要エンドポイント（公開コメント）
-		commentRoutes.GET("/:id", commentController.GetComment)
-		commentRoutes.GET("/parent/:parentId/replies", commentController.GetReplies)
+		commentRoutes.GET("/:id", commentController.GetComment, optionalAuthMiddleware)
+		commentRoutes.GET("/parent/:parentId/replies", commentController.GetReplies, optionalAuthMiddleware)
+		commentRoutes.GET("/:id/revisions", commentController.GetCommentRevisions, optionalAuthMiddleware)
 
 		// 認証必要エンドポイント
 		commentRoutes.POST("", commentController.CreateComment, authMiddleware)
 		commentRoutes.PUT("/:id", commentController.UpdateComment, authMiddleware)
 		commentRoutes.DELETE("/:id", commentController.DeleteComment, authMiddleware)
+		commentRoutes.POST("/:id/approve", commentController.ApproveComment, authMiddleware)
+		commentRoutes.POST("/:id/reject", commentController.RejectComment, authMiddleware)
+	}
+
+	// ========== 注釈API ==========
+	annotationRoutes := api.Group("/annotations")
+	{
+		// 認証必要エンドポイント
+		annotationRoutes.PUT("/:id", annotationController.UpdateAnnotation, authMiddleware)
+		annotationRoutes.DELETE("/:id", annotationController.DeleteAnnotation, authMiddleware)
+	}
+
+	// ========== 通知API ==========
+	notificationRoutes := api.Group("/notifications", authMiddleware)
+	{
+		notificationRoutes.GET("", notificationController.GetNotifications)
+		notificationRoutes.GET("/unread-count", notificationController.GetUnreadCount)
+		notificationRoutes.GET("/preferences", notificationController.GetPreferences)
+		notificationRoutes.PUT("/preferences", notificationController.UpdatePreferences)
+		notificationRoutes.PUT("/read-all", notificationController.MarkAllRead)
+		notificationRoutes.PUT("/:id/read", notificationController.MarkRead)
 	}
 
+	// ========== リアルタイム配信API（Server-Sent Events、EventSource用にtokenクエリも受け付ける） ==========
+	api.GET("/stream", streamController.Stream, webSocketAuthMiddleware)
+
 	// ========== 評価API（いいね機能） ==========
 	ratingRoutes := api.Group("/ratings")
 	{
 		// 認証必要エンドポイント
-		ratingRoutes.POST("/create-or-update", ratingController.CreateRating, authMiddleware)
-		ratingRoutes.DELETE("/:id", ratingController.DeleteRating, authMiddleware)
+		ratingRoutes.POST("/create-or-update", ratingController.CreateRating, authMiddleware, idempotencyMiddleware)
+		ratingRoutes.DELETE("/:id", ratingController.DeleteRating, authMiddleware, idempotencyMiddleware)
 
 		// 認証不要エンドポイント（統計情報）
 		ratingRoutes.GET("/top-contents", ratingController.GetTopRatedContents)
 		ratingRoutes.POST("/bulk-stats", ratingController.GetBulkRatingStats)
 
 		// いいねトグル機能
-		ratingRoutes.POST("/toggle/:contentId", ratingController.ToggleLike, authMiddleware)
+		ratingRoutes.POST("/toggle/:contentId", ratingController.ToggleLike, authMiddleware, idempotencyMiddleware)
+	}
+
+	// ========== 絵文字リアクションAPI ==========
+	reactionRoutes := api.Group("/reactions")
+	{
+		// 認証不要エンドポイント
+		reactionRoutes.GET("/palette", reactionController.GetPalette)
+		reactionRoutes.POST("/bulk-counts", reactionController.GetBulkReactionCounts)
+
+		// リアクショントグル機能（targetType は content または comment）
+		reactionRoutes.POST("/toggle/:targetType/:targetId", reactionController.ToggleReaction, authMiddleware)
+
+		// 管理者限定エンドポイント（パレットの設定）
+		reactionRoutes.POST("/palette", reactionController.CreateReactionKind, authMiddleware, adminMiddleware)
+		reactionRoutes.PUT("/palette/:id", reactionController.UpdateReactionKind, authMiddleware, adminMiddleware)
+		reactionRoutes.DELETE("/palette/:id", reactionController.DeleteReactionKind, authMiddleware, adminMiddleware)
+	}
+
+	// ========== 作品カタログAPI ==========
+	workRoutes := api.Group("/works")
+	{
+		// 認証不要エンドポイント
+		workRoutes.GET("", workController.GetWorks)
+		workRoutes.GET("/:id", workController.GetWork)
+
+		// 認証必要エンドポイント（レビュー投稿時の入力補完・作品登録）
+		workRoutes.GET("/lookup", workController.LookupWorks, authMiddleware)
+		workRoutes.POST("", workController.CreateWork, authMiddleware)
+
+		// 管理者限定エンドポイント
+		workRoutes.PUT("/:id", workController.UpdateWork, authMiddleware, adminMiddleware)
+		workRoutes.DELETE("/:id", workController.DeleteWork, authMiddleware, adminMiddleware)
 	}
 
 	// ========== 管理者API ==========
@@ -210,6 +468,20 @@ func setupDependencies(e *echo.Echo, dbConn database.DBConn, jwtConfig *middlewa
 		// ユーザー管理
 		adminRoutes.GET("/users", userController.GetAllUsers)
 
+		// いいね数・コメント数の再集計
+		adminRoutes.POST("/contents/reconcile-counters", contentController.ReconcileCounters)
+
+		// Webhook（外部サービスへのイベント配信）
+		adminRoutes.GET("/webhooks", webhookController.ListWebhooks)
+		adminRoutes.POST("/webhooks", webhookController.CreateWebhook)
+		adminRoutes.GET("/webhooks/:id", webhookController.GetWebhook)
+		adminRoutes.PUT("/webhooks/:id", webhookController.UpdateWebhook)
+		adminRoutes.DELETE("/webhooks/:id", webhookController.DeleteWebhook)
+		adminRoutes.POST("/webhooks/:id/rotate-secret", webhookController.RotateSecret)
+		adminRoutes.POST("/webhooks/:id/ping", webhookController.PingWebhook)
+		adminRoutes.GET("/webhooks/:id/deliveries", webhookController.GetDeliveries)
+		adminRoutes.POST("/webhooks/:id/deliveries/:deliveryId/redeliver", webhookController.RedeliverDelivery)
+
 		// TODO: 将来的に必要に応じて以下の機能を実装
 		// - ユーザー統計: GET /users/stats
 		// - コンテンツモデレーション: GET /contents/pending
@@ -221,10 +493,56 @@ func setupDependencies(e *echo.Echo, dbConn database.DBConn, jwtConfig *middlewa
 	log.Println("✅ All routes configured with Clean Architecture:")
 	log.Println("  📁 Users: /api/users")
 	log.Println("  📁 Categories: /api/categories")
+	log.Println("  📁 Content Types: /api/content-types")
 	log.Println("  📁 Contents: /api/contents")
 	log.Println("  📁 Comments: /api/comments")
+	log.Println("  📁 Annotations: /api/contents/:contentId/annotations, /api/annotations")
+	log.Println("  📁 Notifications: /api/notifications")
+	log.Println("  📡 Stream: /api/stream, /api/contents/:id/live (WebSocket)")
 	log.Println("  📁 Ratings: /api/ratings")
+	log.Println("  📁 Reactions: /api/reactions")
+	log.Println("  📁 Works: /api/works")
 	log.Println("  📁 Admin: /api/admin")
+	log.Println("  🪝 Webhooks: /api/admin/webhooks")
+	log.Println("  🖼️  Uploads: /uploads")
 	log.Println("  🆕 Follow: /api/users/:id/follow, /api/users/:id/followers, etc.")
 	log.Println("  🏥 Health: /health")
 }
+
+// newMetadataProvider は設定に応じて外部メタデータプロバイダーを作成します
+func newMetadataProvider(config *metadata.Config) service.MetadataProvider {
+	if config.Provider == "http" {
+		provider, err := metadata.NewHTTPProvider(config)
+		if err == nil {
+			log.Printf("📚 Metadata provider: %s", config.BaseURL)
+			return provider
+		}
+		log.Printf("⚠️  Failed to initialize HTTP metadata provider, falling back to fixtures: %v", err)
+	}
+
+	provider, err := metadata.NewFixtureProvider()
+	if err != nil {
+		log.Fatalf("❌ Failed to initialize fixture metadata provider: %v", err)
+	}
+	log.Println("📚 Metadata provider: fixtures")
+	return provider
+}
+
+// newMailer は設定に応じてメールの送信先を作成します（SMTPの設定に失敗した場合はログ出力にフォールバック）
+func newMailer(config *mail.Config) service.Mailer {
+	if config.Provider == "smtp" {
+		mailer, err := mail.NewSMTPMailer(config)
+		if err == nil {
+			log.Printf("📧 Mailer: smtp %s:%d", config.Host, config.Port)
+			return mailer
+		}
+		log.Printf("⚠️  Failed to initialize SMTP mailer, falling back to log: %v", err)
+	}
+
+	mailer, err := mail.NewLogMailer(config)
+	if err != nil {
+		log.Fatalf("❌ Failed to initialize log mailer: %v", err)
+	}
+	log.Println("📧 Mailer: log")
+	return mailer
+}
@@ -0,0 +1,238 @@
+package entity
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 注釈の制限
+const (
+	AnnotationBodyMaxLength  = 1000 // 注釈本文の最大文字数
+	AnnotationQuoteMaxLength = 1000 // 引用部分の最大文字数
+	AnnotationContextLength  = 32   // 引用の前後に保存する文脈の文字数
+)
+
+// blockSeparator はコンテンツ本文を段落（ブロック）に分割する空行です
+var blockSeparator = regexp.MustCompile(`\n[ \t\r]*\n`)
+
+// TextQuoteSelector は段落内の引用部分を前後の文脈とともに表すValue Objectです
+// 本文が編集されても引用文字列を検索して位置を復元できるように、オフセットではなく文字列で保持します
+type TextQuoteSelector struct {
+	Exact  string // 引用部分（段落全体への注釈の場合は段落の全文）
+	Prefix string // 引用部分の直前の文字列（段落内、最大AnnotationContextLength文字）
+	Suffix string // 引用部分の直後の文字列（段落内、最大AnnotationContextLength文字）
+}
+
+// Annotation はコンテンツ本文の段落や範囲に付けられた注釈を表すエンティティです
+type Annotation struct {
+	ID         int64
+	ContentID  int64
+	UserID     int64
+	Body       string
+	BlockIndex int // 注釈が付けられた段落の位置（0始まり）
+	Quote      TextQuoteSelector
+	IsOrphaned bool // 本文の編集により引用部分が見つからなくなった場合true
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// SplitBlocks はコンテンツ本文を空行区切りの段落に分割します（前後の空白を除き、空の段落は含めない）
+func SplitBlocks(body string) []string {
+	var blocks []string
+	for _, block := range blockSeparator.Split(strings.ReplaceAll(body, "\r\n", "\n"), -1) {
+		if block = strings.TrimSpace(block); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// NewAnnotation は本文の段落に対する新しい注釈を作成します
+// exactが空の場合は段落全体への注釈とし、occurrenceは段落内に同じ引用部分が複数ある場合の出現番号（0始まり）です
+func NewAnnotation(contentID, userID int64, body string, contentBody string, blockIndex int, exact string, occurrence int) (*Annotation, error) {
+	annotation := &Annotation{
+		ContentID:  contentID,
+		UserID:     userID,
+		Body:       strings.TrimSpace(body),
+		BlockIndex: blockIndex,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	if err := annotation.validateBody(); err != nil {
+		return nil, err
+	}
+
+	blocks := SplitBlocks(contentBody)
+	if blockIndex < 0 || blockIndex >= len(blocks) {
+		return nil, errors.New("指定された段落が存在しません")
+	}
+	block := blocks[blockIndex]
+
+	if exact == "" {
+		annotation.Quote = TextQuoteSelector{Exact: block}
+		return annotation, nil
+	}
+	if utf8.RuneCountInString(exact) > AnnotationQuoteMaxLength {
+		return nil, errors.New("引用部分は1000文字以内である必要があります")
+	}
+
+	start := nthIndex(block, exact, occurrence)
+	if start < 0 {
+		return nil, errors.New("引用部分が段落内に見つかりません")
+	}
+	annotation.Quote = TextQuoteSelector{
+		Exact:  exact,
+		Prefix: lastRunes(block[:start], AnnotationContextLength),
+		Suffix: firstRunes(block[start+len(exact):], AnnotationContextLength),
+	}
+	return annotation, nil
+}
+
+// SetBody は注釈本文を設定し、バリデーションを行います
+func (a *Annotation) SetBody(body string) error {
+	a.Body = strings.TrimSpace(body)
+	a.UpdatedAt = time.Now()
+	return a.validateBody()
+}
+
+func (a *Annotation) validateBody() error {
+	if a.Body == "" {
+		return errors.New("注釈の本文は必須です")
+	}
+	if utf8.RuneCountInString(a.Body) > AnnotationBodyMaxLength {
+		return errors.New("注釈の本文は1000文字以内である必要があります")
+	}
+	return nil
+}
+
+// CanEdit はユーザーがこの注釈を編集できるか判定します
+func (a *Annotation) CanEdit(userID int64, userRole string) bool {
+	return a.UserID == userID || userRole == "admin"
+}
+
+// Reanchor は編集前後の本文の段落から注釈の段落の位置を復元し、位置または孤立状態が変わった場合trueを返します
+// 同じ段落がそのまま残っている場合はその位置に移動し、それ以外は引用部分を含む段落を
+// 前後の文脈の一致と元の位置との近さで選びます。見つからない場合は孤立した注釈として扱います
+func (a *Annotation) Reanchor(oldBlocks, newBlocks []string) bool {
+	blockIndex, found := a.locateBlock(oldBlocks, newBlocks)
+	changed := found == a.IsOrphaned || (found && blockIndex != a.BlockIndex)
+
+	a.IsOrphaned = !found
+	if found {
+		a.BlockIndex = blockIndex
+	}
+	return changed
+}
+
+func (a *Annotation) locateBlock(oldBlocks, newBlocks []string) (int, bool) {
+	// 元の段落が変更されずに残っている場合（段落の追加・削除による移動）
+	if !a.IsOrphaned && a.BlockIndex < len(oldBlocks) {
+		original := oldBlocks[a.BlockIndex]
+		if best := closestIndex(newBlocks, a.BlockIndex, func(block string) int {
+			if block == original {
+				return 1
+			}
+			return 0
+		}); best >= 0 {
+			return best, true
+		}
+	}
+
+	// 引用部分を含む段落を探す（前後の文脈が一致するものを優先）
+	best := closestIndex(newBlocks, a.BlockIndex, func(block string) int {
+		if !strings.Contains(block, a.Quote.Exact) {
+			return 0
+		}
+		score := 1
+		if a.Quote.Prefix != "" && strings.Contains(block, a.Quote.Prefix+a.Quote.Exact) {
+			score++
+		}
+		if a.Quote.Suffix != "" && strings.Contains(block, a.Quote.Exact+a.Quote.Suffix) {
+			score++
+		}
+		return score
+	})
+	return best, best >= 0
+}
+
+// Locate は段落内の引用部分の位置を文字（Unicodeコードポイント）単位で返します（見つからない場合はfalse）
+func (a *Annotation) Locate(blocks []string) (start, end int, ok bool) {
+	if a.IsOrphaned || a.BlockIndex >= len(blocks) {
+		return 0, 0, false
+	}
+	block := blocks[a.BlockIndex]
+
+	index := -1
+	if a.Quote.Prefix != "" {
+		if i := strings.Index(block, a.Quote.Prefix+a.Quote.Exact); i >= 0 {
+			index = i + len(a.Quote.Prefix)
+		}
+	}
+	if index < 0 {
+		index = strings.Index(block, a.Quote.Exact)
+	}
+	if index < 0 {
+		return 0, 0, false
+	}
+
+	start = utf8.RuneCountInString(block[:index])
+	return start, start + utf8.RuneCountInString(a.Quote.Exact), true
+}
+
+// closestIndex はscoreが最大の段落のうちoriginに最も近い位置を返します（scoreがすべて0の場合は-1）
+func closestIndex(blocks []string, origin int, score func(block string) int) int {
+	best, bestScore, bestDistance := -1, 0, 0
+	for i, block := range blocks {
+		s := score(block)
+		if s == 0 {
+			continue
+		}
+		distance := i - origin
+		if distance < 0 {
+			distance = -distance
+		}
+		if s > bestScore || (s == bestScore && distance < bestDistance) {
+			best, bestScore, bestDistance = i, s, distance
+		}
+	}
+	return best
+}
+
+// nthIndex はsの中でsubstrがn番目（0始まり）に出現するバイト位置を返します（見つからない場合は-1）
+func nthIndex(s, substr string, n int) int {
+	if n < 0 {
+		return -1
+	}
+	offset := 0
+	for {
+		i := strings.Index(s[offset:], substr)
+		if i < 0 {
+			return -1
+		}
+		if n == 0 {
+			return offset + i
+		}
+		n--
+		offset += i + 1
+	}
+}
+
+// lastRunes は文字列の末尾からn文字を返します
+func lastRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[len(runes)-n:])
+}
+
+// firstRunes は文字列の先頭からn文字を返します
+func firstRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
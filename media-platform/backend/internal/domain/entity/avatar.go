@@ -0,0 +1,126 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+const (
+	// AvatarMaxBytes はアップロード可能なアバター画像の最大サイズです（5MB）
+	AvatarMaxBytes = 5 << 20
+
+	// AvatarMinSourceSize は元画像の短辺の最小ピクセル数です
+	AvatarMinSourceSize = 64
+
+	// AvatarMaxSourceSize は元画像の長辺の最大ピクセル数です（巨大画像による負荷対策）
+	AvatarMaxSourceSize = 4096
+
+	// AvatarDefaultSize はUser.Avatarに保存する標準サイズです
+	AvatarDefaultSize = 256
+)
+
+// AvatarSizes は生成するアバター画像の一辺のピクセル数です（大きい順）
+var AvatarSizes = []int{512, AvatarDefaultSize, 64}
+
+// AvatarAllowedFormats はアップロードを許可する画像形式です
+var AvatarAllowedFormats = map[string]bool{
+	"jpeg": true,
+	"png":  true,
+	"gif":  true,
+}
+
+// uploadedAvatarPattern はアップロードしたアバターの公開パス以下の部分（avatars/{userID}/{token}_{size}.jpg）に一致します
+var uploadedAvatarPattern = regexp.MustCompile(`^avatars/(\d+)/[0-9a-f]+_(\d+)\.jpg$`)
+
+// AvatarCrop はアバター画像の正方形切り抜き範囲を表すValue Objectです
+type AvatarCrop struct {
+	X    int
+	Y    int
+	Size int
+}
+
+// NewCenteredAvatarCrop は画像中央の最大の正方形を切り抜く範囲を作成します
+func NewCenteredAvatarCrop(width, height int) AvatarCrop {
+	size := width
+	if height < size {
+		size = height
+	}
+	return AvatarCrop{
+		X:    (width - size) / 2,
+		Y:    (height - size) / 2,
+		Size: size,
+	}
+}
+
+// Validate は切り抜き範囲が画像内に収まっているかを検証します
+func (c AvatarCrop) Validate(width, height int) error {
+	if c.X < 0 || c.Y < 0 {
+		return domainErrors.NewValidationError("切り抜き座標は0以上である必要があります")
+	}
+	if c.Size < AvatarMinSourceSize {
+		return domainErrors.NewValidationError(
+			fmt.Sprintf("切り抜きサイズは%dピクセル以上である必要があります", AvatarMinSourceSize))
+	}
+	if c.X+c.Size > width || c.Y+c.Size > height {
+		return domainErrors.NewValidationError("切り抜き範囲が画像の外にはみ出しています")
+	}
+	return nil
+}
+
+// ValidateAvatarSource はアップロードされた画像の形式と寸法を検証します
+func ValidateAvatarSource(format string, width, height int) error {
+	if !AvatarAllowedFormats[format] {
+		return domainErrors.NewValidationError("アバター画像はJPEG・PNG・GIFのいずれかである必要があります")
+	}
+	if width < AvatarMinSourceSize || height < AvatarMinSourceSize {
+		return domainErrors.NewValidationError(
+			fmt.Sprintf("アバター画像は%dx%dピクセル以上である必要があります", AvatarMinSourceSize, AvatarMinSourceSize))
+	}
+	if width > AvatarMaxSourceSize || height > AvatarMaxSourceSize {
+		return domainErrors.NewValidationError(
+			fmt.Sprintf("アバター画像は%dx%dピクセル以下である必要があります", AvatarMaxSourceSize, AvatarMaxSourceSize))
+	}
+	return nil
+}
+
+// AvatarFileKey は保存先のキー（相対パス）を生成します
+func AvatarFileKey(userID int64, token string, size int) string {
+	return fmt.Sprintf("avatars/%d/%s_%d.jpg", userID, token, size)
+}
+
+// IsAvatarOwnedBy はアバターURLがユーザー自身のアップロード（{urlPath}/avatars/{userID}/ 配下）か判定します
+// urlPathはファイルストレージの公開パスです
+// ファイルを削除する前に必ず確認し、他のユーザーのファイルを削除できないようにします
+func IsAvatarOwnedBy(urlPath, avatarURL string, userID int64) bool {
+	match := matchUploadedAvatar(urlPath, avatarURL)
+	return match != nil && match[1] == strconv.FormatInt(userID, 10)
+}
+
+// AvatarVariantURLs はアップロード済みアバターURLから各サイズのURLを導出します
+// urlPathはファイルストレージの公開パスで、その配下にないURL（外部URLなど）の場合はnilを返します
+func AvatarVariantURLs(urlPath, avatarURL string) map[int]string {
+	if matchUploadedAvatar(urlPath, avatarURL) == nil {
+		return nil
+	}
+
+	prefix := strings.TrimSuffix(avatarURL, ".jpg")
+	prefix = prefix[:strings.LastIndex(prefix, "_")]
+	variants := make(map[int]string, len(AvatarSizes))
+	for _, size := range AvatarSizes {
+		variants[size] = prefix + "_" + strconv.Itoa(size) + ".jpg"
+	}
+	return variants
+}
+
+// matchUploadedAvatar はファイルストレージの公開パス配下のアバターURLを解析します（一致しない場合はnil）
+func matchUploadedAvatar(urlPath, avatarURL string) []string {
+	key, ok := strings.CutPrefix(avatarURL, strings.TrimRight(urlPath, "/")+"/")
+	if !ok {
+		return nil
+	}
+	return uploadedAvatarPattern.FindStringSubmatch(key)
+}
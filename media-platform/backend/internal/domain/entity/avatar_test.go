@@ -0,0 +1,70 @@
+package entity
+
+import "testing"
+
+func TestAvatarVariantURLs(t *testing.T) {
+	tests := []struct {
+		name      string
+		urlPath   string
+		avatarURL string
+		want      map[int]string
+	}{
+		{
+			name:      "アップロードしたアバター",
+			urlPath:   "/uploads",
+			avatarURL: "/uploads/avatars/1/0a1b2c3d4e5f6789_256.jpg",
+			want: map[int]string{
+				512: "/uploads/avatars/1/0a1b2c3d4e5f6789_512.jpg",
+				256: "/uploads/avatars/1/0a1b2c3d4e5f6789_256.jpg",
+				64:  "/uploads/avatars/1/0a1b2c3d4e5f6789_64.jpg",
+			},
+		},
+		{name: "外部URL", urlPath: "/uploads", avatarURL: "https://cdn.example.com/x_128.jpg"},
+		{name: "外部URLのアバター形式のパス", urlPath: "/uploads", avatarURL: "https://cdn.example.com/uploads/avatars/1/0a1b_256.jpg"},
+		{name: "公開パス以外のローカルパス", urlPath: "/uploads", avatarURL: "/static/avatars/1/0a1b_256.jpg"},
+		{name: "公開パスの前方一致のみ", urlPath: "/uploads", avatarURL: "/uploads-old/avatars/1/0a1b_256.jpg"},
+		{name: "空のアバター", urlPath: "/uploads", avatarURL: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AvatarVariantURLs(tt.urlPath, tt.avatarURL)
+			if tt.want == nil {
+				if got != nil {
+					t.Fatalf("AvatarVariantURLs() = %v, want nil", got)
+				}
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("AvatarVariantURLs() = %v, want %v", got, tt.want)
+			}
+			for size, url := range tt.want {
+				if got[size] != url {
+					t.Fatalf("AvatarVariantURLs()[%d] = %q, want %q", size, got[size], url)
+				}
+			}
+		})
+	}
+}
+
+func TestIsAvatarOwnedBy(t *testing.T) {
+	tests := []struct {
+		name      string
+		avatarURL string
+		userID    int64
+		want      bool
+	}{
+		{name: "自分のアップロード", avatarURL: "/uploads/avatars/1/0a1b_256.jpg", userID: 1, want: true},
+		{name: "他のユーザーのアップロード", avatarURL: "/uploads/avatars/12/0a1b_256.jpg", userID: 1},
+		{name: "外部URL", avatarURL: "https://cdn.example.com/uploads/avatars/1/0a1b_256.jpg", userID: 1},
+		{name: "パスの遡り", avatarURL: "/uploads/avatars/1/../2/0a1b_256.jpg", userID: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAvatarOwnedBy("/uploads", tt.avatarURL, tt.userID); got != tt.want {
+				t.Fatalf("IsAvatarOwnedBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -14,6 +14,54 @@ type Comment struct {
 	ParentID  *int64    `json:"parent_id,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	IsPinned      bool       `json:"is_pinned"`            // 投稿者によって先頭に固定されているか
+	ReactionCount int64      `json:"reaction_count"`       // リアクションの合計数（取得時に集計）
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"` // 削除済み（墓標）の場合に設定される
+	EditedAt      *time.Time `json:"edited_at,omitempty"`  // 最後に本文が編集された日時
+	IsPending     bool       `json:"is_pending"`           // コンテンツの投稿者の承認待ち（投稿者・管理者・本人にのみ表示）
+}
+
+// CommentViewer はコメントを閲覧するユーザーです（承認待ちのコメントを表示するかの判定に使用）
+type CommentViewer struct {
+	UserID      int64 // 未ログインの場合は0
+	CanModerate bool  // コンテンツの投稿者または管理者の場合true（すべての承認待ちのコメントを表示）
+}
+
+// CanSee は閲覧者がこのコメントを表示できるか判定します
+func (c *Comment) CanSee(viewer CommentViewer) bool {
+	return !c.IsPending || viewer.CanModerate || (viewer.UserID != 0 && c.UserID == viewer.UserID)
+}
+
+// CommentRevision はコメントの編集前の本文を表すエンティティです
+type CommentRevision struct {
+	ID        int64
+	CommentID int64
+	Body      string
+	EditorID  *int64 // 編集したユーザー（削除済みの場合はnil）
+	CreatedAt time.Time
+}
+
+// CommentTombstoneBody は削除済みコメント（墓標）の表示用の本文です
+const CommentTombstoneBody = "このコメントは削除されました"
+
+// CommentSort はコメント一覧の並び順です
+type CommentSort string
+
+const (
+	CommentSortNewest        CommentSort = "newest"        // 新しい順
+	CommentSortOldest        CommentSort = "oldest"        // 古い順
+	CommentSortTop           CommentSort = "top"           // リアクションが多い順
+	CommentSortControversial CommentSort = "controversial" // リアクションに対して返信が多い順（議論が活発な順）
+)
+
+// IsValidCommentSort はコメントの並び順が有効かチェックします
+func IsValidCommentSort(sort CommentSort) bool {
+	switch sort {
+	case CommentSortNewest, CommentSortOldest, CommentSortTop, CommentSortControversial:
+		return true
+	}
+	return false
 }
 
 // Validate はコメントのドメインルールを検証します
@@ -60,6 +108,27 @@ func (c *Comment) CanDelete(userID int64, userRole string) bool {
 	return c.UserID == userID || userRole == "admin"
 }
 
+// CanPin はこのコメントをピン留めできるか判定します（ルートコメントのみ）
+func (c *Comment) CanPin() bool {
+	return c.IsRootComment() && !c.IsDeleted()
+}
+
+// IsDeleted はこのコメントが削除済み（返信を残すための墓標）かどうかを判定します
+func (c *Comment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
+
+// IsEdited はこのコメントが投稿後に編集されたかどうかを判定します
+func (c *Comment) IsEdited() bool {
+	return c.EditedAt != nil
+}
+
+// CanViewRevisions はユーザーがこのコメントの編集履歴を閲覧できるか判定します
+// 投稿者と管理者は常に閲覧でき、それ以外はpublicRevisionsがtrueの場合のみ閲覧できます
+func (c *Comment) CanViewRevisions(userID int64, userRole string, publicRevisions bool) bool {
+	return publicRevisions || c.UserID == userID || userRole == "admin"
+}
+
 // IsReply はこのコメントが返信かどうかを判定します
 func (c *Comment) IsReply() bool {
 	return c.ParentID != nil
@@ -69,3 +138,45 @@ func (c *Comment) IsReply() bool {
 func (c *Comment) IsRootComment() bool {
 	return c.ParentID == nil
 }
+
+// コメントツリーの制限
+const (
+	CommentMaxDepth           = 8  // 返信の入れ子の最大階層（ルートコメントを0とする）
+	CommentTreeDefaultDepth   = 3  // ツリー取得時のデフォルトの階層数
+	CommentTreeMaxRoots       = 50 // ツリー取得時の1ページあたりの最大ルートコメント数
+	CommentTreeMaxReplies     = 20 // ツリー取得時の1コメントあたりの最大返信数
+	CommentTreeDefaultReplies = 5
+)
+
+// CommentAuthor はコメント投稿者の概要を表すValue Objectです
+type CommentAuthor struct {
+	ID       int64
+	Username string
+	Avatar   string
+}
+
+// CommentCursor はコメント一覧の続きを取得するための位置を表します（作成日時とIDの組）
+type CommentCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// CommentTreeQuery はコメントツリーの取得条件です
+// ParentIDが指定された場合はそのコメントの返信以下を、それ以外はコンテンツのルートコメント以下を取得します
+type CommentTreeQuery struct {
+	ContentID  int64
+	ParentID   *int64
+	After      *CommentCursor // 指定された位置より後の兄弟から取得する
+	RootLimit  int            // 起点となる階層の取得件数
+	ReplyLimit int            // 各コメントの返信の取得件数
+	MaxDepth   int            // 起点を0とした取得する最大階層
+	Viewer     CommentViewer  // 承認待ちのコメントを含めるかの判定に使用
+}
+
+// CommentNode はコメントツリーの1ノードを表します（リポジトリからは走査順のフラットな一覧で返されます）
+type CommentNode struct {
+	Comment    *Comment
+	Author     *CommentAuthor // 投稿者が削除済みの場合はnil
+	Depth      int            // 起点からの階層
+	ReplyCount int64          // 直接の返信数
+}
@@ -2,14 +2,17 @@ package entity
 
 import (
 	"errors"
+	"math"
 	"time"
 
 	domainErrors "media-platform/internal/domain/errors"
 )
 
 // ContentType はコンテンツの種類（趣味カテゴリ）を表す型です
+// 有効な種類はcontent_typesテーブル（ContentTypeDefinition）で管理されます
 type ContentType string
 
+// 初期データとして登録されている種類
 const (
 	ContentTypeMusic ContentType = "音楽"
 	ContentTypeAnime ContentType = "アニメ"
@@ -27,6 +30,25 @@ const (
 	ContentStatusArchived  ContentStatus = "archived"
 )
 
+// CommentPolicy はコンテンツへのコメントの受け付け方を表す型です
+type CommentPolicy string
+
+const (
+	CommentPolicyOpen             CommentPolicy = "open"              // 誰でもコメントできる
+	CommentPolicyFollowersOnly    CommentPolicy = "followers_only"    // 投稿者のフォロワーのみコメントできる
+	CommentPolicyApprovalRequired CommentPolicy = "approval_required" // 投稿者が承認するまで他のユーザーに表示しない
+	CommentPolicyClosed           CommentPolicy = "closed"            // 新しいコメントを受け付けない
+)
+
+// IsValidCommentPolicy はコメントの受け付け方が有効かチェックします
+func IsValidCommentPolicy(policy CommentPolicy) bool {
+	switch policy {
+	case CommentPolicyOpen, CommentPolicyFollowersOnly, CommentPolicyApprovalRequired, CommentPolicyClosed:
+		return true
+	}
+	return false
+}
+
 // Content はコンテンツ（趣味投稿）を表すエンティティです
 type Content struct {
 	ID          int64
@@ -41,6 +63,28 @@ type Content struct {
 	PublishedAt *time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+
+	// いいね数・コメント数（ratings / comments の変更時にDBのトリガーで更新される非正規化カウンター）
+	LikeCount    int64
+	CommentCount int64
+
+	// レビュー対象の作品
+	WorkID              *int64
+	ReviewScore         *float64 // 投稿者による0〜5のスコア（小数第1位まで）
+	RecommendationLevel RecommendationLevel
+
+	// 種類ごとに入力可否が決まる追加フィールド
+	ArtistName  string
+	ReleaseYear *int
+	ImageURL    string
+	ExternalURL string
+	Tags        []string
+
+	// 種類別の構造化項目（スキーマはコンテンツタイプ定義が参照する。ContentTypeDefinition.ValidateContentで検証）
+	Metadata map[string]interface{}
+
+	// コメントの受け付け方
+	CommentPolicy CommentPolicy
 }
 
 // NewContent は新しいコンテンツエンティティを作成します
@@ -61,6 +105,8 @@ func NewContent(
 		ViewCount:  0,
 		CreatedAt:  time.Now(),
 		UpdatedAt:  time.Now(),
+
+		CommentPolicy: CommentPolicyOpen,
 	}
 
 	if err := content.Validate(); err != nil {
@@ -104,19 +150,82 @@ func (c *Content) Validate() error {
 		return domainErrors.NewValidationError("カテゴリIDは必須です")
 	}
 
+	if err := validateReviewScore(c.ReviewScore); err != nil {
+		return domainErrors.NewValidationError(err.Error())
+	}
+
+	if !IsValidRecommendationLevel(c.RecommendationLevel) {
+		return domainErrors.NewValidationError("無効なおすすめ度です")
+	}
+
+	if c.ReleaseYear != nil && (*c.ReleaseYear < 1800 || *c.ReleaseYear > 2100) {
+		return domainErrors.NewValidationError("リリース年は1800〜2100の範囲である必要があります")
+	}
+
+	if len(c.ArtistName) > 255 {
+		return domainErrors.NewValidationError("アーティスト名は255文字以内である必要があります")
+	}
+
+	if len(c.ImageURL) > 500 || len(c.ExternalURL) > 500 {
+		return domainErrors.NewValidationError("URLは500文字以内である必要があります")
+	}
+
+	if !IsValidCommentPolicy(c.CommentPolicy) {
+		return domainErrors.NewValidationError("無効なコメント設定です")
+	}
+
 	return nil
 }
 
-// isValidContentType はコンテンツタイプが有効かチェックします
-func (c *Content) isValidContentType(contentType ContentType) bool {
-	validTypes := map[ContentType]bool{
-		ContentTypeMusic: true,
-		ContentTypeAnime: true,
-		ContentTypeManga: true,
-		ContentTypeMovie: true,
-		ContentTypeGame:  true,
+// ExtraFieldsInUse は値が設定されている追加フィールド名を返します
+func (c *Content) ExtraFieldsInUse() []string {
+	var fields []string
+	if c.ArtistName != "" {
+		fields = append(fields, ContentFieldArtistName)
+	}
+	if c.ReleaseYear != nil {
+		fields = append(fields, ContentFieldReleaseYear)
+	}
+	if c.ImageURL != "" {
+		fields = append(fields, ContentFieldImageURL)
+	}
+	if c.ExternalURL != "" {
+		fields = append(fields, ContentFieldExternalURL)
+	}
+	if len(c.Tags) > 0 {
+		fields = append(fields, ContentFieldTags)
+	}
+	return fields
+}
+
+// SetExtraFields は追加フィールドをまとめて設定します（種類ごとの入力可否はContentTypeDefinitionで検証）
+func (c *Content) SetExtraFields(artistName string, releaseYear *int, imageURL, externalURL string, tags []string) {
+	c.ArtistName = artistName
+	c.ReleaseYear = releaseYear
+	c.ImageURL = imageURL
+	c.ExternalURL = externalURL
+	c.Tags = tags
+	c.UpdatedAt = time.Now()
+}
+
+// validateReviewScore はレビュースコアが0〜5の範囲かつ小数第1位までかチェックします
+func validateReviewScore(score *float64) error {
+	if score == nil {
+		return nil
 	}
-	return validTypes[contentType]
+	if *score < 0 || *score > 5 {
+		return errors.New("スコアは0〜5の範囲である必要があります")
+	}
+	if scaled := *score * 10; math.Abs(scaled-math.Round(scaled)) > 1e-9 {
+		return errors.New("スコアは小数第1位までで指定してください")
+	}
+	return nil
+}
+
+// isValidContentType はコンテンツタイプの形式が有効かチェックします
+// 種類が登録済みかどうかはContentTypeRepositoryで確認します
+func (c *Content) isValidContentType(contentType ContentType) bool {
+	return isValidContentTypeName(contentType)
 }
 
 // isValidContentStatus はコンテンツステータスが有効かチェックします
@@ -181,6 +290,60 @@ func (c *Content) SetCategoryID(categoryID int64) error {
 	return nil
 }
 
+// SetWorkID はレビュー対象の作品を設定します（nilで紐付け解除）
+func (c *Content) SetWorkID(workID *int64) error {
+	if workID != nil && *workID <= 0 {
+		return errors.New("無効な作品IDです")
+	}
+	c.WorkID = workID
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetReviewScore はレビュースコアを設定します（nilで未設定）
+func (c *Content) SetReviewScore(score *float64) error {
+	if err := validateReviewScore(score); err != nil {
+		return err
+	}
+	c.ReviewScore = score
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetRecommendationLevel はおすすめ度を設定します
+func (c *Content) SetRecommendationLevel(level RecommendationLevel) error {
+	if !IsValidRecommendationLevel(level) {
+		return errors.New("無効なおすすめ度です")
+	}
+	c.RecommendationLevel = level
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetMetadata は種類別の構造化項目を現在の種類の定義のスキーマで検証して設定します
+func (c *Content) SetMetadata(contentType *ContentTypeDefinition, metadata map[string]interface{}) error {
+	if contentType.Name != c.Type {
+		return domainErrors.NewValidationError("コンテンツの種類と異なる種類の構造化項目は設定できません")
+	}
+	normalized, err := contentType.NormalizeMetadata(metadata)
+	if err != nil {
+		return err
+	}
+	c.Metadata = normalized
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetCommentPolicy はコメントの受け付け方を設定します
+func (c *Content) SetCommentPolicy(policy CommentPolicy) error {
+	if !IsValidCommentPolicy(policy) {
+		return errors.New("コメント設定は open, followers_only, approval_required, closed のいずれかである必要があります")
+	}
+	c.CommentPolicy = policy
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
 // SetStatus はコンテンツステータスを設定します
 func (c *Content) SetStatus(status ContentStatus) error {
 	if !c.isValidContentStatus(status) {
@@ -215,3 +378,15 @@ func (c *Content) IsPublished() bool {
 func (c *Content) CanEdit(userID int64, userRole string) bool {
 	return c.AuthorID == userID || userRole == "admin"
 }
+
+// CanView は指定されたユーザーがこのコンテンツを閲覧できるかどうかを返します
+// 公開済みのコンテンツは誰でも、下書き・アーカイブ・公開予約中のコンテンツは投稿者と管理者のみ閲覧できます
+func (c *Content) CanView(userID int64, userRole string) bool {
+	return c.IsPublished() || c.CanEdit(userID, userRole)
+}
+
+// RequiresCommentApproval は指定されたユーザーのコメントが投稿者の承認待ちになるかどうかを返します
+// 投稿者自身と管理者のコメントは承認不要です
+func (c *Content) RequiresCommentApproval(userID int64, userRole string) bool {
+	return c.CommentPolicy == CommentPolicyApprovalRequired && !c.CanEdit(userID, userRole)
+}
@@ -0,0 +1,301 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+// MetadataFieldKind は種類別メタデータのフィールドの型を表します
+type MetadataFieldKind string
+
+const (
+	MetadataFieldString     MetadataFieldKind = "string"
+	MetadataFieldInteger    MetadataFieldKind = "integer"
+	MetadataFieldNumber     MetadataFieldKind = "number"
+	MetadataFieldBoolean    MetadataFieldKind = "boolean"
+	MetadataFieldEnum       MetadataFieldKind = "enum"
+	MetadataFieldStringList MetadataFieldKind = "string_list"
+)
+
+// メタデータの文字列・リストの上限
+const (
+	MetadataStringMaxLength = 200
+	MetadataListMaxItems    = 20
+)
+
+// MetadataField は種類別メタデータの1フィールドの定義です
+type MetadataField struct {
+	Key      string
+	Label    string
+	Kind     MetadataFieldKind
+	Required bool
+	Options  []string // Kind が enum の場合の選択肢
+	Min      *float64 // Kind が integer / number の場合の下限
+	Max      *float64 // Kind が integer / number の場合の上限
+}
+
+// MetadataSchema はコンテンツタイプごとの構造化レビュー項目（メタデータ）の定義です
+type MetadataSchema struct {
+	Key    string      // スキーマのキー（コンテンツタイプから参照する固定の識別子）
+	Type   ContentType // スキーマを使用するコンテンツタイプ（ContentTypeDefinition.MetadataSchemaで設定）
+	Fields []MetadataField
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+// metadataSchemas は種類別メタデータのスキーマレジストリです
+// コンテンツタイプはcontent_types.metadata_schemaでキーを参照するため、種類の表示名を変更しても、
+// 管理者が追加した種類でも同じスキーマを使用できます（スキーマを参照しない種類ではメタデータを指定できません）
+var metadataSchemas = map[string]*MetadataSchema{
+	"music": {
+		Key: "music",
+		Fields: []MetadataField{
+			{Key: "album", Label: "アルバム", Kind: MetadataFieldString},
+			{Key: "tracklist_highlights", Label: "おすすめ曲", Kind: MetadataFieldStringList},
+			{Key: "label", Label: "レーベル", Kind: MetadataFieldString},
+		},
+	},
+	"game": {
+		Key: "game",
+		Fields: []MetadataField{
+			{Key: "platform", Label: "プラットフォーム", Kind: MetadataFieldString},
+			{Key: "hours_played", Label: "プレイ時間", Kind: MetadataFieldNumber, Min: floatPtr(0), Max: floatPtr(100000)},
+			{Key: "difficulty", Label: "難易度", Kind: MetadataFieldEnum, Options: []string{"easy", "normal", "hard", "very_hard"}},
+		},
+	},
+	"anime": {
+		Key: "anime",
+		Fields: []MetadataField{
+			{Key: "studio", Label: "制作スタジオ", Kind: MetadataFieldString},
+			{Key: "episodes_watched", Label: "視聴話数", Kind: MetadataFieldInteger, Min: floatPtr(0), Max: floatPtr(10000)},
+		},
+	},
+	"manga": {
+		Key: "manga",
+		Fields: []MetadataField{
+			{Key: "publisher", Label: "出版社", Kind: MetadataFieldString},
+			{Key: "volumes_read", Label: "既読巻数", Kind: MetadataFieldInteger, Min: floatPtr(0), Max: floatPtr(1000)},
+			{Key: "completed_series", Label: "完結済み", Kind: MetadataFieldBoolean},
+		},
+	},
+	"movie": {
+		Key: "movie",
+		Fields: []MetadataField{
+			{Key: "director", Label: "監督", Kind: MetadataFieldString},
+			{Key: "runtime_minutes", Label: "上映時間（分）", Kind: MetadataFieldInteger, Min: floatPtr(1), Max: floatPtr(1000)},
+			{Key: "watched_in_theater", Label: "劇場で鑑賞", Kind: MetadataFieldBoolean},
+		},
+	},
+}
+
+// MetadataSchemaKeys は登録されているスキーマのキーを一覧で返します
+func MetadataSchemaKeys() []string {
+	keys := make([]string, 0, len(metadataSchemas))
+	for key := range metadataSchemas {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// IsValidMetadataSchemaKey はスキーマのキーが登録されているかチェックします
+func IsValidMetadataSchemaKey(key string) bool {
+	_, ok := metadataSchemas[key]
+	return ok
+}
+
+// Field は指定されたキーのフィールド定義を返します
+func (s *MetadataSchema) Field(key string) (*MetadataField, bool) {
+	for i := range s.Fields {
+		if s.Fields[i].Key == key {
+			return &s.Fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// Normalize はメタデータをスキーマに従って検証し、型を揃えた値を返します
+// JSONから読み込んだ数値（float64）は integer フィールドでは int64 に変換されます
+func (s *MetadataSchema) Normalize(metadata map[string]interface{}) (map[string]interface{}, error) {
+	normalized := make(map[string]interface{}, len(metadata))
+
+	for key, value := range metadata {
+		field, ok := s.Field(key)
+		if !ok {
+			return nil, domainErrors.NewValidationError(fmt.Sprintf("コンテンツタイプ「%s」では%sを指定できません", s.Type, key))
+		}
+		if value == nil {
+			continue
+		}
+
+		converted, err := field.normalizeValue(value)
+		if err != nil {
+			return nil, err
+		}
+		normalized[key] = converted
+	}
+
+	for _, field := range s.Fields {
+		if _, ok := normalized[field.Key]; field.Required && !ok {
+			return nil, domainErrors.NewValidationError(fmt.Sprintf("%sは必須です", field.Label))
+		}
+	}
+
+	return normalized, nil
+}
+
+// ParseFilterValue はクエリパラメータの文字列をフィールドの型の値に変換します（絞り込み用）
+func (f *MetadataField) ParseFilterValue(raw string) (interface{}, error) {
+	switch f.Kind {
+	case MetadataFieldInteger:
+		v, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, domainErrors.NewValidationError(fmt.Sprintf("%sは整数で指定してください", f.Label))
+		}
+		return v, nil
+	case MetadataFieldNumber:
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, domainErrors.NewValidationError(fmt.Sprintf("%sは数値で指定してください", f.Label))
+		}
+		return v, nil
+	case MetadataFieldBoolean:
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, domainErrors.NewValidationError(fmt.Sprintf("%sはtrueまたはfalseで指定してください", f.Label))
+		}
+		return v, nil
+	case MetadataFieldStringList:
+		// リストは指定した値を含むものに一致させる
+		return []string{raw}, nil
+	default:
+		return f.normalizeValue(raw)
+	}
+}
+
+// normalizeValue は値をフィールドの型に合わせて検証・変換します
+func (f *MetadataField) normalizeValue(value interface{}) (interface{}, error) {
+	switch f.Kind {
+	case MetadataFieldString:
+		s, ok := value.(string)
+		if !ok {
+			return nil, f.typeError("文字列")
+		}
+		s = strings.TrimSpace(s)
+		if len([]rune(s)) > MetadataStringMaxLength {
+			return nil, domainErrors.NewValidationError(fmt.Sprintf("%sは%d文字以内である必要があります", f.Label, MetadataStringMaxLength))
+		}
+		return s, nil
+
+	case MetadataFieldEnum:
+		s, ok := value.(string)
+		if !ok {
+			return nil, f.typeError("文字列")
+		}
+		for _, option := range f.Options {
+			if option == s {
+				return s, nil
+			}
+		}
+		return nil, domainErrors.NewValidationError(fmt.Sprintf("%sは %s のいずれかを指定してください", f.Label, strings.Join(f.Options, ", ")))
+
+	case MetadataFieldInteger:
+		n, ok := toFloat(value)
+		if !ok || n != math.Trunc(n) {
+			return nil, f.typeError("整数")
+		}
+		if err := f.checkRange(n); err != nil {
+			return nil, err
+		}
+		return int64(n), nil
+
+	case MetadataFieldNumber:
+		n, ok := toFloat(value)
+		if !ok {
+			return nil, f.typeError("数値")
+		}
+		if err := f.checkRange(n); err != nil {
+			return nil, err
+		}
+		return n, nil
+
+	case MetadataFieldBoolean:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, f.typeError("真偽値")
+		}
+		return b, nil
+
+	case MetadataFieldStringList:
+		var items []string
+		switch v := value.(type) {
+		case []string:
+			items = v
+		case []interface{}:
+			for _, item := range v {
+				s, ok := item.(string)
+				if !ok {
+					return nil, f.typeError("文字列の配列")
+				}
+				items = append(items, s)
+			}
+		default:
+			return nil, f.typeError("文字列の配列")
+		}
+		if len(items) > MetadataListMaxItems {
+			return nil, domainErrors.NewValidationError(fmt.Sprintf("%sは%d件以内である必要があります", f.Label, MetadataListMaxItems))
+		}
+		normalized := make([]string, 0, len(items))
+		for _, item := range items {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+			if len([]rune(item)) > MetadataStringMaxLength {
+				return nil, domainErrors.NewValidationError(fmt.Sprintf("%sの各項目は%d文字以内である必要があります", f.Label, MetadataStringMaxLength))
+			}
+			normalized = append(normalized, item)
+		}
+		return normalized, nil
+	}
+
+	return nil, domainErrors.NewValidationError(fmt.Sprintf("%sの型が不明です", f.Label))
+}
+
+func (f *MetadataField) checkRange(n float64) error {
+	if f.Min != nil && n < *f.Min {
+		return domainErrors.NewValidationError(fmt.Sprintf("%sは%v以上である必要があります", f.Label, *f.Min))
+	}
+	if f.Max != nil && n > *f.Max {
+		return domainErrors.NewValidationError(fmt.Sprintf("%sは%v以下である必要があります", f.Label, *f.Max))
+	}
+	return nil
+}
+
+func (f *MetadataField) typeError(expected string) error {
+	return domainErrors.NewValidationError(fmt.Sprintf("%sは%sで指定してください", f.Label, expected))
+}
+
+// toFloat は数値型の値をfloat64に変換します
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	}
+	return 0, false
+}
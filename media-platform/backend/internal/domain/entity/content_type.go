@@ -0,0 +1,189 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+// コンテンツの追加フィールド（種類ごとに入力可否をContentTypeDefinitionで管理）
+const (
+	ContentFieldArtistName  = "artist_name"
+	ContentFieldReleaseYear = "release_year"
+	ContentFieldImageURL    = "image_url"
+	ContentFieldExternalURL = "external_url"
+	ContentFieldTags        = "tags"
+)
+
+// ContentExtraFields は種類ごとに許可できる追加フィールドの一覧です
+var ContentExtraFields = []string{
+	ContentFieldArtistName,
+	ContentFieldReleaseYear,
+	ContentFieldImageURL,
+	ContentFieldExternalURL,
+	ContentFieldTags,
+}
+
+// ContentTypeNameMaxLength はコンテンツタイプ名・表示名の最大文字数です
+const ContentTypeNameMaxLength = 20
+
+// ContentTypeDefinition はコンテンツタイプ（趣味の種類）の定義を表すエンティティです
+// 管理者が追加・変更でき、種類ごとに入力可能な追加フィールドと既定カテゴリを持ちます
+// Nameはコンテンツ・作品・評価軸が参照する識別子のため作成後は変更できず、画面に表示する名前はDisplayNameで変更します
+type ContentTypeDefinition struct {
+	ID                int64
+	Name              ContentType
+	DisplayName       string
+	Description       string
+	AllowedFields     []string
+	MetadataSchemaKey string // 種類別の構造化項目のスキーマのキー（空の場合は構造化項目なし）
+	DefaultCategoryID *int64
+	SortOrder         int
+	IsActive          bool // falseの場合は新規投稿で選択できない
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// NewContentTypeDefinition は新しいコンテンツタイプ定義を作成します（表示名が空の場合は名前を使用）
+func NewContentTypeDefinition(name ContentType, displayName, description string, allowedFields []string, metadataSchemaKey string, defaultCategoryID *int64) (*ContentTypeDefinition, error) {
+	if displayName == "" {
+		displayName = string(name)
+	}
+
+	definition := &ContentTypeDefinition{
+		Name:              name,
+		DisplayName:       displayName,
+		Description:       description,
+		AllowedFields:     normalizeAllowedFields(allowedFields),
+		MetadataSchemaKey: metadataSchemaKey,
+		DefaultCategoryID: defaultCategoryID,
+		IsActive:          true,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	}
+
+	if err := definition.Validate(); err != nil {
+		return nil, err
+	}
+
+	return definition, nil
+}
+
+// Validate はコンテンツタイプ定義のドメインルールを検証します
+func (d *ContentTypeDefinition) Validate() error {
+	if !isValidContentTypeName(d.Name) {
+		return domainErrors.NewValidationError("コンテンツタイプ名は1〜20文字である必要があります")
+	}
+
+	if length := len([]rune(d.DisplayName)); length == 0 || length > ContentTypeNameMaxLength {
+		return domainErrors.NewValidationError("表示名は1〜20文字である必要があります")
+	}
+
+	for _, field := range d.AllowedFields {
+		if !IsValidContentExtraField(field) {
+			return domainErrors.NewValidationError(fmt.Sprintf("無効な追加フィールドです: %s", field))
+		}
+	}
+
+	if d.MetadataSchemaKey != "" && !IsValidMetadataSchemaKey(d.MetadataSchemaKey) {
+		return domainErrors.NewValidationError(fmt.Sprintf("構造化項目のスキーマは %s のいずれかを指定してください", strings.Join(MetadataSchemaKeys(), ", ")))
+	}
+
+	if d.DefaultCategoryID != nil && *d.DefaultCategoryID <= 0 {
+		return domainErrors.NewValidationError("無効な既定カテゴリIDです")
+	}
+
+	return nil
+}
+
+// Update はコンテンツタイプ定義を更新します（名前は変更できません）
+func (d *ContentTypeDefinition) Update(displayName, description string, allowedFields []string, metadataSchemaKey string, defaultCategoryID *int64, sortOrder int, isActive bool) error {
+	d.DisplayName = displayName
+	d.Description = description
+	d.AllowedFields = normalizeAllowedFields(allowedFields)
+	d.MetadataSchemaKey = metadataSchemaKey
+	d.DefaultCategoryID = defaultCategoryID
+	d.SortOrder = sortOrder
+	d.IsActive = isActive
+	d.UpdatedAt = time.Now()
+	return d.Validate()
+}
+
+// AllowsField は指定された追加フィールドを入力できるかどうかを返します
+func (d *ContentTypeDefinition) AllowsField(field string) bool {
+	for _, allowed := range d.AllowedFields {
+		if allowed == field {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateContent はコンテンツの追加フィールドと構造化項目がこの種類で許可されているか検証します
+func (d *ContentTypeDefinition) ValidateContent(content *Content) error {
+	for _, field := range content.ExtraFieldsInUse() {
+		if !d.AllowsField(field) {
+			return domainErrors.NewValidationError(fmt.Sprintf("コンテンツタイプ「%s」では%sを指定できません", d.Name, field))
+		}
+	}
+	if _, err := d.NormalizeMetadata(content.Metadata); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MetadataSchema はこの種類が参照する構造化項目のスキーマを返します
+func (d *ContentTypeDefinition) MetadataSchema() (*MetadataSchema, bool) {
+	base, ok := metadataSchemas[d.MetadataSchemaKey]
+	if !ok {
+		return nil, false
+	}
+	schema := *base
+	schema.Type = d.Name
+	return &schema, true
+}
+
+// NormalizeMetadata は構造化項目をこの種類のスキーマで検証し、正規化した値を返します
+func (d *ContentTypeDefinition) NormalizeMetadata(metadata map[string]interface{}) (map[string]interface{}, error) {
+	schema, ok := d.MetadataSchema()
+	if !ok {
+		if len(metadata) > 0 {
+			return nil, domainErrors.NewValidationError(fmt.Sprintf("コンテンツタイプ「%s」には構造化項目がありません", d.Name))
+		}
+		return map[string]interface{}{}, nil
+	}
+	return schema.Normalize(metadata)
+}
+
+// IsValidContentExtraField は追加フィールド名が有効かチェックします
+func IsValidContentExtraField(field string) bool {
+	for _, f := range ContentExtraFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeAllowedFields は追加フィールドの重複を取り除きます
+func normalizeAllowedFields(fields []string) []string {
+	seen := make(map[string]bool, len(fields))
+	normalized := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field == "" || seen[field] {
+			continue
+		}
+		seen[field] = true
+		normalized = append(normalized, field)
+	}
+	return normalized
+}
+
+// isValidContentTypeName はコンテンツタイプ名の形式が有効かチェックします
+// 種類が登録済みかどうかはContentTypeRepositoryで確認します
+func isValidContentTypeName(name ContentType) bool {
+	length := len([]rune(string(name)))
+	return length > 0 && length <= ContentTypeNameMaxLength
+}
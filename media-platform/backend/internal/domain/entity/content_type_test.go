@@ -0,0 +1,113 @@
+package entity
+
+import "testing"
+
+func TestNewContentTypeDefinition_DisplayName(t *testing.T) {
+	tests := []struct {
+		name        string
+		displayName string
+		want        string
+		wantErr     bool
+	}{
+		{name: "省略した場合は名前を使用", want: "ポッドキャスト"},
+		{name: "指定した表示名", displayName: "Podcast", want: "Podcast"},
+		{name: "長すぎる表示名", displayName: "あいうえおかきくけこさしすせそたちつてとな", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			definition, err := NewContentTypeDefinition("ポッドキャスト", tt.displayName, "", nil, "", nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewContentTypeDefinition() error = %v", err)
+			}
+			if definition.DisplayName != tt.want {
+				t.Fatalf("DisplayName = %q, want %q", definition.DisplayName, tt.want)
+			}
+		})
+	}
+}
+
+func TestContentTypeDefinition_UpdateKeepsName(t *testing.T) {
+	definition, err := NewContentTypeDefinition(ContentTypeMusic, "", "", nil, "music", nil)
+	if err != nil {
+		t.Fatalf("NewContentTypeDefinition() error = %v", err)
+	}
+
+	if err := definition.Update("ミュージック", "説明", nil, "music", nil, 1, true); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if definition.Name != ContentTypeMusic {
+		t.Fatalf("Name = %q, want %q", definition.Name, ContentTypeMusic)
+	}
+	if definition.DisplayName != "ミュージック" {
+		t.Fatalf("DisplayName = %q", definition.DisplayName)
+	}
+
+	if err := definition.Update("", "説明", nil, "music", nil, 1, true); err == nil {
+		t.Fatal("Update() with empty display name expected error")
+	}
+}
+
+func TestContentTypeDefinition_MetadataSchema(t *testing.T) {
+	tests := []struct {
+		name       string
+		typeName   ContentType
+		schemaKey  string
+		metadata   map[string]interface{}
+		wantSchema bool
+		wantErr    bool
+	}{
+		{name: "初期データの種類", typeName: ContentTypeGame, schemaKey: "game", metadata: map[string]interface{}{"hours_played": float64(12)}, wantSchema: true},
+		{name: "管理者が追加した種類にスキーマを割り当て", typeName: "ビジュアルノベル", schemaKey: "game", metadata: map[string]interface{}{"platform": "PC"}, wantSchema: true},
+		{name: "スキーマにない項目", typeName: ContentTypeGame, schemaKey: "game", metadata: map[string]interface{}{"studio": "x"}, wantSchema: true, wantErr: true},
+		{name: "スキーマなしの種類は項目を指定できない", typeName: "ポッドキャスト", metadata: map[string]interface{}{"platform": "PC"}, wantErr: true},
+		{name: "スキーマなしの種類で項目なし", typeName: "ポッドキャスト"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			definition, err := NewContentTypeDefinition(tt.typeName, "", "", nil, tt.schemaKey, nil)
+			if err != nil {
+				t.Fatalf("NewContentTypeDefinition() error = %v", err)
+			}
+
+			schema, ok := definition.MetadataSchema()
+			if ok != tt.wantSchema {
+				t.Fatalf("MetadataSchema() ok = %v, want %v", ok, tt.wantSchema)
+			}
+			if ok && (schema.Key != tt.schemaKey || schema.Type != tt.typeName) {
+				t.Fatalf("schema = %s/%s, want %s/%s", schema.Key, schema.Type, tt.schemaKey, tt.typeName)
+			}
+
+			_, err = definition.NormalizeMetadata(tt.metadata)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeMetadata() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestContentTypeDefinition_MetadataSchemaSurvivesDisplayNameChange(t *testing.T) {
+	definition, err := NewContentTypeDefinition(ContentTypeMovie, "", "", nil, "movie", nil)
+	if err != nil {
+		t.Fatalf("NewContentTypeDefinition() error = %v", err)
+	}
+	if err := definition.Update("シネマ", "", nil, definition.MetadataSchemaKey, nil, 0, true); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if _, ok := definition.MetadataSchema(); !ok {
+		t.Fatal("schema should be kept after changing the display name")
+	}
+}
+
+func TestNewContentTypeDefinition_InvalidMetadataSchema(t *testing.T) {
+	if _, err := NewContentTypeDefinition("ポッドキャスト", "", "", nil, "podcast", nil); err == nil {
+		t.Fatal("expected error for unknown metadata schema")
+	}
+}
@@ -0,0 +1,198 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DomainEventType はドメインイベントの種類です
+type DomainEventType string
+
+const (
+	DomainEventContentPublished DomainEventType = "content.published" // コンテンツが公開された
+	DomainEventCommentCreated   DomainEventType = "comment.created"   // コメントが投稿された（承認待ちを含む）
+	DomainEventCommentApproved  DomainEventType = "comment.approved"  // 承認待ちのコメントが承認された
+	DomainEventLikeToggled      DomainEventType = "like.toggled"      // いいねした・いいねを取り消した
+	DomainEventUserFollowed     DomainEventType = "user.followed"     // ユーザーをフォローした
+	DomainEventUserUnfollowed   DomainEventType = "user.unfollowed"   // フォローを解除した
+)
+
+// DomainEvent はサービスが状態を変更したときに発行するイベントです
+// アウトボックスにJSONで保存され、ディスパッチャーがプロセス内の購読者に配信します
+type DomainEvent interface {
+	EventType() DomainEventType
+}
+
+// ContentPublished はコンテンツが公開されたことを表します（作成時に公開した場合を含む）
+type ContentPublished struct {
+	ContentID   int64     `json:"content_id"`
+	AuthorID    int64     `json:"author_id"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// CommentCreated はコメントが投稿されたことを表します
+// 承認待ちのコメントは承認時に CommentApproved が発行されます
+type CommentCreated struct {
+	CommentID int64  `json:"comment_id"`
+	ContentID int64  `json:"content_id"`
+	UserID    int64  `json:"user_id"`
+	ParentID  *int64 `json:"parent_id,omitempty"`
+	IsPending bool   `json:"is_pending"`
+}
+
+// CommentApproved は承認待ちのコメントが承認されて公開されたことを表します
+type CommentApproved struct {
+	CommentID  int64 `json:"comment_id"`
+	ContentID  int64 `json:"content_id"`
+	UserID     int64 `json:"user_id"`     // コメントの投稿者
+	ApproverID int64 `json:"approver_id"` // 承認したユーザー
+}
+
+// LikeToggled はいいねの状態が変わったことを表します
+type LikeToggled struct {
+	ContentID int64 `json:"content_id"`
+	UserID    int64 `json:"user_id"`
+	Liked     bool  `json:"liked"`
+	LikeCount int64 `json:"like_count"` // 操作後のいいね数
+}
+
+// UserFollowed はユーザーをフォローしたことを表します
+type UserFollowed struct {
+	FollowID    int64 `json:"follow_id"`
+	FollowerID  int64 `json:"follower_id"`
+	FollowingID int64 `json:"following_id"`
+}
+
+// UserUnfollowed はフォローを解除したことを表します
+type UserUnfollowed struct {
+	FollowerID  int64 `json:"follower_id"`
+	FollowingID int64 `json:"following_id"`
+}
+
+func (*ContentPublished) EventType() DomainEventType { return DomainEventContentPublished }
+func (*CommentCreated) EventType() DomainEventType   { return DomainEventCommentCreated }
+func (*CommentApproved) EventType() DomainEventType  { return DomainEventCommentApproved }
+func (*LikeToggled) EventType() DomainEventType      { return DomainEventLikeToggled }
+func (*UserFollowed) EventType() DomainEventType     { return DomainEventUserFollowed }
+func (*UserUnfollowed) EventType() DomainEventType   { return DomainEventUserUnfollowed }
+
+// newDomainEvent は種類に対応するイベントの空の値を返します
+func newDomainEvent(eventType DomainEventType) (DomainEvent, error) {
+	switch eventType {
+	case DomainEventContentPublished:
+		return &ContentPublished{}, nil
+	case DomainEventCommentCreated:
+		return &CommentCreated{}, nil
+	case DomainEventCommentApproved:
+		return &CommentApproved{}, nil
+	case DomainEventLikeToggled:
+		return &LikeToggled{}, nil
+	case DomainEventUserFollowed:
+		return &UserFollowed{}, nil
+	case DomainEventUserUnfollowed:
+		return &UserUnfollowed{}, nil
+	default:
+		return nil, fmt.Errorf("unknown domain event type: %s", eventType)
+	}
+}
+
+// アウトボックスのディスパッチの設定
+const (
+	OutboxMaxAttempts    = 10                 // この回数失敗したらデッドレターにする
+	OutboxRetryBaseDelay = 5 * time.Second    // 1回目の再試行までの待ち時間（以降は2倍ずつ）
+	OutboxRetryMaxDelay  = time.Hour          // 再試行までの最大の待ち時間
+	OutboxDispatchLease  = 2 * time.Minute    // ディスパッチ中のイベントを他のワーカーが取得しない時間
+	OutboxRetention      = 7 * 24 * time.Hour // 配信済みのイベントを保持する期間
+	outboxErrorMaxLength = 1024               // 保存するエラーメッセージの最大バイト数
+)
+
+// OutboxEventStatus はアウトボックスのイベントの状態です
+type OutboxEventStatus string
+
+const (
+	OutboxEventPending    OutboxEventStatus = "pending"    // 配信待ち（再試行待ちを含む）
+	OutboxEventDispatched OutboxEventStatus = "dispatched" // すべての購読者が処理した
+	OutboxEventDead       OutboxEventStatus = "dead"       // 再試行の上限に達した（デッドレター）
+)
+
+// OutboxEvent は状態の変更と同じトランザクションでアウトボックスに保存されたドメインイベントです
+type OutboxEvent struct {
+	ID            int64
+	EventID       string // イベントのID（購読者の重複排除に使えます）
+	Type          DomainEventType
+	Payload       []byte // イベントのJSON
+	Status        OutboxEventStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	DispatchedAt  *time.Time
+	OccurredAt    time.Time
+}
+
+// NewOutboxEvent はドメインイベントからすぐに配信する新しいアウトボックスのイベントを作成します
+func NewOutboxEvent(eventID string, event DomainEvent) (*OutboxEvent, error) {
+	if event == nil {
+		return nil, errors.New("domain event is nil")
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("domain event encoding failed: %w", err)
+	}
+
+	now := time.Now()
+	return &OutboxEvent{
+		EventID:       eventID,
+		Type:          event.EventType(),
+		Payload:       payload,
+		Status:        OutboxEventPending,
+		NextAttemptAt: now,
+		OccurredAt:    now,
+	}, nil
+}
+
+// Decode はペイロードを種類に対応するドメインイベントに変換します（*ContentPublished など）
+func (e *OutboxEvent) Decode() (DomainEvent, error) {
+	event, err := newDomainEvent(e.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(e.Payload, event); err != nil {
+		return nil, fmt.Errorf("domain event decoding failed: %w", err)
+	}
+	return event, nil
+}
+
+// MarkDispatched はすべての購読者が処理したことを記録します
+func (e *OutboxEvent) MarkDispatched(now time.Time) {
+	e.Attempts++
+	e.Status = OutboxEventDispatched
+	e.LastError = ""
+	e.DispatchedAt = &now
+}
+
+// Fail は購読者の処理が失敗したことを記録し、再試行を予約します（上限に達した場合はデッドレター）
+func (e *OutboxEvent) Fail(errMessage string, now time.Time) {
+	e.Attempts++
+	e.LastError = truncateBytes(errMessage, outboxErrorMaxLength)
+	if e.Attempts >= OutboxMaxAttempts {
+		e.Status = OutboxEventDead
+		return
+	}
+	e.Status = OutboxEventPending
+	e.NextAttemptAt = now.Add(OutboxRetryDelay(e.Attempts))
+}
+
+// OutboxRetryDelay はattempts回失敗した後の再試行までの待ち時間を返します（指数バックオフ）
+func OutboxRetryDelay(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	if shift := attempts - 1; shift < 20 {
+		if d := OutboxRetryBaseDelay << shift; d < OutboxRetryMaxDelay {
+			return d
+		}
+	}
+	return OutboxRetryMaxDelay
+}
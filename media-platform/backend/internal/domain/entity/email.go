@@ -0,0 +1,20 @@
+package entity
+
+// EmailTemplate はメールのテンプレート名です
+type EmailTemplate string
+
+const (
+	EmailTemplateFollow  EmailTemplate = "follow"
+	EmailTemplateReply   EmailTemplate = "reply"
+	EmailTemplateMention EmailTemplate = "mention"
+	EmailTemplateDigest  EmailTemplate = "digest"
+)
+
+// EmailMessage は送信するメールを表すValue Objectです
+// 本文はテキストとHTMLの両方を持ち、multipart/alternativeとして送信します
+type EmailMessage struct {
+	To       string
+	Subject  string
+	TextBody string
+	HTMLBody string
+}
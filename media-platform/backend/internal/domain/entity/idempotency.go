@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// IdempotencyKeyMaxLength はIdempotency-Keyの最大長です
+const IdempotencyKeyMaxLength = 255
+
+// IdempotencyKeyTTL はIdempotency-Keyを保持する期間です（経過後は同じキーを再利用できる）
+const IdempotencyKeyTTL = 24 * time.Hour
+
+// IdempotencyRecord はIdempotency-Keyごとのリクエストと保存したレスポンスを表すエンティティです
+type IdempotencyRecord struct {
+	UserID       int64
+	Key          string
+	RequestHash  string // メソッド・パス・ボディのハッシュ（同じキーで異なるリクエストを検出する）
+	StatusCode   *int   // nilの場合は処理中
+	ContentType  string
+	ResponseBody []byte
+	CreatedAt    time.Time
+}
+
+// IsCompleted はレスポンスが保存済みかどうかを返します
+func (r *IdempotencyRecord) IsCompleted() bool {
+	return r.StatusCode != nil
+}
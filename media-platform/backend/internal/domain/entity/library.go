@@ -0,0 +1,235 @@
+package entity
+
+import (
+	"time"
+
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+// LibraryStatus はライブラリの消費状況を表す型です
+type LibraryStatus string
+
+const (
+	LibraryStatusWant       LibraryStatus = "want"        // 気になる
+	LibraryStatusInProgress LibraryStatus = "in_progress" // 視聴・読書・プレイ中
+	LibraryStatusCompleted  LibraryStatus = "completed"   // 完了
+	LibraryStatusDropped    LibraryStatus = "dropped"     // 中断
+)
+
+// LibraryStatuses はライブラリの消費状況の一覧です
+var LibraryStatuses = []LibraryStatus{
+	LibraryStatusWant,
+	LibraryStatusInProgress,
+	LibraryStatusCompleted,
+	LibraryStatusDropped,
+}
+
+// ProgressUnit は進捗の単位を表す型です
+type ProgressUnit string
+
+const (
+	ProgressUnitNone    ProgressUnit = ""
+	ProgressUnitEpisode ProgressUnit = "episode" // 話数（アニメ・ドラマ）
+	ProgressUnitChapter ProgressUnit = "chapter" // 章・話（漫画・小説）
+	ProgressUnitVolume  ProgressUnit = "volume"  // 巻
+	ProgressUnitTrack   ProgressUnit = "track"   // トラック（音楽）
+)
+
+// LibraryNoteMaxLength はプライベートメモの最大文字数です
+const LibraryNoteMaxLength = 2000
+
+// LibraryEntry はユーザーのライブラリ（作品・コンテンツの消費記録）を表すエンティティです
+// 作品（WorkID）かコンテンツ（ContentID）のどちらか一方を対象とします
+type LibraryEntry struct {
+	ID            int64
+	UserID        int64
+	WorkID        *int64
+	ContentID     *int64
+	Status        LibraryStatus
+	Progress      int
+	ProgressTotal *int
+	ProgressUnit  ProgressUnit
+	StartedOn     *time.Time
+	FinishedOn    *time.Time
+	Note          string // 本人のみ閲覧できるメモ
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	// 一覧表示用（作品またはコンテンツから取得、保存はしない）
+	ItemTitle string
+	ItemType  ContentType
+}
+
+// NewLibraryEntry は新しいライブラリエントリを作成します
+func NewLibraryEntry(userID int64, workID, contentID *int64, status LibraryStatus) (*LibraryEntry, error) {
+	entry := &LibraryEntry{
+		UserID:    userID,
+		WorkID:    workID,
+		ContentID: contentID,
+		Status:    status,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	entry.applyStatusDates(time.Now())
+
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// Validate はライブラリエントリのドメインルールを検証します
+func (e *LibraryEntry) Validate() error {
+	if e.UserID == 0 {
+		return domainErrors.NewValidationError("ユーザーIDは必須です")
+	}
+
+	if (e.WorkID == nil) == (e.ContentID == nil) {
+		return domainErrors.NewValidationError("作品IDかコンテンツIDのどちらか一方を指定してください")
+	}
+
+	if !IsValidLibraryStatus(e.Status) {
+		return domainErrors.NewValidationError("無効なライブラリステータスです")
+	}
+
+	if !isValidProgressUnit(e.ProgressUnit) {
+		return domainErrors.NewValidationError("無効な進捗の単位です")
+	}
+
+	if e.Progress < 0 {
+		return domainErrors.NewValidationError("進捗は0以上である必要があります")
+	}
+
+	if e.ProgressTotal != nil {
+		if *e.ProgressTotal <= 0 {
+			return domainErrors.NewValidationError("全体数は1以上である必要があります")
+		}
+		if e.Progress > *e.ProgressTotal {
+			return domainErrors.NewValidationError("進捗が全体数を超えています")
+		}
+	}
+
+	if e.StartedOn != nil && e.FinishedOn != nil && e.FinishedOn.Before(*e.StartedOn) {
+		return domainErrors.NewValidationError("終了日は開始日以降である必要があります")
+	}
+
+	if len([]rune(e.Note)) > LibraryNoteMaxLength {
+		return domainErrors.NewValidationError("メモは2000文字以内である必要があります")
+	}
+
+	return nil
+}
+
+// SetStatus はステータスを設定し、開始日・終了日が未設定であれば補完します
+func (e *LibraryEntry) SetStatus(status LibraryStatus) error {
+	if !IsValidLibraryStatus(status) {
+		return domainErrors.NewValidationError("無効なライブラリステータスです")
+	}
+	e.Status = status
+	e.applyStatusDates(time.Now())
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetProgress は進捗を設定します
+func (e *LibraryEntry) SetProgress(progress int, total *int, unit ProgressUnit) error {
+	e.Progress = progress
+	e.ProgressTotal = total
+	e.ProgressUnit = unit
+	e.UpdatedAt = time.Now()
+	return e.Validate()
+}
+
+// SetDates は開始日・終了日を設定します
+func (e *LibraryEntry) SetDates(startedOn, finishedOn *time.Time) error {
+	e.StartedOn = startedOn
+	e.FinishedOn = finishedOn
+	e.UpdatedAt = time.Now()
+	return e.Validate()
+}
+
+// SetNote はプライベートメモを設定します
+func (e *LibraryEntry) SetNote(note string) error {
+	if len([]rune(note)) > LibraryNoteMaxLength {
+		return domainErrors.NewValidationError("メモは2000文字以内である必要があります")
+	}
+	e.Note = note
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsOwnedBy は指定されたユーザーのエントリかどうかを返します
+func (e *LibraryEntry) IsOwnedBy(userID int64) bool {
+	return e.UserID == userID
+}
+
+// applyStatusDates はステータスに応じて開始日・終了日を補完します
+func (e *LibraryEntry) applyStatusDates(now time.Time) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	switch e.Status {
+	case LibraryStatusInProgress:
+		if e.StartedOn == nil {
+			e.StartedOn = &today
+		}
+	case LibraryStatusCompleted:
+		if e.StartedOn == nil {
+			e.StartedOn = &today
+		}
+		if e.FinishedOn == nil {
+			e.FinishedOn = &today
+		}
+		// 全体数が分かっている場合は進捗を最後まで進める
+		if e.ProgressTotal != nil {
+			e.Progress = *e.ProgressTotal
+		}
+	}
+}
+
+// IsValidLibraryStatus はライブラリステータスが有効かチェックします
+func IsValidLibraryStatus(status LibraryStatus) bool {
+	for _, s := range LibraryStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// isValidProgressUnit は進捗の単位が有効かチェックします
+func isValidProgressUnit(unit ProgressUnit) bool {
+	switch unit {
+	case ProgressUnitNone, ProgressUnitEpisode, ProgressUnitChapter, ProgressUnitVolume, ProgressUnitTrack:
+		return true
+	}
+	return false
+}
+
+// LibraryFilter はライブラリ一覧の絞り込み条件を表すValue Objectです
+type LibraryFilter struct {
+	Status       LibraryStatus // 空の場合は全ステータス
+	Type         ContentType   // 作品・コンテンツの種類（空の場合は全種類）
+	FinishedYear *int          // 終了日の年
+	Target       string        // "work" / "content"（空の場合は両方）
+}
+
+// LibraryStats はライブラリの集計を表すValue Objectです
+type LibraryStats struct {
+	Year            *int // 集計対象の年（nilの場合は全期間）
+	Total           int
+	ByStatus        map[LibraryStatus]int
+	CompletedByType map[ContentType]int // 種類ごとの完了数（Yearが指定された場合はその年に終了したもの）
+}
+
+// NewLibraryStats は全ステータスを0件で初期化したLibraryStatsを作成します
+func NewLibraryStats(year *int) *LibraryStats {
+	byStatus := make(map[LibraryStatus]int, len(LibraryStatuses))
+	for _, status := range LibraryStatuses {
+		byStatus[status] = 0
+	}
+	return &LibraryStats{
+		Year:            year,
+		ByStatus:        byStatus,
+		CompletedByType: make(map[ContentType]int),
+	}
+}
@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"regexp"
+	"time"
+	"unicode/utf8"
+)
+
+// MentionSource はメンションを含む本文の種類です
+type MentionSource string
+
+const (
+	MentionSourceContent MentionSource = "content"
+	MentionSourceComment MentionSource = "comment"
+)
+
+// MentionMaxPerBody は1つの本文で解決するメンションの最大数です（これを超えるユーザー名は無視する）
+const MentionMaxPerBody = 20
+
+// mentionPattern はメンションの候補（@に続くユーザー名に使用できる文字の並び）です
+var mentionPattern = regexp.MustCompile(`@[A-Za-z0-9_]+`)
+
+// Mention は本文中でメンションされたユーザーを表すエンティティです
+type Mention struct {
+	ID        int64
+	Source    MentionSource
+	SourceID  int64
+	UserID    int64  // メンションされたユーザー
+	Username  string // 取得時に結合されるユーザー名
+	CreatedAt time.Time
+}
+
+// MentionToken は本文から抽出された@usernameの位置です
+// Start・Endは文字（Unicodeコードポイント）単位のオフセットで、Endは@usernameの直後を指します
+type MentionToken struct {
+	Username string
+	Start    int
+	End      int
+}
+
+// MentionEvent は新たにメンションされたユーザーへの通知のためのイベントです
+type MentionEvent struct {
+	Source           MentionSource
+	SourceID         int64
+	ContentID        int64 // メンションが含まれるコンテンツ（コメントの場合はコメント先）
+	ActorID          int64 // 本文を投稿・編集したユーザー
+	MentionedUserIDs []int64
+}
+
+// ParseMentions は本文から@usernameを出現順に抽出します
+// メールアドレスのように直前が英数字の場合や、ユーザー名として無効な長さの場合は対象外です
+func ParseMentions(body string) []MentionToken {
+	var tokens []MentionToken
+	for _, loc := range mentionPattern.FindAllStringIndex(body, -1) {
+		if loc[0] > 0 && isMentionWordByte(body[loc[0]-1]) {
+			continue
+		}
+
+		username := body[loc[0]+1 : loc[1]]
+		if len(username) < 3 || len(username) > 100 {
+			continue
+		}
+
+		start := utf8.RuneCountInString(body[:loc[0]])
+		tokens = append(tokens, MentionToken{
+			Username: username,
+			Start:    start,
+			End:      start + utf8.RuneCountInString(body[loc[0]:loc[1]]),
+		})
+	}
+	return tokens
+}
+
+// MentionedUsernames は本文中のユーザー名を重複を除いて出現順に返します（最大MentionMaxPerBody件）
+func MentionedUsernames(tokens []MentionToken) []string {
+	seen := make(map[string]bool, len(tokens))
+	var usernames []string
+	for _, token := range tokens {
+		if seen[token.Username] {
+			continue
+		}
+		if len(usernames) >= MentionMaxPerBody {
+			break
+		}
+		seen[token.Username] = true
+		usernames = append(usernames, token.Username)
+	}
+	return usernames
+}
+
+func isMentionWordByte(b byte) bool {
+	return b == '_' || b == '@' ||
+		('0' <= b && b <= '9') || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z')
+}
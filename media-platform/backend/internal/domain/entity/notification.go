@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// NotificationType は通知の種類を表します
+type NotificationType string
+
+const (
+	NotificationTypeLike    NotificationType = "like"    // コンテンツへのいいね
+	NotificationTypeComment NotificationType = "comment" // コンテンツへのコメント
+	NotificationTypeReply   NotificationType = "reply"   // コメントへの返信
+	NotificationTypeFollow  NotificationType = "follow"  // フォロー
+	NotificationTypeMention NotificationType = "mention" // 本文中のメンション
+)
+
+// IsValidNotificationType は通知の種類が有効か判定します
+func IsValidNotificationType(t NotificationType) bool {
+	switch t {
+	case NotificationTypeLike, NotificationTypeComment, NotificationTypeReply, NotificationTypeFollow, NotificationTypeMention:
+		return true
+	}
+	return false
+}
+
+// NotificationActor は通知の原因となったユーザーの概要を表すValue Objectです
+type NotificationActor struct {
+	ID       int64
+	Username string
+	Avatar   string
+}
+
+// Notification はユーザーへの通知を表すエンティティです
+// 同じ対象への同じ種類の通知は未読の間1件にまとめられ、ActorCountに関わったユーザー数を数えます
+type Notification struct {
+	ID           int64
+	UserID       int64 // 通知を受け取るユーザー
+	Type         NotificationType
+	GroupKey     string // まとめる単位（例: content:12）
+	ContentID    *int64
+	CommentID    *int64
+	ContentTitle string             // 対象のコンテンツのタイトル（取得時のみ）
+	LatestActor  *NotificationActor // 最後に関わったユーザー（削除済みの場合はnil）
+	ActorCount   int
+	IsRead       bool
+	ReadAt       *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewNotification は新しい通知を作成します（groupKeyが同じ未読の通知があればまとめられます）
+func NewNotification(userID int64, notificationType NotificationType, groupKey string, contentID, commentID *int64) *Notification {
+	now := time.Now()
+	return &Notification{
+		UserID:    userID,
+		Type:      notificationType,
+		GroupKey:  groupKey,
+		ContentID: contentID,
+		CommentID: commentID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// ContentGroupKey はコンテンツ単位でまとめる通知のキーを返します
+func ContentGroupKey(contentID int64) string {
+	return fmt.Sprintf("content:%d", contentID)
+}
+
+// CommentGroupKey はコメント単位でまとめる通知のキーを返します
+func CommentGroupKey(commentID int64) string {
+	return fmt.Sprintf("comment:%d", commentID)
+}
+
+// FollowGroupKey はフォロー通知をまとめるキーです
+const FollowGroupKey = "followers"
+
+// Message は通知の表示用の文言を返します（例: 「taroさんと他12人があなたの「タイトル」にいいねしました」）
+func (n *Notification) Message() string {
+	actor := "削除されたユーザー"
+	if n.LatestActor != nil {
+		actor = n.LatestActor.Username
+	}
+	actors := actor + "さん"
+	if n.ActorCount > 1 {
+		actors = fmt.Sprintf("%sさんと他%d人", actor, n.ActorCount-1)
+	}
+
+	target := "あなたのコンテンツ"
+	if n.ContentTitle != "" {
+		target = fmt.Sprintf("あなたの「%s」", n.ContentTitle)
+	}
+
+	switch n.Type {
+	case NotificationTypeLike:
+		return fmt.Sprintf("%sが%sにいいねしました", actors, target)
+	case NotificationTypeComment:
+		return fmt.Sprintf("%sが%sにコメントしました", actors, target)
+	case NotificationTypeReply:
+		return fmt.Sprintf("%sがあなたのコメントに返信しました", actors)
+	case NotificationTypeFollow:
+		return fmt.Sprintf("%sがあなたをフォローしました", actors)
+	case NotificationTypeMention:
+		return fmt.Sprintf("%sがあなたをメンションしました", actors)
+	}
+	return ""
+}
@@ -0,0 +1,70 @@
+package entity
+
+import "time"
+
+// メール通知の言語
+const (
+	LocaleJa      = "ja"
+	LocaleEn      = "en"
+	DefaultLocale = LocaleJa
+)
+
+// 週間ダイジェストの設定
+const (
+	DigestPeriod        = 7 * 24 * time.Hour // ダイジェストの対象期間（前回の処理からこの期間が過ぎたユーザーに送信する）
+	DigestMaxItems      = 5                  // ダイジェストに載せる投稿数
+	DigestCheckInterval = time.Hour          // 送信時期になったユーザーを確認する間隔
+	DigestClaimLease    = 30 * time.Minute   // 処理中のユーザーを他のインスタンスが取得しない時間（失敗した場合はこの後に再試行）
+)
+
+// IsSupportedLocale はメール通知に対応している言語か判定します
+func IsSupportedLocale(locale string) bool {
+	return locale == LocaleJa || locale == LocaleEn
+}
+
+// NotificationPreference はユーザーのメール通知の設定を表すエンティティです
+// 設定を保存していないユーザーはすべてのメール通知を受け取ります
+type NotificationPreference struct {
+	UserID       int64
+	EmailFollow  bool   // フォローされたとき
+	EmailReply   bool   // コメントに返信されたとき
+	EmailMention bool   // メンションされたとき
+	EmailDigest  bool   // フォロー中のユーザーの週間ダイジェスト
+	Locale       string // メールの言語（ja / en）
+	UpdatedAt    time.Time
+}
+
+// NewNotificationPreference はデフォルトのメール通知の設定を作成します
+func NewNotificationPreference(userID int64) *NotificationPreference {
+	return &NotificationPreference{
+		UserID:       userID,
+		EmailFollow:  true,
+		EmailReply:   true,
+		EmailMention: true,
+		EmailDigest:  true,
+		Locale:       DefaultLocale,
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// WantsEmail は通知の種類についてメールを受け取る設定か判定します
+func (p *NotificationPreference) WantsEmail(notificationType NotificationType) bool {
+	switch notificationType {
+	case NotificationTypeFollow:
+		return p.EmailFollow
+	case NotificationTypeReply:
+		return p.EmailReply
+	case NotificationTypeMention:
+		return p.EmailMention
+	default:
+		return false
+	}
+}
+
+// EmailRecipient はメールの送信先のユーザーです
+type EmailRecipient struct {
+	UserID   int64
+	Username string
+	Email    string
+	Locale   string
+}
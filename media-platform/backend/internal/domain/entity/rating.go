@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"math"
 	"time"
 
 	domainErrors "media-platform/internal/domain/errors"
@@ -14,6 +15,15 @@ type Rating struct {
 	ContentID int64
 	CreatedAt time.Time
 	UpdatedAt time.Time
+
+	User *RatingUser // 評価したユーザーの概要（一覧取得でユーザー情報を含めた場合のみ設定）
+}
+
+// RatingUser は評価したユーザーの概要を表すValue Objectです
+type RatingUser struct {
+	ID       int64
+	Username string
+	Avatar   string
 }
 
 // Validate は評価のドメインルールを検証します
@@ -83,14 +93,44 @@ type RatingStats struct {
 	ContentID int64 `json:"content_id"`
 	LikeCount int   `json:"like_count"` // いいね数のみ
 	Count     int   `json:"count"`      // 総評価数（like_countと同じ）
+
+	// 星評価（1〜5）の集計
+	StarCount int         `json:"star_count"`
+	Average   *float64    `json:"average"`   // 星評価がない場合はnil
+	Histogram map[int]int `json:"histogram"` // 星の数ごとの件数（1〜5）
 }
 
-// NewRatingStats は新しいRatingStatsを作成します
+// NewRatingStats は新しいRatingStatsを作成します（星評価は0件で初期化）
 func NewRatingStats(contentID int64, likeCount int) *RatingStats {
+	histogram := make(map[int]int, StarRatingMax)
+	for stars := StarRatingMin; stars <= StarRatingMax; stars++ {
+		histogram[stars] = 0
+	}
+
 	return &RatingStats{
 		ContentID: contentID,
 		LikeCount: likeCount,
 		Count:     likeCount, // いいねのみなので同じ値
+		Histogram: histogram,
+	}
+}
+
+// AddStars は星の数ごとの件数を集計に加え、件数と平均を再計算します
+func (rs *RatingStats) AddStars(stars, count int) {
+	if stars < StarRatingMin || stars > StarRatingMax || count <= 0 {
+		return
+	}
+	rs.Histogram[stars] += count
+
+	total, sum := 0, 0
+	for s, c := range rs.Histogram {
+		total += c
+		sum += s * c
+	}
+	rs.StarCount = total
+	if total > 0 {
+		average := math.Round(float64(sum)/float64(total)*100) / 100
+		rs.Average = &average
 	}
 }
 
@@ -113,6 +153,93 @@ func NewRating(userID, contentID int64, value int) (*Rating, error) {
 	return rating, nil
 }
 
+// LikeAction はいいねの操作を表します
+type LikeAction string
+
+const (
+	LikeActionLike   LikeAction = "like"   // いいねする（既にいいね済みなら何もしない）
+	LikeActionUnlike LikeAction = "unlike" // いいねを取り消す（いいねしていなければ何もしない）
+	LikeActionToggle LikeAction = "toggle" // いいね済みなら取り消し、そうでなければいいねする
+)
+
+// LikeState はいいね操作後の状態を表すValue Objectです
+type LikeState struct {
+	ContentID int64
+	UserID    int64  // いいねしたユーザー（管理者が削除した場合も削除されたいいねのユーザー）
+	RatingID  *int64 // いいね済みの場合の評価ID
+	Liked     bool   // 操作後にいいね済みかどうか
+	Changed   bool   // 操作によって状態が変わったかどうか
+	LikeCount int64  // 操作後のいいね数
+}
+
+// 星評価の範囲
+const (
+	StarRatingMin = 1
+	StarRatingMax = 5
+)
+
+// BayesianPriorWeight はベイズ平均で事前分布（全体平均）に与える仮想的な評価件数です
+// 評価件数が少ないコンテンツの平均は全体平均に引き寄せられます
+const BayesianPriorWeight = 5
+
+// RatingRankMode は人気コンテンツの並び順を表します
+type RatingRankMode string
+
+const (
+	RatingRankByLikes    RatingRankMode = "likes"    // いいね数順
+	RatingRankByBayesian RatingRankMode = "bayesian" // 星評価のベイズ平均順
+)
+
+// IsValidRatingRankMode は並び順が有効かチェックします
+func IsValidRatingRankMode(mode RatingRankMode) bool {
+	return mode == RatingRankByLikes || mode == RatingRankByBayesian
+}
+
+// StarRating は読者による星評価（1〜5）を表すエンティティです
+// いいね（Rating）とは独立して、ユーザーごと・コンテンツごとに1件保持します
+type StarRating struct {
+	ID        int64
+	Stars     int
+	UserID    int64
+	ContentID int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewStarRating は新しい星評価エンティティを作成します
+func NewStarRating(userID, contentID int64, stars int) (*StarRating, error) {
+	rating := &StarRating{
+		Stars:     stars,
+		UserID:    userID,
+		ContentID: contentID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if err := rating.Validate(); err != nil {
+		return nil, err
+	}
+
+	return rating, nil
+}
+
+// Validate は星評価のドメインルールを検証します
+func (r *StarRating) Validate() error {
+	if r.UserID == 0 {
+		return domainErrors.NewValidationError("ユーザーIDは必須です")
+	}
+
+	if r.ContentID == 0 {
+		return domainErrors.NewValidationError("コンテンツIDは必須です")
+	}
+
+	if r.Stars < StarRatingMin || r.Stars > StarRatingMax {
+		return domainErrors.NewValidationError("星評価は1〜5の整数である必要があります")
+	}
+
+	return nil
+}
+
 // HasLikes はいいねが存在するかどうかを返します
 func (rs *RatingStats) HasLikes() bool {
 	return rs.LikeCount > 0
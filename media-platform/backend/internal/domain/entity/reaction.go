@@ -0,0 +1,146 @@
+package entity
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+// ReactionTarget はリアクションを付ける対象の種類です
+type ReactionTarget string
+
+const (
+	ReactionTargetContent ReactionTarget = "content"
+	ReactionTargetComment ReactionTarget = "comment"
+)
+
+// IsValidReactionTarget はリアクション対象の種類が有効かチェックします
+func IsValidReactionTarget(target ReactionTarget) bool {
+	return target == ReactionTargetContent || target == ReactionTargetComment
+}
+
+var reactionKindKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,29}$`)
+
+// ReactionKind はリアクションのパレット（使用できる絵文字）の1項目を表すエンティティです
+type ReactionKind struct {
+	ID        int64
+	Key       string // 英小文字の識別子（例: cry）
+	Emoji     string // 表示する絵文字（例: 😢）
+	Label     string // 表示名（例: 泣いた）
+	SortOrder int
+	IsActive  bool // falseの場合は新たにリアクションできない（既存のリアクションは残る）
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewReactionKind は新しいリアクションの種類を作成します
+func NewReactionKind(key, emoji, label string, sortOrder int) (*ReactionKind, error) {
+	kind := &ReactionKind{
+		Key:       strings.TrimSpace(key),
+		Emoji:     strings.TrimSpace(emoji),
+		Label:     strings.TrimSpace(label),
+		SortOrder: sortOrder,
+		IsActive:  true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if err := kind.Validate(); err != nil {
+		return nil, err
+	}
+
+	return kind, nil
+}
+
+// Validate はリアクションの種類のドメインルールを検証します
+func (k *ReactionKind) Validate() error {
+	if !reactionKindKeyPattern.MatchString(k.Key) {
+		return domainErrors.NewValidationError("リアクションのキーは英小文字で始まる30文字以内の英数字・アンダースコアである必要があります")
+	}
+
+	if k.Emoji == "" {
+		return domainErrors.NewValidationError("絵文字は必須です")
+	}
+
+	if len([]rune(k.Emoji)) > 8 {
+		return domainErrors.NewValidationError("絵文字は1つだけ指定してください")
+	}
+
+	if k.Label == "" {
+		return domainErrors.NewValidationError("リアクションの表示名は必須です")
+	}
+
+	if len([]rune(k.Label)) > 50 {
+		return domainErrors.NewValidationError("リアクションの表示名は50文字以内である必要があります")
+	}
+
+	return nil
+}
+
+// Update はリアクションの種類を更新します（キーは集計の識別に使うため変更不可）
+func (k *ReactionKind) Update(emoji, label string, sortOrder int, isActive bool) error {
+	k.Emoji = strings.TrimSpace(emoji)
+	k.Label = strings.TrimSpace(label)
+	k.SortOrder = sortOrder
+	k.IsActive = isActive
+	k.UpdatedAt = time.Now()
+	return k.Validate()
+}
+
+// Reaction はユーザーがコンテンツまたはコメントに付けたリアクションを表すエンティティです
+// 同じ対象に同じ種類のリアクションは1ユーザー1つまでです
+type Reaction struct {
+	ID         int64
+	UserID     int64
+	KindID     int64
+	TargetType ReactionTarget
+	TargetID   int64
+	CreatedAt  time.Time
+}
+
+// NewReaction は新しいリアクションを作成します
+func NewReaction(userID, kindID int64, targetType ReactionTarget, targetID int64) (*Reaction, error) {
+	reaction := &Reaction{
+		UserID:     userID,
+		KindID:     kindID,
+		TargetType: targetType,
+		TargetID:   targetID,
+		CreatedAt:  time.Now(),
+	}
+
+	if err := reaction.Validate(); err != nil {
+		return nil, err
+	}
+
+	return reaction, nil
+}
+
+// Validate はリアクションのドメインルールを検証します
+func (r *Reaction) Validate() error {
+	if r.UserID == 0 {
+		return domainErrors.NewValidationError("ユーザーIDは必須です")
+	}
+
+	if r.KindID == 0 {
+		return domainErrors.NewValidationError("リアクションの種類は必須です")
+	}
+
+	if !IsValidReactionTarget(r.TargetType) {
+		return domainErrors.NewValidationError("リアクションの対象は content または comment である必要があります")
+	}
+
+	if r.TargetID == 0 {
+		return domainErrors.NewValidationError("リアクションの対象IDは必須です")
+	}
+
+	return nil
+}
+
+// ReactionCount は対象ごと・種類ごとのリアクション数を表すValue Objectです
+type ReactionCount struct {
+	TargetID int64
+	KindID   int64
+	Count    int
+}
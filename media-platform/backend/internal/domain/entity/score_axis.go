@@ -0,0 +1,156 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+// AxisScoreMax は軸別スコアの最大値です（レビュースコアと同じ0〜5）
+const AxisScoreMax = 5.0
+
+var scoreAxisKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// ScoreAxis はコンテンツタイプごとの評価軸（ストーリー・映像・音響など）を表すエンティティです
+type ScoreAxis struct {
+	ID          int64
+	ContentType ContentType
+	Key         string // 英小文字の識別子（例: story, visuals）
+	Label       string // 表示名（例: ストーリー）
+	SortOrder   int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewScoreAxis は新しい評価軸を作成します
+func NewScoreAxis(contentType ContentType, key, label string, sortOrder int) (*ScoreAxis, error) {
+	axis := &ScoreAxis{
+		ContentType: contentType,
+		Key:         strings.TrimSpace(key),
+		Label:       strings.TrimSpace(label),
+		SortOrder:   sortOrder,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	if err := axis.Validate(); err != nil {
+		return nil, err
+	}
+
+	return axis, nil
+}
+
+// Validate は評価軸のドメインルールを検証します
+func (a *ScoreAxis) Validate() error {
+	if !isValidContentTypeName(a.ContentType) {
+		return domainErrors.NewValidationError("無効なコンテンツタイプです")
+	}
+
+	if !scoreAxisKeyPattern.MatchString(a.Key) {
+		return domainErrors.NewValidationError("評価軸のキーは英小文字で始まる50文字以内の英数字・アンダースコアである必要があります")
+	}
+
+	if a.Label == "" {
+		return domainErrors.NewValidationError("評価軸の表示名は必須です")
+	}
+
+	if len([]rune(a.Label)) > 50 {
+		return domainErrors.NewValidationError("評価軸の表示名は50文字以内である必要があります")
+	}
+
+	return nil
+}
+
+// Update は評価軸の表示名と表示順を更新します（キーはスコアの識別に使うため変更不可）
+func (a *ScoreAxis) Update(label string, sortOrder int) error {
+	a.Label = strings.TrimSpace(label)
+	a.SortOrder = sortOrder
+	a.UpdatedAt = time.Now()
+	return a.Validate()
+}
+
+// AxisScore はレビューの軸別スコアを表すValue Objectです
+type AxisScore struct {
+	AxisID int64
+	Key    string
+	Label  string
+	Score  float64
+}
+
+// ValidateAxisScore は軸別スコアが0〜5の範囲かつ小数第1位までかチェックします
+func ValidateAxisScore(label string, score float64) error {
+	if err := validateReviewScore(&score); err != nil {
+		return domainErrors.NewValidationError(fmt.Sprintf("%s: %s", label, err.Error()))
+	}
+	return nil
+}
+
+// AxisScoreStats は作品単位の軸別スコア集計を表すValue Objectです
+type AxisScoreStats struct {
+	AxisID       int64
+	Key          string
+	Label        string
+	Count        int
+	Average      *float64
+	Distribution map[int]int // 整数部ごとの件数（0〜5）
+}
+
+// NewAxisScoreStats は件数0で初期化した軸別スコア集計を作成します
+func NewAxisScoreStats(axis *ScoreAxis) *AxisScoreStats {
+	distribution := make(map[int]int, int(AxisScoreMax)+1)
+	for i := 0; i <= int(AxisScoreMax); i++ {
+		distribution[i] = 0
+	}
+	return &AxisScoreStats{
+		AxisID:       axis.ID,
+		Key:          axis.Key,
+		Label:        axis.Label,
+		Distribution: distribution,
+	}
+}
+
+// AxisScoreBucket はスコアを分布の区間（整数部）に変換します
+func AxisScoreBucket(score float64) int {
+	bucket := int(math.Floor(score))
+	if bucket < 0 {
+		return 0
+	}
+	if bucket > int(AxisScoreMax) {
+		return int(AxisScoreMax)
+	}
+	return bucket
+}
+
+// RadarChart はレーダーチャート描画用のデータを表すValue Objectです
+// Labels と Values は評価軸の表示順で対応し、スコアがない軸の値はnilになります
+type RadarChart struct {
+	Keys   []string
+	Labels []string
+	Values []*float64
+	Max    float64
+}
+
+// NewRadarChart は評価軸の一覧と軸ごとの値からレーダーチャートデータを作成します
+func NewRadarChart(axes []*ScoreAxis, values map[int64]float64) *RadarChart {
+	chart := &RadarChart{
+		Keys:   make([]string, 0, len(axes)),
+		Labels: make([]string, 0, len(axes)),
+		Values: make([]*float64, 0, len(axes)),
+		Max:    AxisScoreMax,
+	}
+	for _, axis := range axes {
+		chart.Keys = append(chart.Keys, axis.Key)
+		chart.Labels = append(chart.Labels, axis.Label)
+		if value, ok := values[axis.ID]; ok {
+			v := value
+			chart.Values = append(chart.Values, &v)
+		} else {
+			chart.Values = append(chart.Values, nil)
+		}
+	}
+	return chart
+}
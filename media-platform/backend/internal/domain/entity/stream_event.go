@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// StreamEventType はリアルタイム配信するイベントの種類を表します
+type StreamEventType string
+
+const (
+	StreamEventNotification StreamEventType = "notification" // 新しい通知（ユーザーのトピック）
+	StreamEventLikeCount    StreamEventType = "like_count"   // いいね数の変化（コンテンツのトピック）
+	StreamEventComment      StreamEventType = "comment"      // 新しいコメント（コンテンツのトピック）
+	StreamEventCommentEdit  StreamEventType = "comment_updated"
+	StreamEventCommentDrop  StreamEventType = "comment_deleted"
+	StreamEventTyping       StreamEventType = "typing" // 入力中の表示（ライブのトピック、再送しない）
+)
+
+const (
+	StreamMaxContentTopics = 50              // 1つの接続で購読できるコンテンツの最大数
+	LiveTypingInterval     = 2 * time.Second // 1つの接続から入力中のイベントを送る最短の間隔
+)
+
+// StreamEvent はクライアントにリアルタイム配信するイベントです
+// IDは配信元（ハブ）が割り当て、再接続時のLast-Event-IDによる再送に使います
+type StreamEvent struct {
+	ID        int64
+	Topic     string
+	Type      StreamEventType
+	Data      []byte // JSONエンコード済みのペイロード
+	Ephemeral bool   // trueの場合は再送用に保持しない（入力中の表示など）
+	CreatedAt time.Time
+}
+
+// UserTopic はユーザー宛てのイベントのトピック名を返します
+func UserTopic(userID int64) string {
+	return fmt.Sprintf("user:%d", userID)
+}
+
+// ContentTopic はコンテンツに関するイベントのトピック名を返します
+func ContentTopic(contentID int64) string {
+	return fmt.Sprintf("content:%d", contentID)
+}
+
+// LiveTopic はコンテンツのライブスレッドでのみ配信するイベント（入力中の表示）のトピック名を返します
+func LiveTopic(contentID int64) string {
+	return fmt.Sprintf("live:%d", contentID)
+}
@@ -196,6 +196,15 @@ func (u *User) SetAvatar(avatar string) error {
 		return errors.New("アバターURLは255文字以内である必要があります")
 	}
 
+	// http(s)のURLかサーバー上のパスのみ許可（"//host" のようなプロトコル相対URLは不可）
+	if avatar != "" && !strings.HasPrefix(avatar, "http://") &&
+		!strings.HasPrefix(avatar, "https://") && !strings.HasPrefix(avatar, "/") {
+		return errors.New("アバターURLの形式が不正です")
+	}
+	if strings.HasPrefix(avatar, "//") {
+		return errors.New("アバターURLの形式が不正です")
+	}
+
 	u.Avatar = avatar
 	u.UpdatedAt = time.Now()
 	return nil
@@ -0,0 +1,315 @@
+package entity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/rand"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// WebhookEventType はWebhookで配信するイベントの種類です
+type WebhookEventType string
+
+const (
+	WebhookEventContentPublished WebhookEventType = "content.published" // コンテンツが公開された
+	WebhookEventCommentCreated   WebhookEventType = "comment.created"   // コメントが投稿された（承認待ちは承認時）
+	WebhookEventUserFollowed     WebhookEventType = "user.followed"     // ユーザーがフォローされた
+	WebhookEventPing             WebhookEventType = "ping"              // 疎通確認（登録したエンドポイントにのみ送信）
+)
+
+// WebhookEventTypes は購読できるイベントの種類の一覧です
+var WebhookEventTypes = []WebhookEventType{
+	WebhookEventContentPublished,
+	WebhookEventCommentCreated,
+	WebhookEventUserFollowed,
+}
+
+// IsValidWebhookEventType は購読できるイベントの種類か判定します
+func IsValidWebhookEventType(eventType WebhookEventType) bool {
+	for _, t := range WebhookEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Webhookの署名に使うヘッダー
+// 署名は "{タイムスタンプ}.{本文}" のHMAC-SHA256で、受信側はタイムスタンプの古いリクエストを拒否することでリプレイを防げます
+const (
+	WebhookHeaderEvent     = "X-Webhook-Event"
+	WebhookHeaderDelivery  = "X-Webhook-Delivery"
+	WebhookHeaderTimestamp = "X-Webhook-Timestamp"
+	WebhookHeaderSignature = "X-Webhook-Signature" // "sha256=" + 16進数の署名
+)
+
+// Webhookの配信の設定
+const (
+	WebhookMaxAttempts       = 10              // この回数失敗したらデッドレターにする
+	WebhookRetryBaseDelay    = time.Minute     // 1回目の再送までの待ち時間（以降は2倍ずつ）
+	WebhookRetryMaxDelay     = 6 * time.Hour   // 再送までの最大の待ち時間
+	WebhookDeliveryLease     = 5 * time.Minute // 配信中のリクエストを他のワーカーが取得しない時間
+	WebhookResponseMaxLength = 1024            // 配信ログに保存するレスポンス本文の最大バイト数
+	WebhookURLMaxLength      = 2048            // エンドポイントURLの最大文字数
+	WebhookDescriptionMax    = 200             // 説明の最大文字数
+	webhookRetryJitter       = 0.2             // 再送の待ち時間のゆらぎ（±20%）
+	webhookSignaturePrefix   = "sha256="       // 署名ヘッダーの接頭辞
+)
+
+// Webhook はイベントを購読する外部のエンドポイントを表すエンティティです
+type Webhook struct {
+	ID          int64
+	OwnerID     int64 // 登録したユーザー（現在は管理者のみ登録可能）
+	URL         string
+	Secret      string // 署名に使う共有シークレット
+	Events      []WebhookEventType
+	Description string
+	IsActive    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewWebhook は新しいWebhookを作成します
+func NewWebhook(ownerID int64, rawURL string, events []WebhookEventType, description, secret string) (*Webhook, error) {
+	webhook := &Webhook{
+		OwnerID:   ownerID,
+		Secret:    secret,
+		IsActive:  true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := webhook.SetURL(rawURL); err != nil {
+		return nil, err
+	}
+	if err := webhook.SetEvents(events); err != nil {
+		return nil, err
+	}
+	if err := webhook.SetDescription(description); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// SetURL はエンドポイントURLを設定します（http/httpsの絶対URLのみ）
+func (w *Webhook) SetURL(rawURL string) error {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return errors.New("エンドポイントURLは必須です")
+	}
+	if len(rawURL) > WebhookURLMaxLength {
+		return errors.New("エンドポイントURLは2048文字以内である必要があります")
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("エンドポイントURLはhttpまたはhttpsの絶対URLである必要があります")
+	}
+	if parsed.User != nil {
+		return errors.New("エンドポイントURLに認証情報を含めることはできません")
+	}
+
+	w.URL = rawURL
+	w.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetEvents は購読するイベントを設定します（重複は除きます）
+func (w *Webhook) SetEvents(events []WebhookEventType) error {
+	if len(events) == 0 {
+		return errors.New("購読するイベントを1つ以上指定してください")
+	}
+
+	seen := map[WebhookEventType]bool{}
+	normalized := make([]WebhookEventType, 0, len(events))
+	for _, event := range events {
+		if !IsValidWebhookEventType(event) {
+			return errors.New("無効なイベントです: " + string(event))
+		}
+		if !seen[event] {
+			seen[event] = true
+			normalized = append(normalized, event)
+		}
+	}
+
+	w.Events = normalized
+	w.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetDescription は説明を設定します
+func (w *Webhook) SetDescription(description string) error {
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > WebhookDescriptionMax {
+		return errors.New("説明は200文字以内である必要があります")
+	}
+	w.Description = description
+	w.UpdatedAt = time.Now()
+	return nil
+}
+
+// Subscribes はイベントを購読しているか判定します
+func (w *Webhook) Subscribes(eventType WebhookEventType) bool {
+	for _, event := range w.Events {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign はタイムスタンプと本文からリクエストの署名ヘッダーの値を作成します
+func (w *Webhook) Sign(timestamp time.Time, body []byte) string {
+	return SignWebhookPayload(w.Secret, timestamp, body)
+}
+
+// SignWebhookPayload は "{UNIX秒}.{本文}" のHMAC-SHA256を "sha256=" 付きの16進数で返します
+// 受信側の検証でも同じ計算を行います
+func SignWebhookPayload(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature は署名ヘッダーの値が正しいか定数時間で比較します
+func VerifyWebhookSignature(secret string, timestamp time.Time, body []byte, signature string) bool {
+	return hmac.Equal([]byte(SignWebhookPayload(secret, timestamp, body)), []byte(signature))
+}
+
+// WebhookDeliveryStatus は配信の状態です
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"   // 配信待ち（再送待ちを含む）
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded" // 2xxの応答を受け取った
+	WebhookDeliveryDead      WebhookDeliveryStatus = "dead"      // 再送の上限に達した（デッドレター）
+)
+
+// IsValidWebhookDeliveryStatus は有効な配信の状態か判定します
+func IsValidWebhookDeliveryStatus(status WebhookDeliveryStatus) bool {
+	return status == WebhookDeliveryPending || status == WebhookDeliverySucceeded || status == WebhookDeliveryDead
+}
+
+// WebhookDelivery は1つのエンドポイントへの1つのイベントの配信（配信ログ）を表すエンティティです
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	EventID        string // イベントのID（同じイベントの配信は同じID。受信側の重複排除に使えます）
+	EventType      WebhookEventType
+	Payload        []byte // 送信するJSON本文
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastAttemptAt  *time.Time
+	ResponseStatus *int   // 最後の試行のHTTPステータス（接続エラーの場合はnil）
+	ResponseBody   string // 最後の試行のレスポンス本文（先頭WebhookResponseMaxLengthバイト）
+	LastError      string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+}
+
+// NewWebhookDelivery はすぐに配信する新しい配信を作成します
+func NewWebhookDelivery(webhookID int64, eventID string, eventType WebhookEventType, payload []byte) *WebhookDelivery {
+	now := time.Now()
+	return &WebhookDelivery{
+		WebhookID:     webhookID,
+		EventID:       eventID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        WebhookDeliveryPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+// Succeed は配信が成功したことを記録します
+func (d *WebhookDelivery) Succeed(statusCode int, body string, now time.Time) {
+	d.recordAttempt(&statusCode, body, "", now)
+	d.Status = WebhookDeliverySucceeded
+	d.DeliveredAt = &now
+}
+
+// Fail は配信が失敗したことを記録し、再送を予約します（上限に達した場合はデッドレター）
+// statusCodeは接続エラーなど応答がない場合はnilです
+func (d *WebhookDelivery) Fail(statusCode *int, body, errMessage string, now time.Time) {
+	d.recordAttempt(statusCode, body, errMessage, now)
+	if d.Attempts >= WebhookMaxAttempts {
+		d.Status = WebhookDeliveryDead
+		return
+	}
+	d.Status = WebhookDeliveryPending
+	d.NextAttemptAt = now.Add(WebhookRetryDelay(d.Attempts))
+}
+
+// DeadLetter は送信せずにデッドレターにします（Webhookが無効化されている場合など）
+func (d *WebhookDelivery) DeadLetter(reason string, now time.Time) {
+	d.LastAttemptAt = &now
+	d.ResponseStatus = nil
+	d.ResponseBody = ""
+	d.LastError = reason
+	d.Status = WebhookDeliveryDead
+}
+
+// Redeliver はデッドレターまたは成功した配信をすぐに再配信するよう戻します（試行回数はリセットします）
+func (d *WebhookDelivery) Redeliver() error {
+	if d.Status == WebhookDeliveryPending {
+		return errors.New("この配信はすでに配信待ちです")
+	}
+	d.Status = WebhookDeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = time.Now()
+	d.DeliveredAt = nil
+	return nil
+}
+
+func (d *WebhookDelivery) recordAttempt(statusCode *int, body, errMessage string, now time.Time) {
+	d.Attempts++
+	d.LastAttemptAt = &now
+	d.ResponseStatus = statusCode
+	d.ResponseBody = truncateBytes(body, WebhookResponseMaxLength)
+	d.LastError = errMessage
+}
+
+// WebhookRetryDelay はattempts回失敗した後の再送までの待ち時間を返します（指数バックオフ・±20%のゆらぎ付き）
+func WebhookRetryDelay(attempts int) time.Duration {
+	delay := WebhookRetryMaxDelay
+	if attempts < 1 {
+		attempts = 1
+	}
+	if shift := attempts - 1; shift < 20 {
+		if d := WebhookRetryBaseDelay << shift; d < WebhookRetryMaxDelay {
+			delay = d
+		}
+	}
+
+	jitter := (rand.Float64()*2 - 1) * webhookRetryJitter
+	return delay + time.Duration(float64(delay)*jitter)
+}
+
+// truncateBytes は文字列をnバイト以内に切り詰めます（UTF-8の文字の途中では切りません）
+func truncateBytes(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// WebhookEndpointResponse はエンドポイントからの応答です
+type WebhookEndpointResponse struct {
+	StatusCode int
+	Body       string // 先頭WebhookResponseMaxLengthバイト
+}
+
+// IsSuccess は2xxの応答か判定します
+func (r *WebhookEndpointResponse) IsSuccess() bool {
+	return r.StatusCode >= 200 && r.StatusCode < 300
+}
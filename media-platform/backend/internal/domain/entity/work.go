@@ -0,0 +1,201 @@
+package entity
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+// externalIDKeyPattern は外部IDのキー（isbn, jan, imdb など）の形式です
+var externalIDKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,31}$`)
+
+// Work はレビュー対象となる作品（映画・アルバム・ゲーム・漫画など）を表すエンティティです
+type Work struct {
+	ID              int64
+	Type            ContentType
+	Title           string
+	AlternateTitles []string // 別名・原題・略称など
+	Creator         string   // 監督・アーティスト・作者・開発元など
+	ReleaseYear     *int
+	ExternalIDs     map[string]string // 例: {"isbn": "...", "jan": "..."}
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// NewWork は新しい作品エンティティを作成します
+func NewWork(
+	workType ContentType,
+	title string,
+	alternateTitles []string,
+	creator string,
+	releaseYear *int,
+	externalIDs map[string]string,
+) (*Work, error) {
+	work := &Work{
+		Type:            workType,
+		Title:           strings.TrimSpace(title),
+		AlternateTitles: normalizeAlternateTitles(alternateTitles),
+		Creator:         strings.TrimSpace(creator),
+		ReleaseYear:     releaseYear,
+		ExternalIDs:     normalizeExternalIDs(externalIDs),
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+
+	if err := work.Validate(); err != nil {
+		return nil, err
+	}
+
+	return work, nil
+}
+
+// Validate は作品のドメインルールを検証します
+func (w *Work) Validate() error {
+	if !isValidWorkType(w.Type) {
+		return domainErrors.NewValidationError("無効な作品タイプです")
+	}
+
+	if w.Title == "" {
+		return domainErrors.NewValidationError("作品タイトルは必須です")
+	}
+
+	if len(w.Title) > 255 {
+		return domainErrors.NewValidationError("作品タイトルは255文字以内である必要があります")
+	}
+
+	if len(w.AlternateTitles) > 20 {
+		return domainErrors.NewValidationError("別タイトルは20件以内である必要があります")
+	}
+	for _, title := range w.AlternateTitles {
+		if len(title) > 255 {
+			return domainErrors.NewValidationError("別タイトルは255文字以内である必要があります")
+		}
+	}
+
+	if len(w.Creator) > 255 {
+		return domainErrors.NewValidationError("作者名は255文字以内である必要があります")
+	}
+
+	if w.ReleaseYear != nil && (*w.ReleaseYear < 1800 || *w.ReleaseYear > 2100) {
+		return domainErrors.NewValidationError("発売年は1800〜2100の範囲である必要があります")
+	}
+
+	for key, value := range w.ExternalIDs {
+		if !externalIDKeyPattern.MatchString(key) {
+			return domainErrors.NewValidationError("外部IDのキーは英小文字・数字・アンダースコアで指定してください")
+		}
+		if value == "" || len(value) > 100 {
+			return domainErrors.NewValidationError("外部IDの値は1〜100文字である必要があります")
+		}
+	}
+
+	return nil
+}
+
+// Update は作品情報をまとめて更新し、バリデーションを行います
+func (w *Work) Update(
+	workType ContentType,
+	title string,
+	alternateTitles []string,
+	creator string,
+	releaseYear *int,
+	externalIDs map[string]string,
+) error {
+	w.Type = workType
+	w.Title = strings.TrimSpace(title)
+	w.AlternateTitles = normalizeAlternateTitles(alternateTitles)
+	w.Creator = strings.TrimSpace(creator)
+	w.ReleaseYear = releaseYear
+	w.ExternalIDs = normalizeExternalIDs(externalIDs)
+	w.UpdatedAt = time.Now()
+	return w.Validate()
+}
+
+// isValidWorkType は作品タイプの形式が有効かチェックします（コンテンツタイプと共通）
+func isValidWorkType(workType ContentType) bool {
+	return isValidContentTypeName(workType)
+}
+
+// normalizeAlternateTitles は空白の除去と重複の排除を行います
+func normalizeAlternateTitles(titles []string) []string {
+	seen := make(map[string]bool, len(titles))
+	normalized := make([]string, 0, len(titles))
+	for _, title := range titles {
+		title = strings.TrimSpace(title)
+		if title == "" || seen[strings.ToLower(title)] {
+			continue
+		}
+		seen[strings.ToLower(title)] = true
+		normalized = append(normalized, title)
+	}
+	return normalized
+}
+
+// normalizeExternalIDs はキーを小文字化し、値の前後の空白を除去します
+// ISBN・JANはハイフンを取り除いて保存します
+func normalizeExternalIDs(ids map[string]string) map[string]string {
+	normalized := make(map[string]string, len(ids))
+	for key, value := range ids {
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		if key == "isbn" || key == "jan" {
+			value = strings.ReplaceAll(value, "-", "")
+		}
+		normalized[key] = value
+	}
+	return normalized
+}
+
+// RecommendationLevel はレビューのおすすめ度を表す型です
+type RecommendationLevel string
+
+const (
+	RecommendationMustSee     RecommendationLevel = "必見"
+	RecommendationRecommended RecommendationLevel = "おすすめ"
+	RecommendationNeutral     RecommendationLevel = "普通"
+	RecommendationMeh         RecommendationLevel = "イマイチ"
+)
+
+// RecommendationLevels はおすすめ度の一覧です（高い順）
+var RecommendationLevels = []RecommendationLevel{
+	RecommendationMustSee,
+	RecommendationRecommended,
+	RecommendationNeutral,
+	RecommendationMeh,
+}
+
+// IsValidRecommendationLevel はおすすめ度が有効かチェックします（空は未設定）
+func IsValidRecommendationLevel(level RecommendationLevel) bool {
+	if level == "" {
+		return true
+	}
+	for _, l := range RecommendationLevels {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
+
+// WorkReviewStats は作品に対するレビューの集計を表すValue Objectです
+type WorkReviewStats struct {
+	WorkID                     int64
+	ReviewCount                int
+	ScoredCount                int      // スコア付きレビュー数
+	AverageScore               *float64 // スコア付きレビューがない場合はnil
+	RecommendationDistribution map[RecommendationLevel]int
+}
+
+// NewWorkReviewStats は全おすすめ度を0件で初期化したWorkReviewStatsを作成します
+func NewWorkReviewStats(workID int64) *WorkReviewStats {
+	distribution := make(map[RecommendationLevel]int, len(RecommendationLevels))
+	for _, level := range RecommendationLevels {
+		distribution[level] = 0
+	}
+	return &WorkReviewStats{
+		WorkID:                     workID,
+		RecommendationDistribution: distribution,
+	}
+}
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// WorkMetadata は外部メタデータプロバイダーから取得した作品情報を表すValue Objectです
+// レビュー作成時の入力補完（タイトル・発売年・作者・カバー画像）に使用します
+type WorkMetadata struct {
+	Source      string            `json:"source"`    // 取得元プロバイダー名
+	SourceID    string            `json:"source_id"` // 取得元での作品ID
+	Type        ContentType       `json:"type"`
+	Title       string            `json:"title"`
+	Creator     string            `json:"creator"`
+	ReleaseYear *int              `json:"release_year,omitempty"`
+	CoverURL    string            `json:"cover_url,omitempty"`
+	ExternalIDs map[string]string `json:"external_ids,omitempty"`
+}
+
+// MetadataLookupTTL はメタデータ検索結果のキャッシュ有効期間です
+const MetadataLookupTTL = 7 * 24 * time.Hour
+
+// MetadataLookupKey は検索クエリを正規化したキャッシュキーを生成します
+func MetadataLookupKey(workType ContentType, query string) string {
+	normalized := strings.Join(strings.Fields(strings.ToLower(query)), " ")
+	return string(workType) + ":" + normalized
+}
+
+// DetectExternalIDType は検索文字列がISBN・JANコードかを判定し、正規化した値を返します
+// ISBN-13（978/979始まり）とISBN-10は "isbn"、それ以外の8桁・13桁の数字は "jan" とします
+func DetectExternalIDType(query string) (idType string, value string, ok bool) {
+	value = strings.ReplaceAll(strings.ReplaceAll(strings.TrimSpace(query), "-", ""), " ", "")
+	if value == "" {
+		return "", "", false
+	}
+
+	digits := value
+	if len(value) == 10 && (value[9] == 'X' || value[9] == 'x') {
+		digits = value[:9]
+		value = value[:9] + "X"
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", "", false
+		}
+	}
+
+	switch {
+	case len(value) == 10:
+		return "isbn", value, true
+	case len(value) == 13 && (strings.HasPrefix(value, "978") || strings.HasPrefix(value, "979")):
+		return "isbn", value, true
+	case len(value) == 13 || len(value) == 8:
+		return "jan", value, true
+	}
+	return "", "", false
+}
@@ -0,0 +1,54 @@
+package entity
+
+import "testing"
+
+func TestMetadataLookupKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		workType ContentType
+		query    string
+		want     string
+	}{
+		{name: "種類とクエリ", workType: ContentTypeMovie, query: "千と千尋", want: "映画:千と千尋"},
+		{name: "小文字に正規化", workType: ContentTypeMusic, query: "Spirited AWAY", want: "音楽:spirited away"},
+		{name: "空白をまとめる", workType: ContentTypeMovie, query: "  spirited \t  away  ", want: "映画:spirited away"},
+		{name: "種類なし", query: "Nausicaä", want: ":nausicaä"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MetadataLookupKey(tt.workType, tt.query); got != tt.want {
+				t.Fatalf("MetadataLookupKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectExternalIDType(t *testing.T) {
+	tests := []struct {
+		query      string
+		wantType   string
+		wantValue  string
+		wantDetect bool
+	}{
+		{query: "978-4-08-872509-3", wantType: "isbn", wantValue: "9784088725093", wantDetect: true},
+		{query: "4-08-872509-x", wantType: "isbn", wantValue: "408872509X", wantDetect: true},
+		{query: "4988008123456", wantType: "jan", wantValue: "4988008123456", wantDetect: true},
+		{query: "49123456", wantType: "jan", wantValue: "49123456", wantDetect: true},
+		{query: "千と千尋", wantDetect: false},
+		{query: "12345", wantDetect: false},
+		{query: "  ", wantDetect: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			idType, value, ok := DetectExternalIDType(tt.query)
+			if ok != tt.wantDetect {
+				t.Fatalf("DetectExternalIDType(%q) ok = %v, want %v", tt.query, ok, tt.wantDetect)
+			}
+			if ok && (idType != tt.wantType || value != tt.wantValue) {
+				t.Fatalf("DetectExternalIDType(%q) = %q, %q; want %q, %q", tt.query, idType, value, tt.wantType, tt.wantValue)
+			}
+		})
+	}
+}
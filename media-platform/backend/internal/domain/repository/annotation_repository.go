@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"media-platform/internal/domain/entity"
+)
+
+// AnnotationRepository は注釈の永続化に関するインターフェースです
+type AnnotationRepository interface {
+	// Find は指定したIDの注釈を取得します
+	Find(ctx context.Context, id int64) (*entity.Annotation, error)
+
+	// FindByContent はコンテンツの注釈を段落順・作成順に取得します（includeOrphanedがfalseの場合は孤立した注釈を除く）
+	FindByContent(ctx context.Context, contentID int64, includeOrphaned bool) ([]*entity.Annotation, error)
+
+	// Create は新しい注釈を作成します
+	Create(ctx context.Context, annotation *entity.Annotation) error
+
+	// UpdateBody は注釈の本文を更新します
+	UpdateBody(ctx context.Context, annotation *entity.Annotation) error
+
+	// UpdateAnchors は複数の注釈の段落の位置と孤立状態をまとめて更新します
+	UpdateAnchors(ctx context.Context, annotations []*entity.Annotation) error
+
+	// Delete は指定したIDの注釈を削除します
+	Delete(ctx context.Context, id int64) error
+}
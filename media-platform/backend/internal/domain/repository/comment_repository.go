@@ -11,27 +11,58 @@ type CommentRepository interface {
 	// Find は指定したIDのコメントを取得します
 	Find(ctx context.Context, id int64) (*entity.Comment, error)
 
-	// FindByContent はコンテンツに関連するコメントを取得します
-	FindByContent(ctx context.Context, contentID int64, limit, offset int) ([]*entity.Comment, error)
+	// FindByContent はコンテンツに関連するルートコメントを取得します（ピン留めされたコメントが先頭）
+	// 承認待ちのコメントはviewerが表示できるもののみ含みます
+	FindByContent(ctx context.Context, contentID int64, viewer entity.CommentViewer, sort entity.CommentSort, limit, offset int) ([]*entity.Comment, error)
 
-	// FindByUser はユーザーが投稿したコメントを取得します
+	// FindPending はコンテンツの承認待ちのコメントを古い順に取得します
+	FindPending(ctx context.Context, contentID int64, limit, offset int) ([]*entity.Comment, error)
+
+	// FindByUser はユーザーが投稿したコメントを取得します（承認待ちのコメントを除く）
 	FindByUser(ctx context.Context, userID int64, limit, offset int) ([]*entity.Comment, error)
 
-	// FindReplies はコメントに対する返信を取得します
-	FindReplies(ctx context.Context, parentID int64, limit, offset int) ([]*entity.Comment, error)
+	// FindReplies はコメントに対する返信を取得します（承認待ちの返信はviewerが表示できるもののみ）
+	FindReplies(ctx context.Context, parentID int64, viewer entity.CommentViewer, sort entity.CommentSort, limit, offset int) ([]*entity.Comment, error)
+
+	// FindTree はコメントツリーを再帰的に取得します（投稿者の概要と直接の返信数を含む）
+	FindTree(ctx context.Context, query entity.CommentTreeQuery) ([]*entity.CommentNode, error)
+
+	// FindDepth はコメントの階層（ルートコメントを0とする）を取得します
+	FindDepth(ctx context.Context, id int64) (int, error)
 
 	// Create は新しいコメントを作成します
 	Create(ctx context.Context, comment *entity.Comment) error
 
 	// Update は既存のコメントを更新します
-	Update(ctx context.Context, comment *entity.Comment) error
+	// 本文が変わる場合は編集前の本文をeditorIDの編集履歴として保存し、編集日時を設定します
+	Update(ctx context.Context, comment *entity.Comment, editorID int64) error
+
+	// Approve は承認待ちのコメントを承認します（承認待ちでない場合はNotFoundError）
+	Approve(ctx context.Context, id int64) error
+
+	// FindRevisions はコメントの編集履歴を新しい順に取得します
+	FindRevisions(ctx context.Context, commentID int64) ([]*entity.CommentRevision, error)
+
+	// SetPinned はコンテンツのピン留めコメントを設定します（commentIDがnilの場合はピン留めを解除）
+	SetPinned(ctx context.Context, contentID int64, commentID *int64) error
 
 	// Delete は指定したIDのコメントを削除します
 	Delete(ctx context.Context, id int64) error
 
-	// CountByContent はコンテンツに関連するコメント数を取得します
-	CountByContent(ctx context.Context, contentID int64) (int64, error)
+	// DeleteOrTombstone はコメントを削除します
+	// 返信がある場合は本文を消して墓標として残し（trueを返す）、ない場合は物理削除します
+	// 物理削除により返信がなくなった祖先の墓標も合わせて削除します
+	DeleteOrTombstone(ctx context.Context, id int64) (bool, error)
+
+	// PurgeOrphanTombstones は返信がなくなった墓標を削除し、削除件数を返します
+	PurgeOrphanTombstones(ctx context.Context) (int64, error)
 
-	// CountByUser はユーザーが投稿したコメント数を取得します
+	// CountByContent はコンテンツに関連するコメント数を取得します（墓標とviewerが表示できない承認待ちのコメントを除く）
+	CountByContent(ctx context.Context, contentID int64, viewer entity.CommentViewer) (int64, error)
+
+	// CountByUser はユーザーが投稿したコメント数を取得します（墓標と承認待ちのコメントを除く）
 	CountByUser(ctx context.Context, userID int64) (int64, error)
+
+	// CountPending はコンテンツの承認待ちのコメント数を取得します
+	CountPending(ctx context.Context, contentID int64) (int64, error)
 }
@@ -19,6 +19,12 @@ type ContentRepository interface {
 	// FindByCategory は指定したカテゴリのコンテンツ一覧を取得します
 	FindByCategory(ctx context.Context, categoryID int64, limit, offset int) ([]*entity.Content, error)
 
+	// FindByWork は指定した作品に紐づく公開済みのコンテンツ（レビュー）一覧を取得します
+	FindByWork(ctx context.Context, workID int64, limit, offset int) ([]*entity.Content, error)
+
+	// FindByTypeAndMetadata は種類と構造化項目（metadata @> 条件）で公開済みのコンテンツを絞り込みます（categoryIDがnilの場合はカテゴリを問いません）
+	FindByTypeAndMetadata(ctx context.Context, contentType entity.ContentType, categoryID *int64, metadata map[string]interface{}, limit, offset int) ([]*entity.Content, error)
+
 	// FindTrending は人気のコンテンツ一覧を取得します
 	FindTrending(ctx context.Context, limit int) ([]*entity.Content, error)
 
@@ -38,4 +44,7 @@ type ContentRepository interface {
 	IncrementViewCount(ctx context.Context, id int64) error
 
 	FindByStatus(ctx context.Context, status string, authorID int64, limit, offset int) ([]*entity.Content, error)
+
+	// ReconcileCounters はいいね数・コメント数を実際の件数で再集計し、補正したコンテンツ数を返します
+	ReconcileCounters(ctx context.Context) (int64, error)
 }
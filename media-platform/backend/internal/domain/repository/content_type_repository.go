@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"media-platform/internal/domain/entity"
+)
+
+// ContentTypeRepository はコンテンツタイプ定義の永続化に関するインターフェースです
+type ContentTypeRepository interface {
+	// FindAll はコンテンツタイプを表示順で取得します（includeInactiveがfalseの場合は有効なもののみ）
+	FindAll(ctx context.Context, includeInactive bool) ([]*entity.ContentTypeDefinition, error)
+
+	// Find は指定されたIDのコンテンツタイプを取得します
+	Find(ctx context.Context, id int64) (*entity.ContentTypeDefinition, error)
+
+	// FindByName は指定された名前のコンテンツタイプを取得します
+	FindByName(ctx context.Context, name entity.ContentType) (*entity.ContentTypeDefinition, error)
+
+	// Create は新しいコンテンツタイプを作成します
+	Create(ctx context.Context, definition *entity.ContentTypeDefinition) error
+
+	// Update は既存のコンテンツタイプを更新します（名前は作成後に変更できません）
+	Update(ctx context.Context, definition *entity.ContentTypeDefinition) error
+
+	// Delete は指定されたIDのコンテンツタイプを削除します（使用中の場合は削除できません）
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"media-platform/internal/domain/entity"
+)
+
+// IdempotencyRepository はIdempotency-Keyの永続化に関するインターフェースです
+type IdempotencyRepository interface {
+	// Reserve はキーを処理中として確保します
+	// 確保できた場合はtrue、既にキーが存在する場合はfalseと既存のレコードを返します
+	// expiredBefore より前に作成されたキーは期限切れとして上書きします
+	Reserve(ctx context.Context, userID int64, key, requestHash string, expiredBefore time.Time) (*entity.IdempotencyRecord, bool, error)
+
+	// Complete は処理中のキーにレスポンスを保存します
+	Complete(ctx context.Context, userID int64, key string, statusCode int, contentType string, body []byte) error
+
+	// Release は処理中のキーを解放します（処理に失敗した場合に再試行できるようにする）
+	Release(ctx context.Context, userID int64, key string) error
+
+	// DeleteExpired は期限切れのキーを削除し、削除件数を返します
+	DeleteExpired(ctx context.Context, expiredBefore time.Time) (int64, error)
+}
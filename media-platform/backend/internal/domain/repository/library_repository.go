@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"media-platform/internal/domain/entity"
+)
+
+// LibraryRepository はユーザーのライブラリ（消費記録）の永続化に関するインターフェースです
+type LibraryRepository interface {
+	// Find は指定されたIDのライブラリエントリを取得します
+	Find(ctx context.Context, id int64) (*entity.LibraryEntry, error)
+
+	// FindByUser はユーザーのライブラリエントリを絞り込み条件付きで取得し、総件数も返します
+	FindByUser(ctx context.Context, userID int64, filter *entity.LibraryFilter, limit, offset int) ([]*entity.LibraryEntry, int, error)
+
+	// Create は新しいライブラリエントリを作成します（同じ対象が登録済みの場合はConflictError）
+	Create(ctx context.Context, entry *entity.LibraryEntry) error
+
+	// Update は既存のライブラリエントリを更新します
+	Update(ctx context.Context, entry *entity.LibraryEntry) error
+
+	// Delete は指定されたIDのライブラリエントリを削除します
+	Delete(ctx context.Context, id int64) error
+
+	// GetStats はユーザーのライブラリ集計を取得します（yearがnilの場合は全期間）
+	GetStats(ctx context.Context, userID int64, year *int) (*entity.LibraryStats, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"media-platform/internal/domain/entity"
+)
+
+// MentionRepository はメンションの永続化に関するインターフェースです
+type MentionRepository interface {
+	// Replace は本文のメンションを指定されたユーザーに置き換えます（残ったメンションの通知済みの状態は保たれます）
+	Replace(ctx context.Context, source entity.MentionSource, sourceID int64, userIDs []int64) error
+
+	// MarkNotified は本文のまだ通知していないメンションを通知済みにし、そのユーザーのIDを返します
+	MarkNotified(ctx context.Context, source entity.MentionSource, sourceID int64) ([]int64, error)
+
+	// FindBySources は複数の本文のメンションをユーザー名付きで一括取得します（キーは本文のID）
+	FindBySources(ctx context.Context, source entity.MentionSource, sourceIDs []int64) (map[int64][]*entity.Mention, error)
+}
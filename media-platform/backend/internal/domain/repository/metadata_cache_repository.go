@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"media-platform/internal/domain/entity"
+)
+
+// MetadataCacheRepository は外部メタデータ検索結果のキャッシュを扱うインターフェースです
+type MetadataCacheRepository interface {
+	// Get は有効期限内のキャッシュを取得します（キャッシュがない場合はfound=false）
+	Get(ctx context.Context, provider, lookupKey string) (results []*entity.WorkMetadata, found bool, err error)
+
+	// Put は検索結果を保存します（既存のキャッシュは上書きされます）
+	Put(ctx context.Context, provider, lookupKey string, results []*entity.WorkMetadata, ttl time.Duration) error
+
+	// DeleteExpired は有効期限切れのキャッシュを削除し、削除件数を返します
+	DeleteExpired(ctx context.Context) (int64, error)
+}
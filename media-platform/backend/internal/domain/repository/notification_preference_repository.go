@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"media-platform/internal/domain/entity"
+)
+
+// NotificationPreferenceRepository はメール通知の設定の永続化に関するインターフェースです
+type NotificationPreferenceRepository interface {
+	// Find はユーザーの設定を取得します（保存されていない場合はデフォルトの設定）
+	Find(ctx context.Context, userID int64) (*entity.NotificationPreference, error)
+
+	// Upsert はユーザーの設定を保存します
+	Upsert(ctx context.Context, preference *entity.NotificationPreference) error
+
+	// ClaimDueDigestRecipients は週間ダイジェストを受け取るユーザーのうち、前回の処理がdueBefore以前（または未処理）で
+	// 他のインスタンスが処理中でないユーザーをID順に最大limit件取得し、leaseUntilまで他のインスタンスが取得しないようにします
+	ClaimDueDigestRecipients(ctx context.Context, dueBefore, now, leaseUntil time.Time, limit int) ([]*entity.EmailRecipient, error)
+
+	// MarkDigestSent はユーザーのダイジェストを処理した日時を記録し、処理中の状態を解除します
+	MarkDigestSent(ctx context.Context, userID int64, sentAt time.Time) error
+}
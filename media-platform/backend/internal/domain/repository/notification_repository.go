@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"media-platform/internal/domain/entity"
+)
+
+// NotificationRepository は通知の永続化に関するインターフェースです
+type NotificationRepository interface {
+	// Record は通知を記録します
+	// 同じ種類・同じまとめる単位の未読の通知があればそこにactorIDを追加し、まだ数えていないユーザーの場合のみtrueを返します
+	Record(ctx context.Context, notification *entity.Notification, actorID int64) (bool, error)
+
+	// Find は指定したIDの通知を最後に関わったユーザーとコンテンツのタイトル付きで取得します
+	Find(ctx context.Context, id int64) (*entity.Notification, error)
+
+	// FindByUser はユーザーの通知を更新日時の新しい順に取得します（unreadOnlyがtrueの場合は未読のみ）
+	FindByUser(ctx context.Context, userID int64, unreadOnly bool, limit, offset int) ([]*entity.Notification, error)
+
+	// CountByUser はユーザーの通知数を取得します（unreadOnlyがtrueの場合は未読のみ）
+	CountByUser(ctx context.Context, userID int64, unreadOnly bool) (int64, error)
+
+	// MarkRead はユーザーの通知を既読にします（ユーザーの通知でない場合はNotFoundError）
+	MarkRead(ctx context.Context, id, userID int64) error
+
+	// MarkAllRead はユーザーの未読の通知をすべて既読にし、既読にした件数を返します
+	MarkAllRead(ctx context.Context, userID int64) (int64, error)
+}
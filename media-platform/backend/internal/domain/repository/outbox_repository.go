@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"media-platform/internal/domain/entity"
+)
+
+// OutboxRepository はドメインイベントのアウトボックスの永続化に関するインターフェースです
+type OutboxRepository interface {
+	// Create はイベントを保存します（Transactorのトランザクション内で呼ぶと状態の変更と同時にコミットされます）
+	Create(ctx context.Context, events []*entity.OutboxEvent) error
+
+	// ClaimPending はnow以前に予定された配信待ちのイベントを発生順に最大limit件取得し、leaseUntilまで他のワーカーが取得しないようにします
+	ClaimPending(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.OutboxEvent, error)
+
+	// Update はディスパッチの結果を保存します
+	Update(ctx context.Context, event *entity.OutboxEvent) error
+
+	// DeleteDispatchedBefore はbefore以前に配信済みになったイベントを削除し、削除した件数を返します
+	DeleteDispatchedBefore(ctx context.Context, before time.Time) (int64, error)
+}
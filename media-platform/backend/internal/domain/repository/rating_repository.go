@@ -13,11 +13,14 @@ type RatingRepository interface {
 	// ユーザーIDとコンテンツIDによる評価取得（重複チェック用）
 	FindByUserAndContentID(ctx context.Context, userID, contentID int64) (*entity.Rating, error)
 
-	// コンテンツIDによる評価一覧取得
-	FindByContentID(ctx context.Context, contentID int64) ([]*entity.Rating, error)
+	// コンテンツIDによる評価一覧取得（新着順、includeUserがtrueの場合は評価したユーザーの概要を含める）
+	FindByContentID(ctx context.Context, contentID int64, limit, offset int, includeUser bool) ([]*entity.Rating, error)
 
-	// ユーザーIDによる評価一覧取得
-	FindByUserID(ctx context.Context, userID int64) ([]*entity.Rating, error)
+	// ユーザーIDによる評価一覧取得（新着順）
+	FindByUserID(ctx context.Context, userID int64, limit, offset int, includeUser bool) ([]*entity.Rating, error)
+
+	// ユーザーIDによる評価数取得
+	CountByUserID(ctx context.Context, userID int64) (int64, error)
 
 	// 評価の作成
 	Create(ctx context.Context, rating *entity.Rating) error
@@ -25,6 +28,14 @@ type RatingRepository interface {
 	// 評価の削除
 	Delete(ctx context.Context, id int64) error
 
+	// ApplyLike はいいね操作を1回のクエリで適用し、操作後の状態といいね数を返します
+	// 同時リクエストでも一意制約違反にならず、コンテンツが存在しない場合はNotFoundErrorを返します
+	ApplyLike(ctx context.Context, userID, contentID int64, action entity.LikeAction) (*entity.LikeState, error)
+
+	// DeleteLikeByID は評価IDでいいねを削除し、操作後の状態を返します
+	// 本人（allowOthersがtrueの場合は他人も可）の評価が存在しない場合はnilを返します
+	DeleteLikeByID(ctx context.Context, id, userID int64, allowOthers bool) (*entity.LikeState, error)
+
 	// コンテンツIDによるいいね統計取得
 	GetStatsByContentID(ctx context.Context, contentID int64) (*entity.RatingStats, error)
 
@@ -37,5 +48,17 @@ type RatingRepository interface {
 	// 指定期間内の評価数を取得（トレンド分析用）
 	CountByDateRange(ctx context.Context, contentID int64, startDate, endDate string) (int, error)
 
-	FindTopRatedContentIDs(ctx context.Context, limit, days int) ([]int64, error)
+	// 指定期間内の人気コンテンツID一覧を取得（いいね数順、または星評価のベイズ平均順）
+	FindTopRatedContentIDs(ctx context.Context, limit, days int, rankBy entity.RatingRankMode) ([]int64, error)
+
+	// ========== 星評価（1〜5） ==========
+
+	// ユーザーIDとコンテンツIDによる星評価取得（存在しない場合はnil）
+	FindStarRating(ctx context.Context, userID, contentID int64) (*entity.StarRating, error)
+
+	// 星評価の作成または更新（ユーザーごと・コンテンツごとに1件）
+	UpsertStarRating(ctx context.Context, rating *entity.StarRating) error
+
+	// 星評価の削除
+	DeleteStarRating(ctx context.Context, userID, contentID int64) error
 }
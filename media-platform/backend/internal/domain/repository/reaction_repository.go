@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+
+	"media-platform/internal/domain/entity"
+)
+
+// ReactionRepository はリアクションとパレットの永続化に関するインターフェースです
+type ReactionRepository interface {
+	// FindKinds はリアクションの種類を表示順で取得します
+	FindKinds(ctx context.Context, includeInactive bool) ([]*entity.ReactionKind, error)
+
+	// FindKind は指定されたIDのリアクションの種類を取得します
+	FindKind(ctx context.Context, id int64) (*entity.ReactionKind, error)
+
+	// FindKindByKey は指定されたキーのリアクションの種類を取得します
+	FindKindByKey(ctx context.Context, key string) (*entity.ReactionKind, error)
+
+	// CreateKind は新しいリアクションの種類を作成します
+	CreateKind(ctx context.Context, kind *entity.ReactionKind) error
+
+	// UpdateKind は既存のリアクションの種類を更新します
+	UpdateKind(ctx context.Context, kind *entity.ReactionKind) error
+
+	// DeleteKind は指定されたIDのリアクションの種類を削除します（その種類のリアクションも削除されます）
+	DeleteKind(ctx context.Context, id int64) error
+
+	// Find はユーザーが対象に付けた指定の種類のリアクションを取得します（存在しない場合はnil）
+	Find(ctx context.Context, userID, kindID int64, targetType entity.ReactionTarget, targetID int64) (*entity.Reaction, error)
+
+	// Create は新しいリアクションを作成します
+	Create(ctx context.Context, reaction *entity.Reaction) error
+
+	// Delete は指定されたIDのリアクションを削除します
+	Delete(ctx context.Context, id int64) error
+
+	// CountByTargets は複数の対象のリアクション数を種類ごとに一括取得します
+	CountByTargets(ctx context.Context, targetType entity.ReactionTarget, targetIDs []int64) ([]*entity.ReactionCount, error)
+}
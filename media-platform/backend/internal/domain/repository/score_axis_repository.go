@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"media-platform/internal/domain/entity"
+)
+
+// ScoreAxisRepository は評価軸と軸別スコアの永続化に関するインターフェースです
+type ScoreAxisRepository interface {
+	// FindByType は指定されたコンテンツタイプの評価軸を表示順で取得します
+	FindByType(ctx context.Context, contentType entity.ContentType) ([]*entity.ScoreAxis, error)
+
+	// Find は指定されたIDの評価軸を取得します
+	Find(ctx context.Context, id int64) (*entity.ScoreAxis, error)
+
+	// Create は新しい評価軸を作成します
+	Create(ctx context.Context, axis *entity.ScoreAxis) error
+
+	// Update は既存の評価軸を更新します
+	Update(ctx context.Context, axis *entity.ScoreAxis) error
+
+	// Delete は指定されたIDの評価軸を削除します（その軸のスコアも削除されます）
+	Delete(ctx context.Context, id int64) error
+
+	// FindScoresByContent はコンテンツ（レビュー）の軸別スコアを評価軸の表示順で取得します
+	FindScoresByContent(ctx context.Context, contentID int64) ([]*entity.AxisScore, error)
+
+	// ReplaceContentScores はコンテンツの軸別スコアを置き換えます（空の場合は全て削除）
+	ReplaceContentScores(ctx context.Context, contentID int64, scores []*entity.AxisScore) error
+
+	// GetWorkAxisStats は作品に紐づく公開済みレビューの軸別スコアを集計します
+	GetWorkAxisStats(ctx context.Context, workID int64, axes []*entity.ScoreAxis) ([]*entity.AxisScoreStats, error)
+}
@@ -0,0 +1,11 @@
+package repository
+
+import "context"
+
+// Transactor は複数のリポジトリ操作を1つのトランザクションで実行するためのインターフェースです
+type Transactor interface {
+	// WithinTransaction はfnをトランザクション内で実行し、エラーがなければコミット、あればロールバックします
+	// fnに渡されるコンテキストを使ったリポジトリ操作は同じトランザクションに参加します
+	// すでにトランザクション内の場合は外側のトランザクションに参加します
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
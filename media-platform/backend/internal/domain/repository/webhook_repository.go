@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"media-platform/internal/domain/entity"
+)
+
+// WebhookRepository はWebhookと配信ログの永続化に関するインターフェースです
+type WebhookRepository interface {
+	// Find は指定されたIDのWebhookを取得します
+	Find(ctx context.Context, id int64) (*entity.Webhook, error)
+
+	// FindAll はWebhookを作成日時の新しい順に取得します
+	FindAll(ctx context.Context, limit, offset int) ([]*entity.Webhook, error)
+
+	// Count はWebhookの件数を取得します
+	Count(ctx context.Context) (int64, error)
+
+	// FindSubscribed はイベントを購読している有効なWebhookを取得します
+	FindSubscribed(ctx context.Context, eventType entity.WebhookEventType) ([]*entity.Webhook, error)
+
+	// Create は新しいWebhookを作成します
+	Create(ctx context.Context, webhook *entity.Webhook) error
+
+	// Update は既存のWebhookを更新します
+	Update(ctx context.Context, webhook *entity.Webhook) error
+
+	// Delete は指定されたIDのWebhookを配信ログとともに削除します
+	Delete(ctx context.Context, id int64) error
+
+	// CreateDeliveries は配信をまとめて作成します（同じWebhookへの同じイベントの配信がすでにある場合は作成せず、IDは0のままです）
+	CreateDeliveries(ctx context.Context, deliveries []*entity.WebhookDelivery) error
+
+	// FindDelivery は指定されたIDの配信を取得します
+	FindDelivery(ctx context.Context, id int64) (*entity.WebhookDelivery, error)
+
+	// FindDeliveries はWebhookの配信を作成日時の新しい順に取得します（statusが空の場合はすべて）
+	FindDeliveries(ctx context.Context, webhookID int64, status entity.WebhookDeliveryStatus, limit, offset int) ([]*entity.WebhookDelivery, error)
+
+	// CountDeliveries はWebhookの配信の件数を取得します（statusが空の場合はすべて）
+	CountDeliveries(ctx context.Context, webhookID int64, status entity.WebhookDeliveryStatus) (int64, error)
+
+	// ClaimDueDeliveries はnow以前に予定された配信待ちを最大limit件取得し、leaseUntilまで他のワーカーが取得しないようにします
+	ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error)
+
+	// UpdateDelivery は配信の試行結果を保存します
+	UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error
+}
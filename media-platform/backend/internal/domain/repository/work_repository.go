@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"media-platform/internal/domain/entity"
+)
+
+// WorkRepository は作品カタログの永続化に関するインターフェースです
+type WorkRepository interface {
+	// Find は指定されたIDの作品を取得します
+	Find(ctx context.Context, id int64) (*entity.Work, error)
+
+	// FindByExternalID は外部ID（isbn, jan など）で作品を取得します
+	FindByExternalID(ctx context.Context, key, value string) (*entity.Work, error)
+
+	// Search はタイトル・別タイトル・作者名で作品を検索します（workTypeが空の場合は全種類）
+	Search(ctx context.Context, keyword string, workType entity.ContentType, limit, offset int) ([]*entity.Work, error)
+
+	// Create は新しい作品を作成します
+	Create(ctx context.Context, work *entity.Work) error
+
+	// Update は既存の作品情報を更新します
+	Update(ctx context.Context, work *entity.Work) error
+
+	// Delete は指定されたIDの作品を削除します（紐づくコンテンツの作品IDはNULLになります）
+	Delete(ctx context.Context, id int64) error
+
+	// GetReviewStats は作品に紐づく公開済みレビューの集計を取得します
+	GetReviewStats(ctx context.Context, workID int64) (*entity.WorkReviewStats, error)
+}
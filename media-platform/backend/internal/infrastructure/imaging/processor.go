@@ -0,0 +1,113 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+
+	// デコーダーの登録
+	_ "image/gif"
+	_ "image/png"
+)
+
+// jpegQuality は出力JPEGの品質です
+const jpegQuality = 90
+
+// Processor は標準ライブラリのみで画像の切り抜きと縮小を行います
+type Processor struct{}
+
+// NewProcessor は新しいProcessorを作成します
+func NewProcessor() *Processor {
+	return &Processor{}
+}
+
+// DecodeConfig は画像全体をデコードせずに形式と寸法を取得します
+func (p *Processor) DecodeConfig(data []byte) (string, int, int, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("failed to decode image config: %w", err)
+	}
+	return format, config.Width, config.Height, nil
+}
+
+// CropSquare は指定範囲を正方形に切り抜き、各サイズのJPEGを生成します
+func (p *Processor) CropSquare(data []byte, x, y, size int, outputSizes []int) (map[int][]byte, error) {
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	bounds := src.Bounds()
+	cropRect := image.Rect(bounds.Min.X+x, bounds.Min.Y+y, bounds.Min.X+x+size, bounds.Min.Y+y+size)
+	if !cropRect.In(bounds) {
+		return nil, fmt.Errorf("crop rectangle %v is outside of image bounds %v", cropRect, bounds)
+	}
+
+	// 透過部分は白で塗りつぶしてからRGBAに変換
+	cropped := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(cropped, cropped.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	draw.Draw(cropped, cropped.Bounds(), src, cropRect.Min, draw.Over)
+
+	results := make(map[int][]byte, len(outputSizes))
+	for _, outputSize := range outputSizes {
+		resized := resizeSquare(cropped, outputSize)
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, resized, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, fmt.Errorf("failed to encode %dpx image: %w", outputSize, err)
+		}
+		results[outputSize] = buf.Bytes()
+	}
+
+	return results, nil
+}
+
+// resizeSquare は正方形画像を平均画素法（ボックスフィルタ）で指定サイズに変換します
+// 拡大時は最近傍の画素を使用します
+func resizeSquare(src *image.RGBA, size int) *image.RGBA {
+	srcSize := src.Bounds().Dx()
+	if srcSize == size {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	for dy := 0; dy < size; dy++ {
+		sy0 := dy * srcSize / size
+		sy1 := (dy + 1) * srcSize / size
+		if sy1 <= sy0 {
+			sy1 = sy0 + 1
+		}
+
+		for dx := 0; dx < size; dx++ {
+			sx0 := dx * srcSize / size
+			sx1 := (dx + 1) * srcSize / size
+			if sx1 <= sx0 {
+				sx1 = sx0 + 1
+			}
+
+			var r, g, b, a, n uint32
+			for sy := sy0; sy < sy1; sy++ {
+				offset := src.PixOffset(sx0, sy)
+				for sx := sx0; sx < sx1; sx++ {
+					r += uint32(src.Pix[offset])
+					g += uint32(src.Pix[offset+1])
+					b += uint32(src.Pix[offset+2])
+					a += uint32(src.Pix[offset+3])
+					offset += 4
+					n++
+				}
+			}
+
+			offset := dst.PixOffset(dx, dy)
+			dst.Pix[offset] = uint8(r / n)
+			dst.Pix[offset+1] = uint8(g / n)
+			dst.Pix[offset+2] = uint8(b / n)
+			dst.Pix[offset+3] = uint8(a / n)
+		}
+	}
+
+	return dst
+}
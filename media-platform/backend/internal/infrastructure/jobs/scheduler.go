@@ -0,0 +1,71 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+)
+
+// Job は定期実行するバックグラウンド処理です
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Start はジョブを定期実行するgoroutineを起動します
+// Intervalが0以下の場合は起動しません。ctxがキャンセルされると停止します
+func Start(ctx context.Context, job Job) {
+	if job.Interval <= 0 {
+		log.Printf("⏸️  Job %s is disabled", job.Name)
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(job.Interval)
+		defer ticker.Stop()
+
+		log.Printf("⏱️  Job %s scheduled every %s", job.Name, job.Interval)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				runOnce(ctx, job)
+			}
+		}
+	}()
+}
+
+// runOnce はジョブを1回実行します（パニックしてもスケジューラーは止めない）
+func runOnce(ctx context.Context, job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("❌ Job %s panicked: %v", job.Name, r)
+		}
+	}()
+
+	if err := job.Run(ctx); err != nil {
+		log.Printf("❌ Job %s failed: %v", job.Name, err)
+	}
+}
+
+// IntervalFromEnv は環境変数から実行間隔を読み込みます（例: "1h", "30m"。"0"で無効）
+// 未設定または不正な値の場合はデフォルト値を返します
+func IntervalFromEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	if value == "0" {
+		return 0
+	}
+
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("⚠️  Invalid %s=%q, using default %s", key, value, defaultValue)
+		return defaultValue
+	}
+	return interval
+}
@@ -0,0 +1,62 @@
+package mail
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config はメール送信の設定を保持します
+type Config struct {
+	Provider string        // "smtp" または "log"
+	Host     string        // SMTPサーバーのホスト名
+	Port     int           // SMTPサーバーのポート
+	Username string        // SMTP認証のユーザー名（空の場合は認証しない）
+	Password string        // SMTP認証のパスワード
+	From     string        // 送信元メールアドレス
+	FromName string        // 送信元の表示名
+	Timeout  time.Duration // 1通の送信のタイムアウト
+	LogDir   string        // logプロバイダーで.emlファイルを書き出すディレクトリ（空の場合はログに出力）
+	BaseURL  string        // メール内のリンクに使うフロントエンドのURL
+}
+
+// LoadConfigFromEnv は環境変数から設定を読み込みます
+// SMTP_HOST が未設定の場合は送信せずにログへ出力する開発用の実装になります
+func LoadConfigFromEnv() *Config {
+	port, err := strconv.Atoi(getEnv("SMTP_PORT", "587"))
+	if err != nil || port <= 0 {
+		port = 587
+	}
+	timeout, err := time.ParseDuration(getEnv("SMTP_TIMEOUT", "10s"))
+	if err != nil || timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	host := getEnv("SMTP_HOST", "")
+	defaultProvider := "log"
+	if host != "" {
+		defaultProvider = "smtp"
+	}
+
+	return &Config{
+		Provider: getEnv("MAIL_PROVIDER", defaultProvider),
+		Host:     host,
+		Port:     port,
+		Username: getEnv("SMTP_USERNAME", ""),
+		Password: getEnv("SMTP_PASSWORD", ""),
+		From:     getEnv("MAIL_FROM", "noreply@localhost"),
+		FromName: getEnv("MAIL_FROM_NAME", "Media Platform"),
+		Timeout:  timeout,
+		LogDir:   getEnv("MAIL_LOG_DIR", ""),
+		BaseURL:  getEnv("APP_BASE_URL", "http://localhost:3000"),
+	}
+}
+
+// getEnv は環境変数の値を取得し、設定されていない場合はデフォルト値を返します
+func getEnv(key, defaultValue string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,64 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"media-platform/internal/domain/entity"
+)
+
+// unsafeFileChars はファイル名に使えない文字です
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// LogMailer はメールを送信せずにログまたは.emlファイルに出力する開発用の実装です
+type LogMailer struct {
+	dir  string
+	from *mail.Address
+}
+
+// NewLogMailer は新しいLogMailerを作成します（dirが空の場合はログに出力します）
+func NewLogMailer(config *Config) (*LogMailer, error) {
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MAIL_FROM: %w", err)
+	}
+	from.Name = config.FromName
+
+	if config.LogDir != "" {
+		if err := os.MkdirAll(config.LogDir, 0o755); err != nil {
+			return nil, fmt.Errorf("メール出力ディレクトリの作成に失敗しました: %w", err)
+		}
+	}
+	return &LogMailer{dir: config.LogDir, from: from}, nil
+}
+
+// Send はメールをログまたは.emlファイルに出力します
+func (m *LogMailer) Send(ctx context.Context, message *entity.EmailMessage) error {
+	if m.dir == "" {
+		log.Printf("📧 [mail] to=%s subject=%q\n%s", message.To, message.Subject, message.TextBody)
+		return nil
+	}
+
+	data, err := buildMessage(m.from, message)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s.eml",
+		time.Now().Format("20060102T150405.000000000"),
+		unsafeFileChars.ReplaceAllString(message.To, "_"),
+	)
+	path := filepath.Join(m.dir, name)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write mail file: %w", err)
+	}
+
+	log.Printf("📧 [mail] to=%s subject=%q saved to %s", message.To, message.Subject, path)
+	return nil
+}
@@ -0,0 +1,298 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"media-platform/internal/domain/entity"
+)
+
+// fakeSMTPSession はフェイクSMTPサーバーが受け取った内容です
+type fakeSMTPSession struct {
+	auth string // AUTH PLAINで受け取った "ユーザー名:パスワード"
+	from string
+	rcpt []string
+	data []byte
+}
+
+// fakeSMTPServer は1回の接続を受け付けるテスト用のSMTPサーバーです
+// rejectRcptがtrueの場合はRCPT TOを550で拒否します
+type fakeSMTPServer struct {
+	listener   net.Listener
+	rejectRcpt bool
+	sessions   chan *fakeSMTPSession
+}
+
+func newFakeSMTPServer(t *testing.T, rejectRcpt bool) *fakeSMTPServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	server := &fakeSMTPServer{listener: listener, rejectRcpt: rejectRcpt, sessions: make(chan *fakeSMTPSession, 1)}
+	go server.serve(t)
+	return server
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) serve(t *testing.T) {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	session := &fakeSMTPSession{}
+	defer func() { s.sessions <- session }()
+
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost ESMTP fake")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250-localhost")
+			tp.PrintfLine("250 AUTH PLAIN")
+		case "AUTH":
+			mechanism, initial, _ := strings.Cut(arg, " ")
+			decoded, err := base64.StdEncoding.DecodeString(initial)
+			if mechanism != "PLAIN" || err != nil {
+				tp.PrintfLine("535 authentication failed")
+				continue
+			}
+			// PLAINは "認可ID\x00ユーザー名\x00パスワード"
+			parts := strings.Split(string(decoded), "\x00")
+			if len(parts) == 3 {
+				session.auth = parts[1] + ":" + parts[2]
+			}
+			tp.PrintfLine("235 authenticated")
+		case "MAIL":
+			session.from = strings.TrimPrefix(arg, "FROM:")
+			tp.PrintfLine("250 ok")
+		case "RCPT":
+			if s.rejectRcpt {
+				tp.PrintfLine("550 no such user")
+				continue
+			}
+			session.rcpt = append(session.rcpt, strings.TrimPrefix(arg, "TO:"))
+			tp.PrintfLine("250 ok")
+		case "DATA":
+			tp.PrintfLine("354 end with <CRLF>.<CRLF>")
+			data, err := io.ReadAll(tp.DotReader())
+			if err != nil {
+				t.Errorf("failed to read DATA: %v", err)
+				return
+			}
+			session.data = data
+			tp.PrintfLine("250 queued")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("502 command not implemented")
+		}
+	}
+}
+
+func (s *fakeSMTPServer) session(t *testing.T) *fakeSMTPSession {
+	t.Helper()
+	select {
+	case session := <-s.sessions:
+		return session
+	case <-time.After(5 * time.Second):
+		t.Fatal("fake smtp server received no session")
+		return nil
+	}
+}
+
+func newTestSMTPMailer(t *testing.T, server *fakeSMTPServer, username, password string) *SMTPMailer {
+	t.Helper()
+	mailer, err := NewSMTPMailer(&Config{
+		Host:     "127.0.0.1",
+		Port:     server.port(),
+		Username: username,
+		Password: password,
+		From:     "noreply@example.com",
+		FromName: "Media Platform",
+		Timeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("NewSMTPMailer() error = %v", err)
+	}
+	return mailer
+}
+
+func TestNewSMTPMailer_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+	}{
+		{name: "ホスト未設定", config: &Config{From: "noreply@example.com"}},
+		{name: "不正な送信元", config: &Config{Host: "localhost", From: "not an address"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewSMTPMailer(tt.config); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	server := newFakeSMTPServer(t, false)
+	mailer := newTestSMTPMailer(t, server, "smtp-user", "smtp-pass")
+
+	message := &entity.EmailMessage{
+		To:       "Alice <alice@example.com>",
+		Subject:  "alice_devさんにフォローされました",
+		TextBody: "本文です。\n.ドットで始まる行\n",
+		HTMLBody: "<p>本文です。</p>",
+	}
+	if err := mailer.Send(context.Background(), message); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	session := server.session(t)
+
+	// エンベロープ
+	if session.auth != "smtp-user:smtp-pass" {
+		t.Errorf("auth = %q", session.auth)
+	}
+	if session.from != "<noreply@example.com>" {
+		t.Errorf("MAIL FROM = %q", session.from)
+	}
+	if len(session.rcpt) != 1 || session.rcpt[0] != "<alice@example.com>" {
+		t.Errorf("RCPT TO = %v", session.rcpt)
+	}
+
+	// ヘッダー
+	received, err := mail.ReadMessage(bytes.NewReader(session.data))
+	if err != nil {
+		t.Fatalf("failed to parse message: %v", err)
+	}
+	from, err := received.Header.AddressList("From")
+	if err != nil || len(from) != 1 || from[0].Address != "noreply@example.com" || from[0].Name != "Media Platform" {
+		t.Errorf("From = %v (%v)", from, err)
+	}
+	to, err := received.Header.AddressList("To")
+	if err != nil || len(to) != 1 || to[0].Address != "alice@example.com" {
+		t.Errorf("To = %v (%v)", to, err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(received.Header.Get("Subject"))
+	if err != nil || subject != message.Subject {
+		t.Errorf("Subject = %q (%v)", subject, err)
+	}
+	if received.Header.Get("Message-ID") == "" || received.Header.Get("Date") == "" {
+		t.Error("Message-ID and Date headers are required")
+	}
+
+	// 本文（multipart/alternative のテキストとHTML）
+	mediaType, params, err := mime.ParseMediaType(received.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q (%v)", mediaType, err)
+	}
+	bodies := map[string]string{}
+	reader := multipart.NewReader(received.Body, params["boundary"])
+	for {
+		part, err := reader.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("failed to read part: %v", err)
+		}
+		if part.Header.Get("Content-Transfer-Encoding") != "quoted-printable" {
+			t.Errorf("Content-Transfer-Encoding = %q", part.Header.Get("Content-Transfer-Encoding"))
+		}
+		decoded, err := io.ReadAll(quotedprintable.NewReader(part))
+		if err != nil {
+			t.Fatalf("failed to decode part: %v", err)
+		}
+		contentType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		bodies[contentType] = strings.ReplaceAll(string(decoded), "\r\n", "\n")
+	}
+
+	if got := bodies["text/plain"]; got != message.TextBody {
+		t.Errorf("text body = %q, want %q", got, message.TextBody)
+	}
+	if got := bodies["text/html"]; got != message.HTMLBody {
+		t.Errorf("html body = %q, want %q", got, message.HTMLBody)
+	}
+}
+
+func TestSMTPMailer_Send_WithoutAuth(t *testing.T) {
+	server := newFakeSMTPServer(t, false)
+	mailer := newTestSMTPMailer(t, server, "", "")
+
+	err := mailer.Send(context.Background(), &entity.EmailMessage{To: "bob@example.com", Subject: "s", TextBody: "t", HTMLBody: "h"})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if session := server.session(t); session.auth != "" {
+		t.Errorf("auth should be skipped without username: %q", session.auth)
+	}
+}
+
+func TestSMTPMailer_Send_Errors(t *testing.T) {
+	t.Run("受信者の拒否", func(t *testing.T) {
+		server := newFakeSMTPServer(t, true)
+		mailer := newTestSMTPMailer(t, server, "", "")
+
+		err := mailer.Send(context.Background(), &entity.EmailMessage{To: "nobody@example.com", Subject: "s", TextBody: "t", HTMLBody: "h"})
+		if err == nil || !strings.Contains(err.Error(), "RCPT TO") {
+			t.Fatalf("Send() error = %v, want RCPT TO error", err)
+		}
+	})
+
+	t.Run("不正な宛先", func(t *testing.T) {
+		server := newFakeSMTPServer(t, false)
+		mailer := newTestSMTPMailer(t, server, "", "")
+
+		if err := mailer.Send(context.Background(), &entity.EmailMessage{To: "not an address"}); err == nil {
+			t.Fatal("Send() expected error")
+		}
+	})
+
+	t.Run("接続できない", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("failed to listen: %v", err)
+		}
+		port := listener.Addr().(*net.TCPAddr).Port
+		listener.Close()
+
+		mailer, err := NewSMTPMailer(&Config{Host: "127.0.0.1", Port: port, From: "noreply@example.com", Timeout: time.Second})
+		if err != nil {
+			t.Fatalf("NewSMTPMailer() error = %v", err)
+		}
+		err = mailer.Send(context.Background(), &entity.EmailMessage{To: "bob@example.com", Subject: "s", TextBody: "t", HTMLBody: "h"})
+		if err == nil || !strings.Contains(err.Error(), "connect") {
+			t.Fatalf("Send() error = %v, want connection error on port %s", err, strconv.Itoa(port))
+		}
+	})
+}
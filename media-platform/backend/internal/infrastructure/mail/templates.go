@@ -0,0 +1,115 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"strings"
+	texttemplate "text/template"
+
+	"media-platform/internal/domain/entity"
+)
+
+// templates/{locale}/{name}.txt はテキスト本文で、{{define "subject"}}で件名を定義します
+// templates/{locale}/{name}.html はHTML本文で、layout.htmlの"layout"から{{template "content"}}として呼ばれます
+//
+//go:embed templates
+var templateFS embed.FS
+
+// textFuncs はテキストテンプレートで使う関数です（番号付きリスト用）
+var textFuncs = texttemplate.FuncMap{
+	"inc": func(i int) int { return i + 1 },
+}
+
+var emailTemplates = []entity.EmailTemplate{
+	entity.EmailTemplateFollow,
+	entity.EmailTemplateReply,
+	entity.EmailTemplateMention,
+	entity.EmailTemplateDigest,
+}
+
+// TemplateRenderer は言語ごとのテンプレートからメールの件名と本文を生成します
+type TemplateRenderer struct {
+	text map[string]*texttemplate.Template
+	html map[string]*htmltemplate.Template
+}
+
+// NewTemplateRenderer は埋め込まれたテンプレートを読み込みます
+func NewTemplateRenderer() (*TemplateRenderer, error) {
+	locales, err := fs.ReadDir(templateFS, "templates")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read templates: %w", err)
+	}
+
+	r := &TemplateRenderer{
+		text: map[string]*texttemplate.Template{},
+		html: map[string]*htmltemplate.Template{},
+	}
+	for _, locale := range locales {
+		if !locale.IsDir() {
+			continue
+		}
+		dir := "templates/" + locale.Name()
+		for _, name := range emailTemplates {
+			key := templateKey(locale.Name(), name)
+
+			text, err := texttemplate.New(string(name)+".txt").Funcs(textFuncs).
+				ParseFS(templateFS, fmt.Sprintf("%s/%s.txt", dir, name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse template %s.txt: %w", key, err)
+			}
+			if text.Lookup("subject") == nil {
+				return nil, fmt.Errorf("template %s.txt has no subject", key)
+			}
+
+			html, err := htmltemplate.ParseFS(templateFS, dir+"/layout.html", fmt.Sprintf("%s/%s.html", dir, name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse template %s.html: %w", key, err)
+			}
+
+			r.text[key] = text
+			r.html[key] = html
+		}
+	}
+
+	if _, ok := r.text[templateKey(entity.DefaultLocale, entity.EmailTemplateFollow)]; !ok {
+		return nil, fmt.Errorf("templates for default locale %q are missing", entity.DefaultLocale)
+	}
+	return r, nil
+}
+
+// Render はテンプレートから件名と本文を生成します（宛先は設定しません）
+// 対応していない言語の場合はデフォルトの言語を使います
+func (r *TemplateRenderer) Render(locale string, name entity.EmailTemplate, data interface{}) (*entity.EmailMessage, error) {
+	key := templateKey(locale, name)
+	if _, ok := r.text[key]; !ok {
+		key = templateKey(entity.DefaultLocale, name)
+	}
+	text, ok := r.text[key]
+	if !ok {
+		return nil, fmt.Errorf("unknown email template: %s", name)
+	}
+
+	var subject, textBody, htmlBody bytes.Buffer
+	if err := text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("failed to render subject %s: %w", key, err)
+	}
+	if err := text.Execute(&textBody, data); err != nil {
+		return nil, fmt.Errorf("failed to render text %s: %w", key, err)
+	}
+	if err := r.html[key].ExecuteTemplate(&htmlBody, "layout", data); err != nil {
+		return nil, fmt.Errorf("failed to render html %s: %w", key, err)
+	}
+
+	return &entity.EmailMessage{
+		Subject:  strings.TrimSpace(subject.String()),
+		TextBody: strings.TrimSpace(textBody.String()) + "\n",
+		HTMLBody: htmlBody.String(),
+	}, nil
+}
+
+func templateKey(locale string, name entity.EmailTemplate) string {
+	return locale + "/" + string(name)
+}
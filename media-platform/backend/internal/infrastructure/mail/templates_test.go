@@ -0,0 +1,182 @@
+package mail
+
+import (
+	"strings"
+	"testing"
+
+	"media-platform/internal/domain/entity"
+	"media-platform/internal/usecase/dto"
+)
+
+func newTestTemplateRenderer(t *testing.T) *TemplateRenderer {
+	t.Helper()
+	renderer, err := NewTemplateRenderer()
+	if err != nil {
+		t.Fatalf("NewTemplateRenderer() error = %v", err)
+	}
+	return renderer
+}
+
+func TestTemplateRenderer_Render(t *testing.T) {
+	renderer := newTestTemplateRenderer(t)
+
+	follow := dto.FollowEmailData{
+		Recipient:   "alice",
+		Follower:    "bob",
+		ProfileURL:  "https://example.com/users/alice",
+		SettingsURL: "https://example.com/settings/notifications",
+	}
+	reply := dto.ReplyEmailData{
+		Recipient:    "alice",
+		Replier:      "carol",
+		ContentTitle: "千と千尋の神隠し",
+		ReplyBody:    "同感です",
+		CommentURL:   "https://example.com/contents/1#comment-2",
+		SettingsURL:  "https://example.com/settings/notifications",
+	}
+	mention := dto.MentionEmailData{
+		Recipient:    "alice",
+		Mentioner:    "dave",
+		ContentTitle: "君の名は。",
+		Excerpt:      "@alice おすすめです",
+		URL:          "https://example.com/contents/3",
+		SettingsURL:  "https://example.com/settings/notifications",
+	}
+	digest := dto.DigestEmailData{
+		Recipient: "alice",
+		Items: []dto.DigestEmailItem{
+			{Title: "人気の投稿", Author: "bob", Excerpt: "抜粋", LikeCount: 12, CommentCount: 3, URL: "https://example.com/contents/10"},
+			{Title: "二番目の投稿", Author: "carol", Excerpt: "抜粋2", LikeCount: 5, CommentCount: 1, URL: "https://example.com/contents/11"},
+		},
+		FeedURL:     "https://example.com/feed",
+		SettingsURL: "https://example.com/settings/notifications",
+	}
+
+	tests := []struct {
+		name        string
+		locale      string
+		template    entity.EmailTemplate
+		data        interface{}
+		wantSubject string
+		wantText    []string
+		wantHTML    []string
+	}{
+		{
+			name: "ja/follow", locale: "ja", template: entity.EmailTemplateFollow, data: follow,
+			wantSubject: "bobさんにフォローされました",
+			wantText:    []string{"aliceさん", "bobさんがあなたをフォローしました", follow.ProfileURL, follow.SettingsURL},
+			wantHTML:    []string{`lang="ja"`, "aliceさん", follow.SettingsURL},
+		},
+		{
+			name: "en/follow", locale: "en", template: entity.EmailTemplateFollow, data: follow,
+			wantSubject: "bob started following you",
+			wantText:    []string{"Hi alice,", follow.ProfileURL, follow.SettingsURL},
+			wantHTML:    []string{`lang="en"`, follow.SettingsURL},
+		},
+		{
+			name: "ja/reply", locale: "ja", template: entity.EmailTemplateReply, data: reply,
+			wantSubject: "carolさんがあなたのコメントに返信しました",
+			wantText:    []string{"「千と千尋の神隠し」", "同感です", reply.CommentURL},
+			wantHTML:    []string{"千と千尋の神隠し", "同感です"},
+		},
+		{
+			name: "en/reply", locale: "en", template: entity.EmailTemplateReply, data: reply,
+			wantSubject: "carol replied to your comment",
+			wantText:    []string{"同感です", reply.CommentURL},
+			wantHTML:    []string{"同感です"},
+		},
+		{
+			name: "ja/mention", locale: "ja", template: entity.EmailTemplateMention, data: mention,
+			wantSubject: "daveさんがあなたをメンションしました",
+			wantText:    []string{"「君の名は。」", "@alice おすすめです", mention.URL},
+			wantHTML:    []string{"@alice おすすめです"},
+		},
+		{
+			name: "en/mention", locale: "en", template: entity.EmailTemplateMention, data: mention,
+			wantSubject: "dave mentioned you",
+			wantText:    []string{"@alice おすすめです", mention.URL},
+			wantHTML:    []string{"@alice おすすめです"},
+		},
+		{
+			name: "ja/digest", locale: "ja", template: entity.EmailTemplateDigest, data: digest,
+			wantSubject: "今週のフォロー中の人気投稿",
+			wantText:    []string{"1. 人気の投稿（bobさん）", "2. 二番目の投稿（carolさん）", "いいね 12・コメント 3", digest.FeedURL},
+			wantHTML:    []string{"人気の投稿", "二番目の投稿", digest.FeedURL},
+		},
+		{
+			name: "en/digest", locale: "en", template: entity.EmailTemplateDigest, data: digest,
+			wantSubject: "Top posts from people you follow this week",
+			wantText:    []string{"1. 人気の投稿 (by bob)", "12 likes · 3 comments", digest.FeedURL},
+			wantHTML:    []string{"人気の投稿", digest.FeedURL},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, err := renderer.Render(tt.locale, tt.template, tt.data)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if !strings.HasPrefix(message.Subject, tt.wantSubject) {
+				t.Errorf("subject = %q, want prefix %q", message.Subject, tt.wantSubject)
+			}
+			for _, want := range tt.wantText {
+				if !strings.Contains(message.TextBody, want) {
+					t.Errorf("text body does not contain %q:\n%s", want, message.TextBody)
+				}
+			}
+			for _, want := range tt.wantHTML {
+				if !strings.Contains(message.HTMLBody, want) {
+					t.Errorf("html body does not contain %q:\n%s", want, message.HTMLBody)
+				}
+			}
+			if strings.Contains(message.TextBody, "<no value>") || strings.Contains(message.HTMLBody, "<no value>") {
+				t.Error("template refers to a field that is not in the data")
+			}
+		})
+	}
+}
+
+func TestTemplateRenderer_FallsBackToDefaultLocale(t *testing.T) {
+	renderer := newTestTemplateRenderer(t)
+	data := dto.FollowEmailData{Recipient: "alice", Follower: "bob"}
+
+	fallback, err := renderer.Render("fr", entity.EmailTemplateFollow, data)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	ja, err := renderer.Render(entity.DefaultLocale, entity.EmailTemplateFollow, data)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if fallback.Subject != ja.Subject || fallback.TextBody != ja.TextBody {
+		t.Fatalf("unsupported locale should fall back to %s: %q", entity.DefaultLocale, fallback.Subject)
+	}
+}
+
+func TestTemplateRenderer_EscapesHTML(t *testing.T) {
+	renderer := newTestTemplateRenderer(t)
+
+	message, err := renderer.Render("ja", entity.EmailTemplateReply, dto.ReplyEmailData{
+		Recipient:    "alice",
+		Replier:      "mallory",
+		ContentTitle: "タイトル",
+		ReplyBody:    `<script>alert("x")</script>`,
+	})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if strings.Contains(message.HTMLBody, "<script>") {
+		t.Fatalf("html body is not escaped:\n%s", message.HTMLBody)
+	}
+	if !strings.Contains(message.TextBody, `<script>alert("x")</script>`) {
+		t.Fatalf("text body should keep the original text:\n%s", message.TextBody)
+	}
+}
+
+func TestTemplateRenderer_UnknownTemplate(t *testing.T) {
+	renderer := newTestTemplateRenderer(t)
+	if _, err := renderer.Render("ja", entity.EmailTemplate("unknown"), nil); err == nil {
+		t.Fatal("expected error for unknown template")
+	}
+}
@@ -0,0 +1,54 @@
+package metadata
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config は外部メタデータプロバイダーの設定を保持します
+type Config struct {
+	Provider     string        // "http" または "fake"
+	BaseURL      string        // HTTPプロバイダーのAPIベースURL
+	APIKey       string        // Bearerトークンとして送信するAPIキー
+	ImageBaseURL string        // 相対パスのカバー画像（poster_pathなど）に付与するURL
+	Timeout      time.Duration // リクエストタイムアウト
+	MaxResults   int           // 1回の検索で返す最大件数
+}
+
+// LoadConfigFromEnv は環境変数から設定を読み込みます
+// METADATA_API_BASE_URL が未設定の場合はフィクスチャを使うフェイクになります
+func LoadConfigFromEnv() *Config {
+	timeout, err := time.ParseDuration(getEnv("METADATA_API_TIMEOUT", "5s"))
+	if err != nil {
+		timeout = 5 * time.Second
+	}
+	maxResults, err := strconv.Atoi(getEnv("METADATA_MAX_RESULTS", "10"))
+	if err != nil || maxResults <= 0 {
+		maxResults = 10
+	}
+
+	baseURL := getEnv("METADATA_API_BASE_URL", "")
+	defaultProvider := "fake"
+	if baseURL != "" {
+		defaultProvider = "http"
+	}
+
+	return &Config{
+		Provider:     getEnv("METADATA_PROVIDER", defaultProvider),
+		BaseURL:      baseURL,
+		APIKey:       getEnv("METADATA_API_KEY", ""),
+		ImageBaseURL: getEnv("METADATA_IMAGE_BASE_URL", ""),
+		Timeout:      timeout,
+		MaxResults:   maxResults,
+	}
+}
+
+// getEnv は環境変数の値を取得し、設定されていない場合はデフォルト値を返します
+func getEnv(key, defaultValue string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,72 @@
+package metadata
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"media-platform/internal/domain/entity"
+)
+
+//go:embed fixtures/works.json
+var defaultFixtures []byte
+
+// FixtureProvider はフィクスチャデータから作品を検索するフェイクプロバイダーです
+// 開発環境やテストで外部APIを呼び出さずにルックアップを動作させるために使用します
+type FixtureProvider struct {
+	works []*entity.WorkMetadata
+}
+
+// NewFixtureProvider は組み込みのフィクスチャを読み込んだFixtureProviderを作成します
+func NewFixtureProvider() (*FixtureProvider, error) {
+	return NewFixtureProviderFromJSON(defaultFixtures)
+}
+
+// NewFixtureProviderFromJSON は任意のJSONフィクスチャからFixtureProviderを作成します
+func NewFixtureProviderFromJSON(data []byte) (*FixtureProvider, error) {
+	var works []*entity.WorkMetadata
+	if err := json.Unmarshal(data, &works); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata fixtures: %w", err)
+	}
+	return &FixtureProvider{works: works}, nil
+}
+
+// Name はプロバイダー名を返します
+func (p *FixtureProvider) Name() string {
+	return "fixture"
+}
+
+// SearchByTitle はタイトル・作者名の部分一致で作品を検索します
+func (p *FixtureProvider) SearchByTitle(ctx context.Context, workType entity.ContentType, title string, limit int) ([]*entity.WorkMetadata, error) {
+	keyword := strings.ToLower(strings.TrimSpace(title))
+	results := make([]*entity.WorkMetadata, 0)
+	for _, work := range p.works {
+		if workType != "" && work.Type != workType {
+			continue
+		}
+		if !strings.Contains(strings.ToLower(work.Title), keyword) &&
+			!strings.Contains(strings.ToLower(work.Creator), keyword) {
+			continue
+		}
+		results = append(results, work)
+		if limit > 0 && len(results) >= limit {
+			break
+		}
+	}
+	return results, nil
+}
+
+// LookupByID は外部ID（isbn, jan など）またはフィクスチャIDで作品を取得します
+func (p *FixtureProvider) LookupByID(ctx context.Context, idType, id string) (*entity.WorkMetadata, error) {
+	for _, work := range p.works {
+		if idType == p.Name() && work.SourceID == id {
+			return work, nil
+		}
+		if value, ok := work.ExternalIDs[idType]; ok && value == id {
+			return work, nil
+		}
+	}
+	return nil, nil
+}
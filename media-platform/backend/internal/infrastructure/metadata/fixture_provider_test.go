@@ -0,0 +1,129 @@
+package metadata
+
+import (
+	"context"
+	"testing"
+
+	"media-platform/internal/domain/entity"
+)
+
+const testFixtures = `[
+  {"source": "fixture", "source_id": "movie-001", "type": "映画", "title": "千と千尋の神隠し", "creator": "宮崎駿", "release_year": 2001, "external_ids": {"imdb": "tt0245429"}},
+  {"source": "fixture", "source_id": "movie-002", "type": "映画", "title": "Spirited Away Remix", "creator": "Example Director"},
+  {"source": "fixture", "source_id": "manga-001", "type": "漫画", "title": "風の谷のナウシカ", "creator": "宮崎駿", "external_ids": {"isbn": "9784197700008"}},
+  {"source": "fixture", "source_id": "music-001", "type": "音楽", "title": "Spirited Away Soundtrack", "creator": "久石譲", "external_ids": {"jan": "4988008123456"}}
+]`
+
+func newTestFixtureProvider(t *testing.T) *FixtureProvider {
+	t.Helper()
+	provider, err := NewFixtureProviderFromJSON([]byte(testFixtures))
+	if err != nil {
+		t.Fatalf("NewFixtureProviderFromJSON() error = %v", err)
+	}
+	return provider
+}
+
+func sourceIDs(works []*entity.WorkMetadata) []string {
+	ids := make([]string, len(works))
+	for i, work := range works {
+		ids[i] = work.SourceID
+	}
+	return ids
+}
+
+func TestNewFixtureProvider_LoadsEmbeddedFixtures(t *testing.T) {
+	provider, err := NewFixtureProvider()
+	if err != nil {
+		t.Fatalf("NewFixtureProvider() error = %v", err)
+	}
+	if len(provider.works) == 0 {
+		t.Fatal("embedded fixtures are empty")
+	}
+	for _, work := range provider.works {
+		if work.SourceID == "" || work.Title == "" || work.Type == "" {
+			t.Errorf("fixture has missing fields: %+v", work)
+		}
+	}
+}
+
+func TestNewFixtureProviderFromJSON_InvalidJSON(t *testing.T) {
+	if _, err := NewFixtureProviderFromJSON([]byte(`{"not": "an array"}`)); err == nil {
+		t.Fatal("expected error for invalid fixtures")
+	}
+}
+
+func TestFixtureProvider_SearchByTitle(t *testing.T) {
+	provider := newTestFixtureProvider(t)
+
+	tests := []struct {
+		name     string
+		workType entity.ContentType
+		title    string
+		limit    int
+		want     []string
+	}{
+		{name: "タイトルの部分一致", title: "神隠し", want: []string{"movie-001"}},
+		{name: "大文字小文字を区別しない", title: "  spirited AWAY ", want: []string{"movie-002", "music-001"}},
+		{name: "作者名で一致", title: "宮崎", want: []string{"movie-001", "manga-001"}},
+		{name: "種類で絞り込み", workType: entity.ContentTypeManga, title: "宮崎", want: []string{"manga-001"}},
+		{name: "件数の上限", title: "spirited", limit: 1, want: []string{"movie-002"}},
+		{name: "一致なし", title: "存在しない作品", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := provider.SearchByTitle(context.Background(), tt.workType, tt.title, tt.limit)
+			if err != nil {
+				t.Fatalf("SearchByTitle() error = %v", err)
+			}
+			if got == nil {
+				t.Fatal("SearchByTitle() returned nil slice")
+			}
+			ids := sourceIDs(got)
+			if len(ids) != len(tt.want) {
+				t.Fatalf("SearchByTitle() = %v, want %v", ids, tt.want)
+			}
+			for i := range ids {
+				if ids[i] != tt.want[i] {
+					t.Fatalf("SearchByTitle() = %v, want %v", ids, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestFixtureProvider_LookupByID(t *testing.T) {
+	provider := newTestFixtureProvider(t)
+
+	tests := []struct {
+		name   string
+		idType string
+		id     string
+		want   string // 空の場合は見つからないこと
+	}{
+		{name: "フィクスチャID", idType: "fixture", id: "movie-002", want: "movie-002"},
+		{name: "ISBN", idType: "isbn", id: "9784197700008", want: "manga-001"},
+		{name: "JANコード", idType: "jan", id: "4988008123456", want: "music-001"},
+		{name: "IDの種類が違う", idType: "jan", id: "9784197700008"},
+		{name: "存在しないID", idType: "isbn", id: "9780000000000"},
+		{name: "外部IDにフィクスチャIDは使わない", idType: "isbn", id: "manga-001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := provider.LookupByID(context.Background(), tt.idType, tt.id)
+			if err != nil {
+				t.Fatalf("LookupByID() error = %v", err)
+			}
+			if tt.want == "" {
+				if got != nil {
+					t.Fatalf("LookupByID() = %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil || got.SourceID != tt.want {
+				t.Fatalf("LookupByID() = %+v, want %s", got, tt.want)
+			}
+		})
+	}
+}
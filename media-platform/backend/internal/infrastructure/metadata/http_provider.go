@@ -0,0 +1,224 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"media-platform/internal/domain/entity"
+)
+
+// maxResponseBytes は外部APIレスポンスの読み込み上限です
+const maxResponseBytes = 2 << 20
+
+// HTTPProvider はTMDB/MusicBrainz風のJSON APIから作品メタデータを取得するアダプターです
+//
+// 想定するエンドポイント:
+//
+//	GET {base}/search?query=...&type=...   → {"results": [ ... ]}
+//	GET {base}/lookup?id_type=isbn&id=...  → { ... } （見つからない場合は404）
+//
+// 各作品オブジェクトは title/name、release_date/first_release_date/first_air_date、
+// creator/artist/director、cover_url/poster_path などのフィールド名の揺れを吸収します
+type HTTPProvider struct {
+	config *Config
+	client *http.Client
+}
+
+// NewHTTPProvider は新しいHTTPProviderを作成します
+func NewHTTPProvider(config *Config) (*HTTPProvider, error) {
+	if config.BaseURL == "" {
+		return nil, fmt.Errorf("metadata api base url is required")
+	}
+	if _, err := url.Parse(config.BaseURL); err != nil {
+		return nil, fmt.Errorf("invalid metadata api base url: %w", err)
+	}
+
+	return &HTTPProvider{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+// Name はプロバイダー名を返します（キャッシュのキーや外部IDのキーに使用）
+func (p *HTTPProvider) Name() string {
+	return "external"
+}
+
+// SearchByTitle はタイトルで作品を検索します
+func (p *HTTPProvider) SearchByTitle(ctx context.Context, workType entity.ContentType, title string, limit int) ([]*entity.WorkMetadata, error) {
+	params := url.Values{}
+	params.Set("query", title)
+	if workType != "" {
+		params.Set("type", string(workType))
+	}
+
+	var body struct {
+		Results []remoteWork `json:"results"`
+	}
+	found, err := p.getJSON(ctx, "/search", params, &body)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return []*entity.WorkMetadata{}, nil
+	}
+
+	if limit <= 0 || limit > p.config.MaxResults {
+		limit = p.config.MaxResults
+	}
+
+	results := make([]*entity.WorkMetadata, 0, len(body.Results))
+	for _, remote := range body.Results {
+		metadata := remote.toWorkMetadata(p.Name(), workType, p.config.ImageBaseURL)
+		if metadata.Title == "" {
+			continue
+		}
+		results = append(results, metadata)
+		if len(results) >= limit {
+			break
+		}
+	}
+	return results, nil
+}
+
+// LookupByID は外部IDで作品を取得します（見つからない場合はnil）
+func (p *HTTPProvider) LookupByID(ctx context.Context, idType, id string) (*entity.WorkMetadata, error) {
+	params := url.Values{}
+	params.Set("id_type", idType)
+	params.Set("id", id)
+
+	var remote remoteWork
+	found, err := p.getJSON(ctx, "/lookup", params, &remote)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+
+	metadata := remote.toWorkMetadata(p.Name(), "", p.config.ImageBaseURL)
+	if metadata.Title == "" {
+		return nil, nil
+	}
+	if metadata.ExternalIDs == nil {
+		metadata.ExternalIDs = map[string]string{}
+	}
+	if _, ok := metadata.ExternalIDs[idType]; !ok {
+		metadata.ExternalIDs[idType] = id
+	}
+	return metadata, nil
+}
+
+// getJSON はGETリクエストを送信し、レスポンスをデコードします（404の場合はfound=false）
+func (p *HTTPProvider) getJSON(ctx context.Context, path string, params url.Values, out interface{}) (bool, error) {
+	endpoint := strings.TrimRight(p.config.BaseURL, "/") + path + "?" + params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to build metadata request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if p.config.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.config.APIKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("metadata request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return false, fmt.Errorf("metadata api returned status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return false, fmt.Errorf("failed to read metadata response: %w", err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return false, fmt.Errorf("failed to decode metadata response: %w", err)
+	}
+
+	return true, nil
+}
+
+// remoteWork は外部APIの作品オブジェクトです（API間のフィールド名の揺れを吸収）
+type remoteWork struct {
+	ID               json.RawMessage   `json:"id"`
+	Type             string            `json:"type"`
+	Title            string            `json:"title"`
+	Name             string            `json:"name"`
+	Creator          string            `json:"creator"`
+	Artist           string            `json:"artist"`
+	Director         string            `json:"director"`
+	ReleaseYear      int               `json:"release_year"`
+	ReleaseDate      string            `json:"release_date"`
+	FirstReleaseDate string            `json:"first_release_date"`
+	FirstAirDate     string            `json:"first_air_date"`
+	CoverURL         string            `json:"cover_url"`
+	PosterPath       string            `json:"poster_path"`
+	ExternalIDs      map[string]string `json:"external_ids"`
+}
+
+// toWorkMetadata は外部APIの作品オブジェクトをWorkMetadataに変換します
+func (r *remoteWork) toWorkMetadata(source string, fallbackType entity.ContentType, imageBaseURL string) *entity.WorkMetadata {
+	metadata := &entity.WorkMetadata{
+		Source:      source,
+		SourceID:    strings.Trim(string(r.ID), `"`),
+		Type:        entity.ContentType(r.Type),
+		Title:       firstNonEmpty(r.Title, r.Name),
+		Creator:     firstNonEmpty(r.Creator, r.Artist, r.Director),
+		ExternalIDs: r.ExternalIDs,
+	}
+	if metadata.Type == "" {
+		metadata.Type = fallbackType
+	}
+
+	if r.ReleaseYear > 0 {
+		year := r.ReleaseYear
+		metadata.ReleaseYear = &year
+	} else if year, ok := parseYear(firstNonEmpty(r.ReleaseDate, r.FirstReleaseDate, r.FirstAirDate)); ok {
+		metadata.ReleaseYear = &year
+	}
+
+	cover := firstNonEmpty(r.CoverURL, r.PosterPath)
+	if cover != "" && !strings.HasPrefix(cover, "http://") && !strings.HasPrefix(cover, "https://") {
+		cover = strings.TrimRight(imageBaseURL, "/") + "/" + strings.TrimLeft(cover, "/")
+	}
+	metadata.CoverURL = cover
+
+	return metadata
+}
+
+// parseYear は "2001-07-20" や "2001" 形式の日付から年を取り出します
+func parseYear(date string) (int, bool) {
+	if len(date) < 4 {
+		return 0, false
+	}
+	year, err := strconv.Atoi(date[:4])
+	if err != nil || year < 1800 || year > time.Now().Year()+10 {
+		return 0, false
+	}
+	return year, true
+}
+
+// firstNonEmpty は最初の空でない文字列を返します
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
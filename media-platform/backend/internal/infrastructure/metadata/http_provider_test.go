@@ -0,0 +1,239 @@
+package metadata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"media-platform/internal/domain/entity"
+)
+
+// newTestHTTPProvider はhandlerに応答させるテスト用のHTTPProviderを作成します
+func newTestHTTPProvider(t *testing.T, maxResults int, handler http.HandlerFunc) *HTTPProvider {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	provider, err := NewHTTPProvider(&Config{
+		Provider:     "http",
+		BaseURL:      server.URL + "/api/",
+		APIKey:       "test-key",
+		ImageBaseURL: "https://images.example.com/w500/",
+		Timeout:      5 * time.Second,
+		MaxResults:   maxResults,
+	})
+	if err != nil {
+		t.Fatalf("NewHTTPProvider() error = %v", err)
+	}
+	return provider
+}
+
+func TestNewHTTPProvider_RequiresBaseURL(t *testing.T) {
+	if _, err := NewHTTPProvider(&Config{}); err == nil {
+		t.Fatal("expected error for empty base url")
+	}
+}
+
+func TestHTTPProvider_SearchByTitle(t *testing.T) {
+	provider := newTestHTTPProvider(t, 10, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/search" {
+			t.Errorf("path = %q, want /api/search", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("query"); got != "千と千尋" {
+			t.Errorf("query = %q", got)
+		}
+		if got := r.URL.Query().Get("type"); got != string(entity.ContentTypeMovie) {
+			t.Errorf("type = %q", got)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-key" {
+			t.Errorf("Authorization = %q", got)
+		}
+		if got := r.Header.Get("Accept"); got != "application/json" {
+			t.Errorf("Accept = %q", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": [
+			{"id": 129, "title": "千と千尋の神隠し", "director": "宮崎駿", "release_date": "2001-07-20", "poster_path": "/poster.jpg"},
+			{"id": "abc", "name": "Spirited Away", "artist": "久石譲", "first_release_date": "2001", "cover_url": "https://cdn.example.com/cover.jpg", "external_ids": {"jan": "4988008123456"}},
+			{"id": 3, "release_year": 2001}
+		]}`))
+	})
+
+	got, err := provider.SearchByTitle(context.Background(), entity.ContentTypeMovie, "千と千尋", 0)
+	if err != nil {
+		t.Fatalf("SearchByTitle() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("len(results) = %d, want 2 (entries without title are skipped)", len(got))
+	}
+
+	first := got[0]
+	if first.Source != "external" || first.SourceID != "129" {
+		t.Errorf("source = %q/%q", first.Source, first.SourceID)
+	}
+	if first.Type != entity.ContentTypeMovie {
+		t.Errorf("type = %q, want fallback to requested type", first.Type)
+	}
+	if first.Title != "千と千尋の神隠し" || first.Creator != "宮崎駿" {
+		t.Errorf("title/creator = %q/%q", first.Title, first.Creator)
+	}
+	if first.ReleaseYear == nil || *first.ReleaseYear != 2001 {
+		t.Errorf("release year = %v, want 2001", first.ReleaseYear)
+	}
+	if first.CoverURL != "https://images.example.com/w500/poster.jpg" {
+		t.Errorf("cover url = %q", first.CoverURL)
+	}
+
+	second := got[1]
+	if second.SourceID != "abc" || second.Title != "Spirited Away" || second.Creator != "久石譲" {
+		t.Errorf("second = %+v", second)
+	}
+	if second.CoverURL != "https://cdn.example.com/cover.jpg" {
+		t.Errorf("absolute cover url should be kept: %q", second.CoverURL)
+	}
+	if second.ExternalIDs["jan"] != "4988008123456" {
+		t.Errorf("external ids = %v", second.ExternalIDs)
+	}
+}
+
+func TestHTTPProvider_SearchByTitle_Limit(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"results": [{"title": "A"}, {"title": "B"}, {"title": "C"}, {"title": "D"}]}`))
+	}
+
+	tests := []struct {
+		name       string
+		maxResults int
+		limit      int
+		want       int
+	}{
+		{name: "指定した件数", maxResults: 10, limit: 2, want: 2},
+		{name: "未指定の場合は設定の上限", maxResults: 3, limit: 0, want: 3},
+		{name: "設定の上限を超えない", maxResults: 1, limit: 5, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newTestHTTPProvider(t, tt.maxResults, handler)
+			got, err := provider.SearchByTitle(context.Background(), "", "x", tt.limit)
+			if err != nil {
+				t.Fatalf("SearchByTitle() error = %v", err)
+			}
+			if len(got) != tt.want {
+				t.Fatalf("len(results) = %d, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTPProvider_SearchByTitle_NotFound(t *testing.T) {
+	provider := newTestHTTPProvider(t, 10, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("type") {
+			t.Error("type parameter should be omitted for empty work type")
+		}
+		http.NotFound(w, r)
+	})
+
+	got, err := provider.SearchByTitle(context.Background(), "", "none", 0)
+	if err != nil {
+		t.Fatalf("SearchByTitle() error = %v", err)
+	}
+	if got == nil || len(got) != 0 {
+		t.Fatalf("SearchByTitle() = %v, want empty slice", got)
+	}
+}
+
+func TestHTTPProvider_LookupByID(t *testing.T) {
+	provider := newTestHTTPProvider(t, 10, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/lookup" {
+			t.Errorf("path = %q, want /api/lookup", r.URL.Path)
+		}
+		if r.URL.Query().Get("id_type") != "isbn" {
+			t.Errorf("id_type = %q", r.URL.Query().Get("id_type"))
+		}
+		if r.URL.Query().Get("id") != "9784088725093" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"id": "b-1", "type": "漫画", "title": "ONE PIECE 1", "creator": "尾田栄一郎", "release_year": 1997}`))
+	})
+
+	got, err := provider.LookupByID(context.Background(), "isbn", "9784088725093")
+	if err != nil {
+		t.Fatalf("LookupByID() error = %v", err)
+	}
+	if got == nil {
+		t.Fatal("LookupByID() = nil")
+	}
+	if got.Type != entity.ContentTypeManga || got.Title != "ONE PIECE 1" {
+		t.Errorf("got = %+v", got)
+	}
+	if got.ExternalIDs["isbn"] != "9784088725093" {
+		t.Errorf("looked up id should be recorded in external ids: %v", got.ExternalIDs)
+	}
+
+	missing, err := provider.LookupByID(context.Background(), "isbn", "9780000000000")
+	if err != nil {
+		t.Fatalf("LookupByID() error = %v", err)
+	}
+	if missing != nil {
+		t.Fatalf("LookupByID() = %+v, want nil for 404", missing)
+	}
+}
+
+func TestHTTPProvider_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{name: "サーバーエラー", handler: func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}},
+		{name: "認証エラー", handler: func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}},
+		{name: "不正なJSON", handler: func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"results": [`))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newTestHTTPProvider(t, 10, tt.handler)
+			if _, err := provider.SearchByTitle(context.Background(), "", "x", 0); err == nil {
+				t.Error("SearchByTitle() expected error")
+			}
+			if _, err := provider.LookupByID(context.Background(), "isbn", "9784088725093"); err == nil {
+				t.Error("LookupByID() expected error")
+			}
+		})
+	}
+}
+
+func TestParseYear(t *testing.T) {
+	tests := []struct {
+		date   string
+		want   int
+		wantOK bool
+	}{
+		{date: "2001-07-20", want: 2001, wantOK: true},
+		{date: "1999", want: 1999, wantOK: true},
+		{date: "", wantOK: false},
+		{date: "99", wantOK: false},
+		{date: "abcd-01-01", wantOK: false},
+		{date: "1700-01-01", wantOK: false},
+		{date: "9999-01-01", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			got, ok := parseYear(tt.date)
+			if ok != tt.wantOK || (ok && got != tt.want) {
+				t.Fatalf("parseYear(%q) = %d, %v; want %d, %v", tt.date, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package realtime
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config はリアルタイム配信の設定を保持します
+type Config struct {
+	BufferSize        int           // 再接続時の再送のために保持する直近のイベント数
+	SubscriberBuffer  int           // 購読者ごとの送信待ちイベント数（超えた購読者は切断し、再接続で再送させる）
+	HeartbeatInterval time.Duration // 接続維持のためのハートビートの間隔
+}
+
+// LoadConfigFromEnv は環境変数から設定を読み込みます
+func LoadConfigFromEnv() *Config {
+	bufferSize, err := strconv.Atoi(getEnv("STREAM_BUFFER_SIZE", "1000"))
+	if err != nil || bufferSize <= 0 {
+		bufferSize = 1000
+	}
+	subscriberBuffer, err := strconv.Atoi(getEnv("STREAM_SUBSCRIBER_BUFFER", "64"))
+	if err != nil || subscriberBuffer <= 0 {
+		subscriberBuffer = 64
+	}
+	heartbeat, err := time.ParseDuration(getEnv("STREAM_HEARTBEAT_INTERVAL", "25s"))
+	if err != nil || heartbeat <= 0 {
+		heartbeat = 25 * time.Second
+	}
+
+	return &Config{
+		BufferSize:        bufferSize,
+		SubscriberBuffer:  subscriberBuffer,
+		HeartbeatInterval: heartbeat,
+	}
+}
+
+// getEnv は環境変数の値を取得し、設定されていない場合はデフォルト値を返します
+func getEnv(key, defaultValue string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
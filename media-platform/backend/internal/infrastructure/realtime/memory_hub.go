@@ -0,0 +1,113 @@
+package realtime
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"media-platform/internal/domain/entity"
+)
+
+// MemoryHub はプロセス内でイベントを配信するpub/subハブです
+// 単一インスタンス向けの実装で、複数インスタンスに配信する場合は同じインターフェースで
+// PostgreSQLのLISTEN/NOTIFYなどを使う実装に差し替えます
+type MemoryHub struct {
+	mu               sync.Mutex
+	lastID           int64
+	recent           []*entity.StreamEvent // 直近のイベント（古い順）
+	bufferSize       int
+	subscriberBuffer int
+	subscribers      map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	topics map[string]struct{}
+	ch     chan *entity.StreamEvent
+}
+
+// NewMemoryHub はMemoryHubを作成します
+func NewMemoryHub(config *Config) *MemoryHub {
+	return &MemoryHub{
+		// 再起動後も以前のLast-Event-IDより大きいIDになるよう現在時刻から採番する
+		lastID:           time.Now().UnixMicro(),
+		bufferSize:       config.BufferSize,
+		subscriberBuffer: config.SubscriberBuffer,
+		subscribers:      make(map[*subscriber]struct{}),
+	}
+}
+
+// Publish はイベントにIDを割り当てて、トピックを購読しているクライアントに配信します
+// 受信が追いつかない購読者はブロックせずに切断します（再接続時にLast-Event-IDから再送されます）
+func (h *MemoryHub) Publish(ctx context.Context, event *entity.StreamEvent) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.lastID++
+	event.ID = h.lastID
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	if !event.Ephemeral {
+		h.recent = append(h.recent, event)
+		if len(h.recent) > h.bufferSize {
+			h.recent = h.recent[len(h.recent)-h.bufferSize:]
+		}
+	}
+
+	for sub := range h.subscribers {
+		if _, ok := sub.topics[event.Topic]; !ok {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			h.removeLocked(sub)
+		}
+	}
+	return nil
+}
+
+// Subscribe は指定したトピックのイベントを受け取るチャネルを返します
+// lastEventIDが0より大きい場合は、保持している直近のイベントのうちそれより後のものを先に配信します
+// チャネルはctxが終了するか、受信が追いつかずに切断された場合に閉じられます
+func (h *MemoryHub) Subscribe(ctx context.Context, topics []string, lastEventID int64) (<-chan *entity.StreamEvent, error) {
+	sub := &subscriber{topics: make(map[string]struct{}, len(topics))}
+	for _, topic := range topics {
+		sub.topics[topic] = struct{}{}
+	}
+
+	h.mu.Lock()
+	var replay []*entity.StreamEvent
+	if lastEventID > 0 {
+		for _, event := range h.recent {
+			if _, ok := sub.topics[event.Topic]; ok && event.ID > lastEventID {
+				replay = append(replay, event)
+			}
+		}
+	}
+	sub.ch = make(chan *entity.StreamEvent, h.subscriberBuffer+len(replay))
+	for _, event := range replay {
+		sub.ch <- event
+	}
+	h.subscribers[sub] = struct{}{}
+	h.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		h.mu.Lock()
+		h.removeLocked(sub)
+		h.mu.Unlock()
+	}()
+
+	return sub.ch, nil
+}
+
+// removeLocked は購読者を削除してチャネルを閉じます（h.muを保持して呼び出します）
+func (h *MemoryHub) removeLocked(sub *subscriber) {
+	if _, ok := h.subscribers[sub]; !ok {
+		return
+	}
+	delete(h.subscribers, sub)
+	close(sub.ch)
+}
@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Config はローカルファイルストレージの設定を保持します
+type Config struct {
+	Dir     string // 保存先ディレクトリ
+	URLPath string // 配信時のURLパス（例: /uploads）
+}
+
+// LoadConfigFromEnv は環境変数から設定を読み込みます
+func LoadConfigFromEnv() *Config {
+	return &Config{
+		Dir:     getEnv("UPLOAD_DIR", "./uploads"),
+		URLPath: strings.TrimRight(getEnv("UPLOAD_URL_PATH", "/uploads"), "/"),
+	}
+}
+
+// LocalStorage はローカルディスクにファイルを保存します
+type LocalStorage struct {
+	config *Config
+}
+
+// NewLocalStorage は新しいLocalStorageを作成します
+func NewLocalStorage(config *Config) (*LocalStorage, error) {
+	if err := os.MkdirAll(config.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("アップロードディレクトリの作成に失敗しました: %w", err)
+	}
+	return &LocalStorage{config: config}, nil
+}
+
+// Save はファイルを保存し、配信用のURLを返します
+func (s *LocalStorage) Save(ctx context.Context, key string, data []byte) (string, error) {
+	path, err := s.resolvePath(key)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	// 書き込み途中のファイルが配信されないよう一時ファイル経由で保存
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to close file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return "", fmt.Errorf("failed to chmod file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to move file: %w", err)
+	}
+
+	return s.config.URLPath + "/" + key, nil
+}
+
+// Delete はURLに対応するファイルを削除します
+// このストレージが管理していないURLや存在しないファイルは無視します
+func (s *LocalStorage) Delete(ctx context.Context, url string) error {
+	prefix := s.config.URLPath + "/"
+	if !strings.HasPrefix(url, prefix) {
+		return nil
+	}
+
+	path, err := s.resolvePath(strings.TrimPrefix(url, prefix))
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	return nil
+}
+
+// resolvePath はキーを保存先ディレクトリ配下の絶対パスに変換します
+func (s *LocalStorage) resolvePath(key string) (string, error) {
+	cleaned := filepath.Clean(filepath.FromSlash(key))
+	if cleaned == "." || filepath.IsAbs(cleaned) || strings.HasPrefix(cleaned, "..") {
+		return "", fmt.Errorf("invalid storage key: %q", key)
+	}
+	return filepath.Join(s.config.Dir, cleaned), nil
+}
+
+// getEnv は環境変数の値を取得し、設定されていない場合はデフォルト値を返します
+func getEnv(key, defaultValue string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"os"
+	"time"
+)
+
+// Config はWebhookの配信の設定を保持します
+type Config struct {
+	Timeout              time.Duration // 1回の配信リクエストのタイムアウト
+	AllowPrivateNetworks bool          // ループバック・プライベートアドレスへの配信を許可する（開発用）
+	UserAgent            string
+}
+
+// LoadConfigFromEnv は環境変数から設定を読み込みます
+func LoadConfigFromEnv() *Config {
+	timeout, err := time.ParseDuration(getEnv("WEBHOOK_TIMEOUT", "10s"))
+	if err != nil || timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	return &Config{
+		Timeout:              timeout,
+		AllowPrivateNetworks: getEnv("WEBHOOK_ALLOW_PRIVATE_NETWORKS", "false") == "true",
+		UserAgent:            getEnv("WEBHOOK_USER_AGENT", "MediaPlatform-Webhook/1.0"),
+	}
+}
+
+// getEnv は環境変数の値を取得し、設定されていない場合はデフォルト値を返します
+func getEnv(key, defaultValue string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
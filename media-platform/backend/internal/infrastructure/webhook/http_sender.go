@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+
+	"media-platform/internal/domain/entity"
+)
+
+// errPrivateAddress はプライベートアドレスへの配信を拒否したときのエラーです
+var errPrivateAddress = errors.New("private network address is not allowed")
+
+// HTTPSender はWebhookのペイロードをHTTP POSTで送信します
+// リダイレクトには従わず、接続先がループバック・プライベートアドレスの場合は拒否します（設定で許可可能）
+type HTTPSender struct {
+	client    *http.Client
+	userAgent string
+}
+
+// NewHTTPSender は新しいHTTPSenderを作成します
+func NewHTTPSender(config *Config) *HTTPSender {
+	dialer := &net.Dialer{Timeout: config.Timeout}
+	if !config.AllowPrivateNetworks {
+		// DNSの解決後の実際の接続先で判定する（DNSリバインディング対策）
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isPrivateIP(ip) {
+				return errPrivateAddress
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &HTTPSender{
+		client: &http.Client{
+			Timeout:   config.Timeout,
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		userAgent: config.UserAgent,
+	}
+}
+
+// Send はペイロードを送信し、応答のステータスと本文の先頭を返します
+// 応答を受け取れなかった場合のみエラーを返します（2xx以外の判定は呼び出し側で行います）
+func (s *HTTPSender) Send(ctx context.Context, url string, headers map[string]string, body []byte) (*entity.WebhookEndpointResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", s.userAgent)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, entity.WebhookResponseMaxLength))
+	return &entity.WebhookEndpointResponse{
+		StatusCode: resp.StatusCode,
+		Body:       string(respBody),
+	}, nil
+}
+
+// isPrivateIP はループバック・プライベート・リンクローカルなど外部から到達できないアドレスか判定します
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified()
+}
@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"time"
+
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+// TextQuoteResponse は注釈の引用部分と前後の文脈です
+type TextQuoteResponse struct {
+	Exact  string `json:"exact"`
+	Prefix string `json:"prefix"`
+	Suffix string `json:"suffix"`
+}
+
+// AnnotationResponse は注釈のレスポンスです
+// Start・Endは段落内の引用部分の文字（Unicodeコードポイント）単位のオフセットで、孤立した注釈では省略されます
+type AnnotationResponse struct {
+	ID         int64             `json:"id"`
+	ContentID  int64             `json:"content_id"`
+	UserID     int64             `json:"user_id"`
+	User       *UserBrief        `json:"user,omitempty"`
+	Body       string            `json:"body"`
+	BlockIndex int               `json:"block_index"`
+	Quote      TextQuoteResponse `json:"quote"`
+	Start      *int              `json:"start,omitempty"`
+	End        *int              `json:"end,omitempty"`
+	IsOrphaned bool              `json:"is_orphaned"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+// AnnotationListResponse はコンテンツの注釈一覧のレスポンスです
+type AnnotationListResponse struct {
+	ContentID   int64                 `json:"content_id"`
+	Annotations []*AnnotationResponse `json:"annotations"`
+	Total       int                   `json:"total"`
+}
+
+// CreateAnnotationRequest は注釈作成のリクエストです
+// Quoteを省略した場合は段落全体への注釈になり、Occurrenceは段落内に同じ引用部分が複数ある場合の出現番号（0始まり）です
+type CreateAnnotationRequest struct {
+	Body       string `json:"body"`
+	BlockIndex *int   `json:"block_index"`
+	Quote      string `json:"quote,omitempty"`
+	Occurrence int    `json:"occurrence,omitempty"`
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *CreateAnnotationRequest) Validate() error {
+	if req.Body == "" {
+		return domainErrors.NewValidationError("注釈の本文は必須です")
+	}
+
+	if req.BlockIndex == nil {
+		return domainErrors.NewValidationError("段落の位置は必須です")
+	}
+
+	if req.Occurrence < 0 {
+		return domainErrors.NewValidationError("出現番号は0以上である必要があります")
+	}
+
+	return nil
+}
+
+// UpdateAnnotationRequest は注釈更新のリクエストです
+type UpdateAnnotationRequest struct {
+	Body string `json:"body"`
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *UpdateAnnotationRequest) Validate() error {
+	if req.Body == "" {
+		return domainErrors.NewValidationError("注釈の本文は必須です")
+	}
+
+	return nil
+}
@@ -15,6 +15,46 @@ type CommentResponse struct {
 	UpdatedAt time.Time          `json:"updated_at"`
 	User      *UserBrief         `json:"user,omitempty"`
 	Replies   []*CommentResponse `json:"replies,omitempty"`
+
+	IsPinned      bool  `json:"is_pinned"`
+	ReactionCount int64 `json:"reaction_count"`
+	IsDeleted     bool  `json:"is_deleted"` // 返信を残すための墓標（本文は削除済みの文言になる）
+
+	IsEdited bool       `json:"is_edited"`
+	EditedAt *time.Time `json:"edited_at,omitempty"` // 最後に本文が編集された日時
+
+	IsPending bool `json:"is_pending"` // コンテンツの投稿者の承認待ち
+
+	Mentions []*MentionResponse `json:"mentions"` // 本文中の@usernameの位置（ユーザーが存在するもののみ）
+}
+
+// CommentRevisionResponse はコメントの編集前の本文のレスポンスです
+type CommentRevisionResponse struct {
+	ID        int64     `json:"id"`
+	Body      string    `json:"body"`
+	EditorID  *int64    `json:"editor_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"` // この本文が置き換えられた日時
+}
+
+// CommentRevisionListResponse はコメントの編集履歴のレスポンスです
+type CommentRevisionListResponse struct {
+	CommentID   int64                      `json:"comment_id"`
+	CurrentBody string                     `json:"current_body"`
+	EditedAt    *time.Time                 `json:"edited_at,omitempty"`
+	Revisions   []*CommentRevisionResponse `json:"revisions"` // 新しい順
+}
+
+// PinCommentRequest はコメントのピン留めリクエストです
+type PinCommentRequest struct {
+	CommentID int64 `json:"comment_id"`
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *PinCommentRequest) Validate() error {
+	if req.CommentID == 0 {
+		return domainErrors.NewValidationError("コメントIDは必須です")
+	}
+	return nil
 }
 
 type UserBrief struct {
@@ -63,3 +103,43 @@ type CommentListResponse struct {
 	TotalCount int64              `json:"total_count"`
 	HasMore    bool               `json:"has_more"`
 }
+
+// CommentTreeRequest はコメントツリーの取得条件です
+type CommentTreeRequest struct {
+	ParentID     *int64 // 指定された場合はそのコメントの返信以下を取得する（「さらに読み込む」用）
+	Cursor       string // 前回のレスポンスの next_cursor / replies_cursor
+	Depth        int    // 取得する返信の階層数
+	Limit        int    // 起点となる階層の取得件数
+	RepliesLimit int    // 各コメントの返信の取得件数
+}
+
+// CommentTreeNodeResponse はコメントツリーの1ノードのレスポンスです
+type CommentTreeNodeResponse struct {
+	ID             int64                      `json:"id"`
+	Body           string                     `json:"body"`
+	UserID         int64                      `json:"user_id"`
+	ContentID      int64                      `json:"content_id"`
+	ParentID       *int64                     `json:"parent_id,omitempty"`
+	CreatedAt      time.Time                  `json:"created_at"`
+	UpdatedAt      time.Time                  `json:"updated_at"`
+	User           *UserBrief                 `json:"user,omitempty"`
+	IsDeleted      bool                       `json:"is_deleted"`
+	IsEdited       bool                       `json:"is_edited"`
+	EditedAt       *time.Time                 `json:"edited_at,omitempty"`
+	IsPending      bool                       `json:"is_pending"`
+	Mentions       []*MentionResponse         `json:"mentions"`
+	Depth          int                        `json:"depth"`
+	ReplyCount     int64                      `json:"reply_count"`
+	HasMoreReplies bool                       `json:"has_more_replies"`
+	RepliesCursor  string                     `json:"replies_cursor,omitempty"` // 続きの返信を取得するカーソル（返信を1件も含まない場合は空）
+	Replies        []*CommentTreeNodeResponse `json:"replies"`
+}
+
+// CommentTreeResponse はコメントツリーのレスポンスです
+type CommentTreeResponse struct {
+	ContentID  int64                      `json:"content_id"`
+	ParentID   *int64                     `json:"parent_id,omitempty"`
+	Comments   []*CommentTreeNodeResponse `json:"comments"`
+	HasMore    bool                       `json:"has_more"`
+	NextCursor string                     `json:"next_cursor,omitempty"`
+}
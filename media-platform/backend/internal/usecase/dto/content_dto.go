@@ -25,6 +25,31 @@ type ContentResponse struct {
 	// いいね・コメント数
 	LikeCount    int64 `json:"like_count"`
 	CommentCount int64 `json:"comment_count"`
+
+	// レビュー対象の作品
+	WorkID              *int64   `json:"work_id,omitempty"`
+	ReviewScore         *float64 `json:"review_score,omitempty"`
+	RecommendationLevel string   `json:"recommendation_level,omitempty"`
+
+	// 種類ごとの追加フィールド
+	ArtistName  string   `json:"artist_name,omitempty"`
+	ReleaseYear *int     `json:"release_year,omitempty"`
+	ImageURL    string   `json:"image_url,omitempty"`
+	ExternalURL string   `json:"external_url,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+
+	// 種類別の構造化項目
+	Metadata map[string]interface{} `json:"metadata"`
+
+	// コメントの受け付け方（open / followers_only / approval_required / closed）
+	CommentPolicy string `json:"comment_policy"`
+
+	// 軸別スコア（詳細取得・作成・更新時のみ）
+	AxisScores []*AxisScoreResponse `json:"axis_scores,omitempty"`
+	Radar      *RadarChartResponse  `json:"radar,omitempty"`
+
+	// 本文中のメンションの位置（詳細取得・作成・更新時のみ）
+	Mentions []*MentionResponse `json:"mentions,omitempty"`
 }
 
 // CreateContentRequest はコンテンツ作成のリクエストです
@@ -33,8 +58,28 @@ type CreateContentRequest struct {
 	Body       string `json:"body"`
 	Type       string `json:"type"`
 	Genre      string `json:"genre"`
-	CategoryID int64  `json:"category_id"`
+	CategoryID int64  `json:"category_id"` // 0の場合はコンテンツタイプの既定カテゴリ
 	Status     string `json:"status"`
+
+	WorkID              *int64   `json:"work_id"`
+	ReviewScore         *float64 `json:"review_score"`
+	RecommendationLevel string   `json:"recommendation_level"`
+
+	// 種類ごとの追加フィールド（入力可否はコンテンツタイプの設定による）
+	ArtistName  string   `json:"artist_name"`
+	ReleaseYear *int     `json:"release_year"`
+	ImageURL    string   `json:"image_url"`
+	ExternalURL string   `json:"external_url"`
+	Tags        []string `json:"tags"`
+
+	// 種類別の構造化項目（項目は種類ごとのスキーマに従う）
+	Metadata map[string]interface{} `json:"metadata"`
+
+	// 軸別スコア（評価軸のキー → 0〜5のスコア）
+	AxisScores map[string]float64 `json:"axis_scores"`
+
+	// コメントの受け付け方（open / followers_only / approval_required / closed、未指定の場合はopen）
+	CommentPolicy string `json:"comment_policy"`
 }
 
 // Validate はリクエストのバリデーションを行います
@@ -59,17 +104,7 @@ func (req *CreateContentRequest) Validate() error {
 		return domainErrors.NewValidationError("コンテンツタイプは必須です")
 	}
 
-	// タイプのバリデーション
-	validTypes := map[string]bool{
-		"音楽": true, "アニメ": true, "漫画": true, "映画": true, "ゲーム": true,
-	}
-	if !validTypes[req.Type] {
-		return domainErrors.NewValidationError("無効なコンテンツタイプです")
-	}
-
-	if req.CategoryID == 0 {
-		return domainErrors.NewValidationError("カテゴリIDは必須です")
-	}
+	// タイプの存在確認はコンテンツタイプ定義（content_types）に基づいてService層で行う
 
 	return nil
 }
@@ -82,6 +117,26 @@ type UpdateContentRequest struct {
 	Genre      string `json:"genre"`
 	CategoryID int64  `json:"category_id"`
 	Status     string `json:"status"`
+
+	WorkID              *int64   `json:"work_id"` // 0を指定すると作品の紐付けを解除
+	ReviewScore         *float64 `json:"review_score"`
+	RecommendationLevel *string  `json:"recommendation_level"`
+
+	// 種類ごとの追加フィールド（空文字・0・空配列を指定するとクリア）
+	ArtistName  *string   `json:"artist_name"`
+	ReleaseYear *int      `json:"release_year"`
+	ImageURL    *string   `json:"image_url"`
+	ExternalURL *string   `json:"external_url"`
+	Tags        *[]string `json:"tags"`
+
+	// 種類別の構造化項目（指定した場合は全体を置き換え、{}でクリア）
+	Metadata map[string]interface{} `json:"metadata"`
+
+	// 軸別スコア（指定した場合は全体を置き換え、{}でクリア）
+	AxisScores map[string]float64 `json:"axis_scores"`
+
+	// コメントの受け付け方（open / followers_only / approval_required / closed）
+	CommentPolicy *string `json:"comment_policy"`
 }
 
 // UpdateContentStatusRequest はステータス更新のリクエストです
@@ -109,4 +164,12 @@ type ContentQuery struct {
 	SortOrder   *string `json:"sort_order"`
 	Limit       int     `json:"limit"`
 	Offset      int     `json:"offset"`
+
+	// 種類別の構造化項目による絞り込み（キー → クエリ文字列の値、Typeの指定が必要）
+	Metadata map[string]string `json:"metadata"`
+}
+
+// ReconcileCountersResponse はカウンター再集計の結果です
+type ReconcileCountersResponse struct {
+	FixedContents int64 `json:"fixed_contents"` // 補正したコンテンツ数
 }
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"time"
+
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+// ContentTypeResponse はコンテンツタイプのレスポンスです
+type ContentTypeResponse struct {
+	ID                int64     `json:"id"`
+	Name              string    `json:"name"`
+	DisplayName       string    `json:"display_name"`
+	Description       string    `json:"description"`
+	AllowedFields     []string  `json:"allowed_fields"`
+	MetadataSchema    string    `json:"metadata_schema"` // 構造化項目のスキーマのキー（空の場合は構造化項目なし）
+	DefaultCategoryID *int64    `json:"default_category_id,omitempty"`
+	SortOrder         int       `json:"sort_order"`
+	IsActive          bool      `json:"is_active"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+
+	// 種類別の構造化項目のスキーマ（レビュー投稿フォームのテンプレート）
+	MetadataFields []*MetadataFieldResponse `json:"metadata_fields"`
+}
+
+// MetadataFieldResponse は種類別の構造化項目の定義のレスポンスです
+type MetadataFieldResponse struct {
+	Key      string   `json:"key"`
+	Label    string   `json:"label"`
+	Kind     string   `json:"kind"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options,omitempty"`
+	Min      *float64 `json:"min,omitempty"`
+	Max      *float64 `json:"max,omitempty"`
+}
+
+// CreateContentTypeRequest はコンテンツタイプ作成のリクエストです
+type CreateContentTypeRequest struct {
+	Name              string   `json:"name"`
+	DisplayName       string   `json:"display_name"` // 省略した場合は名前を使用
+	Description       string   `json:"description"`
+	AllowedFields     []string `json:"allowed_fields"`
+	MetadataSchema    string   `json:"metadata_schema"` // 構造化項目のスキーマのキー（music, game など。省略した場合は構造化項目なし）
+	DefaultCategoryID *int64   `json:"default_category_id"`
+	SortOrder         int      `json:"sort_order"`
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *CreateContentTypeRequest) Validate() error {
+	if req.Name == "" {
+		return domainErrors.NewValidationError("コンテンツタイプ名は必須です")
+	}
+	return nil
+}
+
+// UpdateContentTypeRequest はコンテンツタイプ更新のリクエストです（指定したフィールドのみ更新）
+// 名前は作成後に変更できないため、現在と異なる名前を指定するとエラーになります
+type UpdateContentTypeRequest struct {
+	Name              *string   `json:"name"`
+	DisplayName       *string   `json:"display_name"`
+	Description       *string   `json:"description"`
+	AllowedFields     *[]string `json:"allowed_fields"`
+	MetadataSchema    *string   `json:"metadata_schema"`     // 空文字を指定すると構造化項目なし
+	DefaultCategoryID *int64    `json:"default_category_id"` // 0を指定すると既定カテゴリを解除
+	SortOrder         *int      `json:"sort_order"`
+	IsActive          *bool     `json:"is_active"`
+}
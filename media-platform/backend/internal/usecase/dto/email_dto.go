@@ -0,0 +1,49 @@
+package dto
+
+// メールテンプレートに渡すデータです（テンプレートはinfrastructure/mail/templatesにあります）
+
+// FollowEmailData はフォローされたときのメールのデータです
+type FollowEmailData struct {
+	Recipient   string // 受信者のユーザー名
+	Follower    string // フォローしたユーザーのユーザー名
+	ProfileURL  string // フォロワーを確認できる受信者のプロフィールページ
+	SettingsURL string
+}
+
+// ReplyEmailData はコメントに返信されたときのメールのデータです
+type ReplyEmailData struct {
+	Recipient    string
+	Replier      string
+	ContentTitle string
+	ReplyBody    string
+	CommentURL   string
+	SettingsURL  string
+}
+
+// MentionEmailData はメンションされたときのメールのデータです
+type MentionEmailData struct {
+	Recipient    string
+	Mentioner    string
+	ContentTitle string
+	Excerpt      string // メンションが含まれる本文の抜粋
+	URL          string
+	SettingsURL  string
+}
+
+// DigestEmailItem は週間ダイジェストに載せる投稿です
+type DigestEmailItem struct {
+	Title        string
+	Author       string
+	Excerpt      string
+	LikeCount    int64
+	CommentCount int64
+	URL          string
+}
+
+// DigestEmailData は週間ダイジェストのメールのデータです
+type DigestEmailData struct {
+	Recipient   string
+	Items       []DigestEmailItem
+	FeedURL     string
+	SettingsURL string
+}
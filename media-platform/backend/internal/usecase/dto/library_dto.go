@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"time"
+
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+// LibraryDateLayout はライブラリの開始日・終了日の形式です
+const LibraryDateLayout = "2006-01-02"
+
+// LibraryEntryResponse はライブラリエントリのレスポンスです
+type LibraryEntryResponse struct {
+	ID            int64      `json:"id"`
+	WorkID        *int64     `json:"work_id,omitempty"`
+	ContentID     *int64     `json:"content_id,omitempty"`
+	ItemTitle     string     `json:"item_title"`
+	ItemType      string     `json:"item_type"`
+	Status        string     `json:"status"`
+	Progress      int        `json:"progress"`
+	ProgressTotal *int       `json:"progress_total,omitempty"`
+	ProgressUnit  string     `json:"progress_unit,omitempty"`
+	StartedOn     *time.Time `json:"started_on,omitempty"`
+	FinishedOn    *time.Time `json:"finished_on,omitempty"`
+	Note          string     `json:"note"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// LibraryStatsResponse はライブラリ集計のレスポンスです
+type LibraryStatsResponse struct {
+	Year            *int           `json:"year,omitempty"`
+	Total           int            `json:"total"`
+	ByStatus        map[string]int `json:"by_status"`
+	CompletedByType map[string]int `json:"completed_by_type"`
+}
+
+// CreateLibraryEntryRequest はライブラリ登録のリクエストです
+type CreateLibraryEntryRequest struct {
+	WorkID        *int64  `json:"work_id"`
+	ContentID     *int64  `json:"content_id"`
+	Status        string  `json:"status"`
+	Progress      int     `json:"progress"`
+	ProgressTotal *int    `json:"progress_total"`
+	ProgressUnit  string  `json:"progress_unit"`
+	StartedOn     *string `json:"started_on"`  // YYYY-MM-DD
+	FinishedOn    *string `json:"finished_on"` // YYYY-MM-DD
+	Note          string  `json:"note"`
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *CreateLibraryEntryRequest) Validate() error {
+	if (req.WorkID == nil) == (req.ContentID == nil) {
+		return domainErrors.NewValidationError("work_idかcontent_idのどちらか一方を指定してください")
+	}
+
+	if req.Status == "" {
+		return domainErrors.NewValidationError("ステータスは必須です")
+	}
+
+	return nil
+}
+
+// UpdateLibraryEntryRequest はライブラリ更新のリクエストです（指定したフィールドのみ更新）
+// 開始日・終了日は空文字を指定するとクリアされます
+type UpdateLibraryEntryRequest struct {
+	Status        *string `json:"status"`
+	Progress      *int    `json:"progress"`
+	ProgressTotal *int    `json:"progress_total"`
+	ProgressUnit  *string `json:"progress_unit"`
+	StartedOn     *string `json:"started_on"`
+	FinishedOn    *string `json:"finished_on"`
+	Note          *string `json:"note"`
+}
+
+// LibraryQuery はライブラリ一覧のクエリです
+type LibraryQuery struct {
+	Status string
+	Type   string
+	Year   *int
+	Target string
+	Limit  int
+	Offset int
+}
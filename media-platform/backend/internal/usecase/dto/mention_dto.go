@@ -0,0 +1,10 @@
+package dto
+
+// MentionResponse は本文中のメンションの位置です（フロントエンドでリンクとして描画するために使用）
+// Start・Endは文字（Unicodeコードポイント）単位のオフセットで、Endは@usernameの直後を指します
+type MentionResponse struct {
+	UserID   int64  `json:"user_id"`
+	Username string `json:"username"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+}
@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"time"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+// NotificationResponse は通知のレスポンスです
+// まとめられた通知ではActorが最後に関わったユーザー、ActorCountが関わったユーザー数になります
+type NotificationResponse struct {
+	ID           int64      `json:"id"`
+	Type         string     `json:"type"`
+	Message      string     `json:"message"`
+	ContentID    *int64     `json:"content_id,omitempty"`
+	CommentID    *int64     `json:"comment_id,omitempty"`
+	ContentTitle string     `json:"content_title,omitempty"`
+	Actor        *UserBrief `json:"actor,omitempty"`
+	ActorCount   int        `json:"actor_count"`
+	IsRead       bool       `json:"is_read"`
+	ReadAt       *time.Time `json:"read_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// NotificationListResponse は通知一覧のレスポンスです
+type NotificationListResponse struct {
+	Notifications []*NotificationResponse `json:"notifications"`
+	UnreadCount   int64                   `json:"unread_count"`
+	TotalCount    int64                   `json:"total_count"`
+	HasMore       bool                    `json:"has_more"`
+}
+
+// MarkAllNotificationsReadResponse は通知の一括既読のレスポンスです
+type MarkAllNotificationsReadResponse struct {
+	MarkedCount int64 `json:"marked_count"`
+}
+
+// NotificationPreferenceResponse はメール通知の設定のレスポンスです
+type NotificationPreferenceResponse struct {
+	EmailFollow  bool      `json:"email_follow"`
+	EmailReply   bool      `json:"email_reply"`
+	EmailMention bool      `json:"email_mention"`
+	EmailDigest  bool      `json:"email_digest"`
+	Locale       string    `json:"locale"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// UpdateNotificationPreferenceRequest はメール通知の設定の更新リクエストです（指定した項目のみ更新します）
+type UpdateNotificationPreferenceRequest struct {
+	EmailFollow  *bool   `json:"email_follow,omitempty"`
+	EmailReply   *bool   `json:"email_reply,omitempty"`
+	EmailMention *bool   `json:"email_mention,omitempty"`
+	EmailDigest  *bool   `json:"email_digest,omitempty"`
+	Locale       *string `json:"locale,omitempty"`
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *UpdateNotificationPreferenceRequest) Validate() error {
+	if req.Locale != nil && !entity.IsSupportedLocale(*req.Locale) {
+		return domainErrors.NewValidationError("言語はjaまたはenを指定してください")
+	}
+
+	return nil
+}
@@ -6,12 +6,20 @@ import (
 )
 
 type RatingResponse struct {
-	ID        int64     `json:"id"`
-	Value     int       `json:"value"`
-	UserID    int64     `json:"user_id"`
-	ContentID int64     `json:"content_id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID        int64      `json:"id"`
+	Value     int        `json:"value"`
+	UserID    int64      `json:"user_id"`
+	ContentID int64      `json:"content_id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	User      *UserBrief `json:"user,omitempty"` // 評価したユーザー（include_user指定時のみ）
+}
+
+// RatingListResponse はページネーション付きの評価一覧のレスポンスです
+type RatingListResponse struct {
+	Ratings    []*RatingResponse `json:"ratings"`
+	TotalCount int64             `json:"total_count"`
+	HasMore    bool              `json:"has_more"`
 }
 
 type CreateRatingRequest struct {
@@ -29,16 +37,54 @@ func (req *CreateRatingRequest) Validate() error {
 	return nil
 }
 
+// LikeStateResponse はいいね操作後の状態のレスポンスです
+type LikeStateResponse struct {
+	ContentID int64  `json:"content_id"`
+	Liked     bool   `json:"liked"`
+	RatingID  *int64 `json:"rating_id,omitempty"`
+	LikeCount int64  `json:"like_count"`
+	Changed   bool   `json:"changed"` // 操作によって状態が変わったかどうか
+}
+
 type RatingStatsResponse struct {
 	ContentID int64 `json:"content_id"`
 	LikeCount int   `json:"like_count"`
 	Count     int   `json:"count"`
+
+	// 星評価（1〜5）の集計
+	StarCount int            `json:"star_count"`
+	Average   *float64       `json:"average"`
+	Histogram map[string]int `json:"histogram"` // "1"〜"5"ごとの件数
 }
 
 type UserRatingStatusResponse struct {
 	ContentID int64  `json:"content_id"`
 	HasRated  bool   `json:"has_rated"`
 	RatingID  *int64 `json:"rating_id,omitempty"`
+	Stars     *int   `json:"stars,omitempty"` // 自分の星評価（未評価の場合は省略）
+}
+
+// StarRatingResponse は星評価のレスポンスです
+type StarRatingResponse struct {
+	ID        int64     `json:"id"`
+	Stars     int       `json:"stars"`
+	UserID    int64     `json:"user_id"`
+	ContentID int64     `json:"content_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// StarRatingRequest は星評価の登録・変更リクエストです
+type StarRatingRequest struct {
+	Stars int `json:"stars"`
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *StarRatingRequest) Validate() error {
+	if req.Stars < 1 || req.Stars > 5 {
+		return domainErrors.NewValidationError("星評価は1〜5の整数である必要があります")
+	}
+	return nil
 }
 
 type RatingQuery struct {
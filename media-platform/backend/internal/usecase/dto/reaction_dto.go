@@ -0,0 +1,104 @@
+package dto
+
+import (
+	"time"
+
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+// ReactionKindResponse はリアクションの種類（パレットの1項目）のレスポンスです
+type ReactionKindResponse struct {
+	ID        int64     `json:"id"`
+	Key       string    `json:"key"`
+	Emoji     string    `json:"emoji"`
+	Label     string    `json:"label"`
+	SortOrder int       `json:"sort_order"`
+	IsActive  bool      `json:"is_active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateReactionKindRequest はリアクションの種類作成のリクエストです
+type CreateReactionKindRequest struct {
+	Key       string `json:"key"`
+	Emoji     string `json:"emoji"`
+	Label     string `json:"label"`
+	SortOrder int    `json:"sort_order"`
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *CreateReactionKindRequest) Validate() error {
+	if req.Key == "" {
+		return domainErrors.NewValidationError("リアクションのキーは必須です")
+	}
+
+	if req.Emoji == "" {
+		return domainErrors.NewValidationError("絵文字は必須です")
+	}
+
+	if req.Label == "" {
+		return domainErrors.NewValidationError("リアクションの表示名は必須です")
+	}
+
+	return nil
+}
+
+// UpdateReactionKindRequest はリアクションの種類更新のリクエストです（指定したフィールドのみ更新）
+type UpdateReactionKindRequest struct {
+	Emoji     *string `json:"emoji"`
+	Label     *string `json:"label"`
+	SortOrder *int    `json:"sort_order"`
+	IsActive  *bool   `json:"is_active"`
+}
+
+// ToggleReactionRequest はリアクションのトグルリクエストです
+type ToggleReactionRequest struct {
+	Kind string `json:"kind"` // リアクションの種類のキー（例: cry）
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *ToggleReactionRequest) Validate() error {
+	if req.Kind == "" {
+		return domainErrors.NewValidationError("リアクションの種類は必須です")
+	}
+	return nil
+}
+
+// ReactionCountResponse は種類ごとのリアクション数のレスポンスです
+type ReactionCountResponse struct {
+	Key   string `json:"key"`
+	Emoji string `json:"emoji"`
+	Count int    `json:"count"`
+}
+
+// ReactionToggleResponse はリアクションのトグル結果のレスポンスです
+type ReactionToggleResponse struct {
+	TargetType string                   `json:"target_type"`
+	TargetID   int64                    `json:"target_id"`
+	Kind       string                   `json:"kind"`
+	Reacted    bool                     `json:"reacted"` // trueの場合は追加、falseの場合は取り消し
+	Counts     []*ReactionCountResponse `json:"counts"`
+}
+
+// BulkReactionCountsRequest は複数対象のリアクション数一括取得のリクエストです
+type BulkReactionCountsRequest struct {
+	TargetType string  `json:"target_type"` // content または comment
+	TargetIDs  []int64 `json:"target_ids"`
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *BulkReactionCountsRequest) Validate() error {
+	if req.TargetType == "" {
+		return domainErrors.NewValidationError("target_typeは必須です")
+	}
+
+	if len(req.TargetIDs) == 0 {
+		return domainErrors.NewValidationError("target_idsは必須です")
+	}
+
+	if len(req.TargetIDs) > 100 {
+		return domainErrors.NewValidationError("target_idsは100件以内で指定してください")
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"time"
+
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+// ScoreAxisResponse は評価軸のレスポンスです
+type ScoreAxisResponse struct {
+	ID          int64     `json:"id"`
+	ContentType string    `json:"content_type"`
+	Key         string    `json:"key"`
+	Label       string    `json:"label"`
+	SortOrder   int       `json:"sort_order"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateScoreAxisRequest は評価軸作成のリクエストです
+type CreateScoreAxisRequest struct {
+	Key       string `json:"key"`
+	Label     string `json:"label"`
+	SortOrder int    `json:"sort_order"`
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *CreateScoreAxisRequest) Validate() error {
+	if req.Key == "" {
+		return domainErrors.NewValidationError("評価軸のキーは必須です")
+	}
+
+	if req.Label == "" {
+		return domainErrors.NewValidationError("評価軸の表示名は必須です")
+	}
+
+	return nil
+}
+
+// UpdateScoreAxisRequest は評価軸更新のリクエストです（指定したフィールドのみ更新）
+type UpdateScoreAxisRequest struct {
+	Label     *string `json:"label"`
+	SortOrder *int    `json:"sort_order"`
+}
+
+// AxisScoreResponse はレビューの軸別スコアのレスポンスです
+type AxisScoreResponse struct {
+	Key   string  `json:"key"`
+	Label string  `json:"label"`
+	Score float64 `json:"score"`
+}
+
+// AxisScoreStatsResponse は作品単位の軸別スコア集計のレスポンスです
+type AxisScoreStatsResponse struct {
+	Key          string         `json:"key"`
+	Label        string         `json:"label"`
+	Count        int            `json:"count"`
+	Average      *float64       `json:"average"`
+	Distribution map[string]int `json:"distribution"` // "0"〜"5"（整数部）ごとの件数
+}
+
+// RadarChartResponse はレーダーチャート描画用データのレスポンスです
+// Labels と Values は同じ順序で対応し、スコアがない軸の値はnullになります
+type RadarChartResponse struct {
+	Keys   []string   `json:"keys"`
+	Labels []string   `json:"labels"`
+	Values []*float64 `json:"values"`
+	Max    float64    `json:"max"`
+}
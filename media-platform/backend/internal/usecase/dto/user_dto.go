@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"media-platform/internal/domain/entity"
 	domainErrors "media-platform/internal/domain/errors"
 	"regexp"
 	"time"
@@ -55,6 +56,32 @@ func (req *UpdateUserRequest) Validate() error {
 	return nil
 }
 
+// MaxAvatarUploadBytes はアバター画像アップロードの最大サイズです
+const MaxAvatarUploadBytes = entity.AvatarMaxBytes
+
+// UpdateAvatarRequest はアバター画像アップロードのリクエストです
+// 切り抜き範囲を省略した場合は画像中央の正方形を使用します
+type UpdateAvatarRequest struct {
+	Data     []byte
+	CropX    *int
+	CropY    *int
+	CropSize *int
+}
+
+func (req *UpdateAvatarRequest) Validate() error {
+	if len(req.Data) == 0 {
+		return domainErrors.NewValidationError("アバター画像は必須です")
+	}
+	if len(req.Data) > MaxAvatarUploadBytes {
+		return domainErrors.NewValidationError("アバター画像は5MB以内である必要があります")
+	}
+	hasCrop := req.CropX != nil || req.CropY != nil || req.CropSize != nil
+	if hasCrop && (req.CropX == nil || req.CropY == nil || req.CropSize == nil) {
+		return domainErrors.NewValidationError("切り抜き範囲はcrop_x, crop_y, crop_sizeをすべて指定してください")
+	}
+	return nil
+}
+
 type LoginRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
@@ -75,14 +102,15 @@ func (req *LoginRequest) Validate() error {
 }
 
 type UserResponse struct {
-	ID        int64     `json:"id"`
-	Username  string    `json:"username"`
-	Email     string    `json:"email,omitempty"`
-	Bio       string    `json:"bio,omitempty"`
-	Avatar    string    `json:"avatar,omitempty"`
-	Role      string    `json:"role,omitempty"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	ID         int64             `json:"id"`
+	Username   string            `json:"username"`
+	Email      string            `json:"email,omitempty"`
+	Bio        string            `json:"bio,omitempty"`
+	Avatar     string            `json:"avatar,omitempty"`
+	AvatarURLs map[string]string `json:"avatar_urls,omitempty"` // サイズ別のアバターURL
+	Role       string            `json:"role,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at,omitempty"`
 }
 
 type LoginResponse struct {
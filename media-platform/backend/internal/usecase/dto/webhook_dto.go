@@ -0,0 +1,142 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+// WebhookResponse はWebhookのレスポンスです
+// Secretは作成時とシークレットの再生成時のみ含まれます
+type WebhookResponse struct {
+	ID          int64     `json:"id"`
+	OwnerID     int64     `json:"owner_id"`
+	URL         string    `json:"url"`
+	Events      []string  `json:"events"`
+	Description string    `json:"description"`
+	IsActive    bool      `json:"is_active"`
+	Secret      string    `json:"secret,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// WebhookListResponse はWebhook一覧のレスポンスです
+type WebhookListResponse struct {
+	Webhooks []*WebhookResponse `json:"webhooks"`
+	Total    int64              `json:"total"`
+}
+
+// CreateWebhookRequest はWebhook登録のリクエストです
+type CreateWebhookRequest struct {
+	URL         string   `json:"url"`
+	Events      []string `json:"events"`
+	Description string   `json:"description"`
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *CreateWebhookRequest) Validate() error {
+	if req.URL == "" {
+		return domainErrors.NewValidationError("エンドポイントURLは必須です")
+	}
+
+	if len(req.Events) == 0 {
+		return domainErrors.NewValidationError("購読するイベントを1つ以上指定してください")
+	}
+
+	return nil
+}
+
+// UpdateWebhookRequest はWebhook更新のリクエストです（指定した項目のみ更新します）
+type UpdateWebhookRequest struct {
+	URL         *string  `json:"url,omitempty"`
+	Events      []string `json:"events,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	IsActive    *bool    `json:"is_active,omitempty"`
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *UpdateWebhookRequest) Validate() error {
+	if req.URL == nil && req.Events == nil && req.Description == nil && req.IsActive == nil {
+		return domainErrors.NewValidationError("更新する項目を指定してください")
+	}
+
+	return nil
+}
+
+// WebhookDeliveryResponse は配信ログのレスポンスです
+type WebhookDeliveryResponse struct {
+	ID             int64           `json:"id"`
+	WebhookID      int64           `json:"webhook_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"` // 配信待ちの場合のみ
+	LastAttemptAt  *time.Time      `json:"last_attempt_at,omitempty"`
+	ResponseStatus *int            `json:"response_status,omitempty"`
+	ResponseBody   string          `json:"response_body,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	Payload        json.RawMessage `json:"payload"`
+}
+
+// WebhookDeliveryListResponse は配信ログ一覧のレスポンスです
+type WebhookDeliveryListResponse struct {
+	Deliveries []*WebhookDeliveryResponse `json:"deliveries"`
+	Total      int64                      `json:"total"`
+	HasMore    bool                       `json:"has_more"`
+}
+
+// ========== 配信するペイロード ==========
+
+// WebhookEventPayload はエンドポイントに送信するJSON本文です
+type WebhookEventPayload struct {
+	ID        string      `json:"id"`   // イベントのID（再送しても同じ）
+	Type      string      `json:"type"` // 例: content.published
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// WebhookContentData は content.published のデータです
+type WebhookContentData struct {
+	ID          int64      `json:"id"`
+	Title       string     `json:"title"`
+	Body        string     `json:"body"`
+	Type        string     `json:"type"`
+	Genre       string     `json:"genre"`
+	Tags        []string   `json:"tags"`
+	AuthorID    int64      `json:"author_id"`
+	AuthorName  string     `json:"author_name"`
+	CategoryID  int64      `json:"category_id"`
+	WorkID      *int64     `json:"work_id,omitempty"`
+	ReviewScore *float64   `json:"review_score,omitempty"`
+	PublishedAt *time.Time `json:"published_at"`
+}
+
+// WebhookCommentData は comment.created のデータです
+type WebhookCommentData struct {
+	ID        int64     `json:"id"`
+	ContentID int64     `json:"content_id"`
+	ParentID  *int64    `json:"parent_id,omitempty"`
+	UserID    int64     `json:"user_id"`
+	Username  string    `json:"username"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WebhookFollowData は user.followed のデータです
+type WebhookFollowData struct {
+	FollowerID        int64     `json:"follower_id"`
+	FollowerUsername  string    `json:"follower_username"`
+	FollowingID       int64     `json:"following_id"`
+	FollowingUsername string    `json:"following_username"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// WebhookPingData は ping のデータです
+type WebhookPingData struct {
+	WebhookID int64    `json:"webhook_id"`
+	Events    []string `json:"events"`
+}
@@ -0,0 +1,120 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	domainErrors "media-platform/internal/domain/errors"
+)
+
+// WorkResponse は作品のレスポンスです
+type WorkResponse struct {
+	ID              int64             `json:"id"`
+	Type            string            `json:"type"`
+	Title           string            `json:"title"`
+	AlternateTitles []string          `json:"alternate_titles"`
+	Creator         string            `json:"creator"`
+	ReleaseYear     *int              `json:"release_year,omitempty"`
+	ExternalIDs     map[string]string `json:"external_ids"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+}
+
+// WorkReviewStatsResponse は作品のレビュー集計のレスポンスです
+type WorkReviewStatsResponse struct {
+	ReviewCount                int            `json:"review_count"`
+	ScoredCount                int            `json:"scored_count"`
+	AverageScore               *float64       `json:"average_score"`
+	RecommendationDistribution map[string]int `json:"recommendation_distribution"`
+
+	// 軸別スコアの集計とレーダーチャート用データ（平均値）
+	AxisStats []*AxisScoreStatsResponse `json:"axis_stats"`
+	Radar     *RadarChartResponse       `json:"radar,omitempty"`
+}
+
+// WorkDetailResponse は作品詳細（レビュー一覧と集計を含む）のレスポンスです
+type WorkDetailResponse struct {
+	Work    *WorkResponse            `json:"work"`
+	Stats   *WorkReviewStatsResponse `json:"stats"`
+	Reviews []*ContentResponse       `json:"reviews"`
+}
+
+// CreateWorkRequest は作品登録のリクエストです
+type CreateWorkRequest struct {
+	Type            string            `json:"type"`
+	Title           string            `json:"title"`
+	AlternateTitles []string          `json:"alternate_titles"`
+	Creator         string            `json:"creator"`
+	ReleaseYear     *int              `json:"release_year"`
+	ExternalIDs     map[string]string `json:"external_ids"`
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *CreateWorkRequest) Validate() error {
+	if req.Type == "" {
+		return domainErrors.NewValidationError("作品タイプは必須です")
+	}
+
+	if req.Title == "" {
+		return domainErrors.NewValidationError("作品タイトルは必須です")
+	}
+
+	return nil
+}
+
+// UpdateWorkRequest は作品更新のリクエストです（指定したフィールドのみ更新）
+type UpdateWorkRequest struct {
+	Type            *string            `json:"type"`
+	Title           *string            `json:"title"`
+	AlternateTitles *[]string          `json:"alternate_titles"`
+	Creator         *string            `json:"creator"`
+	ReleaseYear     *int               `json:"release_year"`
+	ExternalIDs     *map[string]string `json:"external_ids"`
+}
+
+// WorkQuery は作品検索のクエリです
+type WorkQuery struct {
+	Keyword string
+	Type    string
+	Limit   int
+	Offset  int
+}
+
+// WorkLookupRequest は外部メタデータによる作品ルックアップのリクエストです
+type WorkLookupRequest struct {
+	Query string
+	Type  string
+}
+
+// Validate はリクエストのバリデーションを行います
+func (req *WorkLookupRequest) Validate() error {
+	if strings.TrimSpace(req.Query) == "" {
+		return domainErrors.NewValidationError("検索キーワード(q)は必須です")
+	}
+
+	if len(req.Query) > 200 {
+		return domainErrors.NewValidationError("検索キーワードは200文字以内である必要があります")
+	}
+
+	return nil
+}
+
+// WorkCandidateResponse は外部メタデータから取得した作品候補のレスポンスです
+type WorkCandidateResponse struct {
+	Source      string            `json:"source"`
+	SourceID    string            `json:"source_id"`
+	Type        string            `json:"type"`
+	Title       string            `json:"title"`
+	Creator     string            `json:"creator"`
+	ReleaseYear *int              `json:"release_year,omitempty"`
+	CoverURL    string            `json:"cover_url,omitempty"`
+	ExternalIDs map[string]string `json:"external_ids"`
+}
+
+// WorkLookupResponse は作品ルックアップのレスポンスです
+type WorkLookupResponse struct {
+	Query      string                   `json:"query"`
+	Cached     bool                     `json:"cached"`     // 外部プロバイダーの結果がキャッシュから返されたか
+	Works      []*WorkResponse          `json:"works"`      // 既にカタログに登録済みの作品
+	Candidates []*WorkCandidateResponse `json:"candidates"` // 外部メタデータの候補
+}
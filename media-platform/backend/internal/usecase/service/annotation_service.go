@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+	"media-platform/internal/usecase/dto"
+)
+
+// AnnotationService はコンテンツ本文の段落・範囲に付ける注釈に関するユースケースを提供します
+type AnnotationService struct {
+	annotationRepo repository.AnnotationRepository
+	contentRepo    repository.ContentRepository
+	userRepo       repository.UserRepository
+	followRepo     repository.FollowRepository
+}
+
+// NewAnnotationService は新しいAnnotationServiceのインスタンスを生成します
+func NewAnnotationService(
+	annotationRepo repository.AnnotationRepository,
+	contentRepo repository.ContentRepository,
+	userRepo repository.UserRepository,
+	followRepo repository.FollowRepository,
+) *AnnotationService {
+	return &AnnotationService{
+		annotationRepo: annotationRepo,
+		contentRepo:    contentRepo,
+		userRepo:       userRepo,
+		followRepo:     followRepo,
+	}
+}
+
+// GetAnnotations はコンテンツの注釈一覧を取得します（includeOrphanedがtrueの場合は孤立した注釈も含む）
+func (s *AnnotationService) GetAnnotations(ctx context.Context, contentID int64, includeOrphaned bool) (*dto.AnnotationListResponse, error) {
+	content, err := s.findContent(ctx, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations, err := s.annotationRepo.FindByContent(ctx, contentID, includeOrphaned)
+	if err != nil {
+		return nil, fmt.Errorf("annotations lookup failed: %w", err)
+	}
+
+	blocks := entity.SplitBlocks(content.Body)
+	users := make(map[int64]*entity.User)
+	responses := make([]*dto.AnnotationResponse, 0, len(annotations))
+	for _, annotation := range annotations {
+		user, ok := users[annotation.UserID]
+		if !ok {
+			user, err = s.userRepo.Find(ctx, annotation.UserID)
+			if err != nil && !domainErrors.IsNotFoundError(err) {
+				return nil, fmt.Errorf("user lookup failed: %w", err)
+			}
+			users[annotation.UserID] = user
+		}
+		responses = append(responses, s.toAnnotationResponse(annotation, blocks, user))
+	}
+
+	return &dto.AnnotationListResponse{
+		ContentID:   contentID,
+		Annotations: responses,
+		Total:       len(responses),
+	}, nil
+}
+
+// CreateAnnotation はコンテンツの段落に注釈を追加します
+// コンテンツのコメント設定が注釈にも適用され、承認制のコンテンツには投稿者のみ注釈を追加できます
+func (s *AnnotationService) CreateAnnotation(ctx context.Context, contentID, userID int64, userRole string, req *dto.CreateAnnotationRequest) (*dto.AnnotationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	content, err := s.findContent(ctx, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCommentPolicy(ctx, s.followRepo, content, userID, userRole); err != nil {
+		return nil, err
+	}
+	if content.RequiresCommentApproval(userID, userRole) {
+		return nil, domainErrors.NewPermissionError("このコンテンツはコメントが承認制のため注釈を追加できません")
+	}
+
+	annotation, err := entity.NewAnnotation(contentID, userID, req.Body, content.Body, *req.BlockIndex, req.Quote, req.Occurrence)
+	if err != nil {
+		return nil, domainErrors.NewValidationError(err.Error())
+	}
+
+	if err := s.annotationRepo.Create(ctx, annotation); err != nil {
+		if domainErrors.IsValidationError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("annotation creation failed: %w", err)
+	}
+
+	return s.toAnnotationResponseWithUser(ctx, annotation, content)
+}
+
+// UpdateAnnotation は注釈の本文を更新します（注釈の作成者または管理者のみ）
+func (s *AnnotationService) UpdateAnnotation(ctx context.Context, id, userID int64, userRole string, req *dto.UpdateAnnotationRequest) (*dto.AnnotationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	annotation, err := s.findAnnotation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !annotation.CanEdit(userID, userRole) {
+		return nil, domainErrors.NewPermissionError("この注釈を編集する権限がありません")
+	}
+
+	if err := annotation.SetBody(req.Body); err != nil {
+		return nil, domainErrors.NewValidationError(err.Error())
+	}
+
+	if err := s.annotationRepo.UpdateBody(ctx, annotation); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("annotation update failed: %w", err)
+	}
+
+	content, err := s.findContent(ctx, annotation.ContentID)
+	if err != nil {
+		return nil, err
+	}
+	return s.toAnnotationResponseWithUser(ctx, annotation, content)
+}
+
+// DeleteAnnotation は注釈を削除します（注釈の作成者・コンテンツの投稿者・管理者のみ）
+func (s *AnnotationService) DeleteAnnotation(ctx context.Context, id, userID int64, userRole string) error {
+	annotation, err := s.findAnnotation(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !annotation.CanEdit(userID, userRole) {
+		content, err := s.findContent(ctx, annotation.ContentID)
+		if err != nil {
+			return err
+		}
+		if !content.CanEdit(userID, userRole) {
+			return domainErrors.NewPermissionError("この注釈を削除する権限がありません")
+		}
+	}
+
+	if err := s.annotationRepo.Delete(ctx, id); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("annotation deletion failed: %w", err)
+	}
+
+	return nil
+}
+
+// Reanchor はコンテンツ本文の編集後に、編集前の本文をもとに注釈の段落の位置を付け直します
+// 引用部分が見つからなくなった注釈は孤立した注釈として残し、再び見つかった場合は元に戻します
+func (s *AnnotationService) Reanchor(ctx context.Context, contentID int64, oldBody, newBody string) error {
+	if oldBody == newBody {
+		return nil
+	}
+
+	annotations, err := s.annotationRepo.FindByContent(ctx, contentID, true)
+	if err != nil {
+		return fmt.Errorf("annotations lookup failed: %w", err)
+	}
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	oldBlocks := entity.SplitBlocks(oldBody)
+	newBlocks := entity.SplitBlocks(newBody)
+
+	changed := []*entity.Annotation{}
+	orphaned := 0
+	for _, annotation := range annotations {
+		if annotation.Reanchor(oldBlocks, newBlocks) {
+			changed = append(changed, annotation)
+		}
+		if annotation.IsOrphaned {
+			orphaned++
+		}
+	}
+
+	if err := s.annotationRepo.UpdateAnchors(ctx, changed); err != nil {
+		return fmt.Errorf("annotation anchors update failed: %w", err)
+	}
+
+	if len(changed) > 0 {
+		log.Printf("📌 注釈の位置を更新しました: content %d, 更新%d件, 孤立%d件", contentID, len(changed), orphaned)
+	}
+	return nil
+}
+
+// ========== ヘルパーメソッド ==========
+
+func (s *AnnotationService) findContent(ctx context.Context, contentID int64) (*entity.Content, error) {
+	content, err := s.contentRepo.Find(ctx, contentID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("content lookup failed: %w", err)
+	}
+	if content == nil {
+		return nil, domainErrors.NewNotFoundError("Content", contentID)
+	}
+	return content, nil
+}
+
+func (s *AnnotationService) findAnnotation(ctx context.Context, id int64) (*entity.Annotation, error) {
+	annotation, err := s.annotationRepo.Find(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("annotation lookup failed: %w", err)
+	}
+	return annotation, nil
+}
+
+func (s *AnnotationService) toAnnotationResponseWithUser(ctx context.Context, annotation *entity.Annotation, content *entity.Content) (*dto.AnnotationResponse, error) {
+	user, err := s.userRepo.Find(ctx, annotation.UserID)
+	if err != nil && !domainErrors.IsNotFoundError(err) {
+		return nil, fmt.Errorf("user lookup failed: %w", err)
+	}
+	return s.toAnnotationResponse(annotation, entity.SplitBlocks(content.Body), user), nil
+}
+
+// Entity → DTO 変換（Service層の責務）
+func (s *AnnotationService) toAnnotationResponse(annotation *entity.Annotation, blocks []string, user *entity.User) *dto.AnnotationResponse {
+	response := &dto.AnnotationResponse{
+		ID:         annotation.ID,
+		ContentID:  annotation.ContentID,
+		UserID:     annotation.UserID,
+		Body:       annotation.Body,
+		BlockIndex: annotation.BlockIndex,
+		Quote: dto.TextQuoteResponse{
+			Exact:  annotation.Quote.Exact,
+			Prefix: annotation.Quote.Prefix,
+			Suffix: annotation.Quote.Suffix,
+		},
+		IsOrphaned: annotation.IsOrphaned,
+		CreatedAt:  annotation.CreatedAt,
+		UpdatedAt:  annotation.UpdatedAt,
+	}
+
+	if start, end, ok := annotation.Locate(blocks); ok {
+		response.Start = &start
+		response.End = &end
+	}
+
+	if user != nil {
+		response.User = &dto.UserBrief{
+			ID:       user.ID,
+			Username: user.Username,
+			Avatar:   user.Avatar,
+		}
+	}
+	return response
+}
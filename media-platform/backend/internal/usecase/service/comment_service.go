@@ -2,7 +2,11 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"media-platform/internal/domain/entity"
@@ -12,27 +16,45 @@ import (
 )
 
 type CommentService struct {
-	commentRepo repository.CommentRepository
-	contentRepo repository.ContentRepository
-	userRepo    repository.UserRepository
+	commentRepo         repository.CommentRepository
+	contentRepo         repository.ContentRepository
+	userRepo            repository.UserRepository
+	followRepo          repository.FollowRepository
+	mentionService      *MentionService
+	notificationService *NotificationService
+	streamService       *StreamService
+	eventService        *EventService
+	publicRevisions     bool // trueの場合は誰でも編集履歴を閲覧できる（falseの場合は投稿者と管理者のみ）
 }
 
 func NewCommentService(
 	commentRepo repository.CommentRepository,
 	contentRepo repository.ContentRepository,
 	userRepo repository.UserRepository,
+	followRepo repository.FollowRepository,
+	mentionService *MentionService,
+	notificationService *NotificationService,
+	streamService *StreamService,
+	eventService *EventService,
+	publicRevisions bool,
 ) *CommentService {
 	return &CommentService{
-		commentRepo: commentRepo,
-		contentRepo: contentRepo,
-		userRepo:    userRepo,
+		commentRepo:         commentRepo,
+		contentRepo:         contentRepo,
+		userRepo:            userRepo,
+		followRepo:          followRepo,
+		mentionService:      mentionService,
+		notificationService: notificationService,
+		streamService:       streamService,
+		eventService:        eventService,
+		publicRevisions:     publicRevisions,
 	}
 }
 
 // ========== Entity to DTO変換メソッド ==========
 
 func (s *CommentService) toCommentResponse(comment *entity.Comment) *dto.CommentResponse {
-	return &dto.CommentResponse{
+	response := &dto.CommentResponse{
 		ID:        comment.ID,
 		Body:      comment.Body,
 		UserID:    comment.UserID,
@@ -40,12 +62,27 @@ func (s *CommentService) toCommentResponse(comment *entity.Comment) *dto.Comment
 		ParentID:  comment.ParentID,
 		CreatedAt: comment.CreatedAt,
 		UpdatedAt: comment.UpdatedAt,
+
+		IsPinned:      comment.IsPinned,
+		ReactionCount: comment.ReactionCount,
+		IsDeleted:     comment.IsDeleted(),
+		IsEdited:      comment.IsEdited(),
+		EditedAt:      comment.EditedAt,
+		IsPending:     comment.IsPending,
+	}
+
+	// 墓標は本文と投稿者を表示しない
+	if comment.IsDeleted() {
+		response.Body = entity.CommentTombstoneBody
+		response.UserID = 0
 	}
+
+	return response
 }
 
 func (s *CommentService) toCommentResponseWithUser(comment *entity.Comment, user *entity.User) *dto.CommentResponse {
 	response := s.toCommentResponse(comment)
-	if user != nil {
+	if user != nil && !comment.IsDeleted() {
 		response.User = &dto.UserBrief{
 			ID:       user.ID,
 			Username: user.Username,
@@ -55,6 +92,42 @@ func (s *CommentService) toCommentResponseWithUser(comment *entity.Comment, user
 	return response
 }
 
+func (s *CommentService) toCommentTreeNodeResponse(node *entity.CommentNode) *dto.CommentTreeNodeResponse {
+	comment := node.Comment
+	response := &dto.CommentTreeNodeResponse{
+		ID:         comment.ID,
+		Body:       comment.Body,
+		UserID:     comment.UserID,
+		ContentID:  comment.ContentID,
+		ParentID:   comment.ParentID,
+		CreatedAt:  comment.CreatedAt,
+		UpdatedAt:  comment.UpdatedAt,
+		IsDeleted:  comment.IsDeleted(),
+		IsEdited:   comment.IsEdited(),
+		EditedAt:   comment.EditedAt,
+		IsPending:  comment.IsPending,
+		Depth:      node.Depth,
+		ReplyCount: node.ReplyCount,
+		Replies:    []*dto.CommentTreeNodeResponse{},
+	}
+
+	// 墓標は本文と投稿者を表示しない
+	if comment.IsDeleted() {
+		response.Body = entity.CommentTombstoneBody
+		response.UserID = 0
+		return response
+	}
+
+	if node.Author != nil {
+		response.User = &dto.UserBrief{
+			ID:       node.Author.ID,
+			Username: node.Author.Username,
+			Avatar:   node.Author.Avatar,
+		}
+	}
+	return response
+}
+
 func (s *CommentService) toCommentResponseList(comments []*entity.Comment) []*dto.CommentResponse {
 	responses := make([]*dto.CommentResponse, len(comments))
 	for i, comment := range comments {
@@ -93,7 +166,8 @@ func (s *CommentService) CreateCommentEntity(req *dto.CreateCommentRequest, user
 
 // ========== Use Cases ==========
 
-func (s *CommentService) GetCommentByID(ctx context.Context, id int64) (*dto.CommentResponse, error) {
+// 承認待ちのコメントはコンテンツの投稿者・管理者・コメントの投稿者以外には存在しないものとして扱います
+func (s *CommentService) GetCommentByID(ctx context.Context, id int64, userID int64, userRole string) (*dto.CommentResponse, error) {
 	comment, err := s.commentRepo.Find(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("comment lookup failed: %w", err)
@@ -101,17 +175,31 @@ func (s *CommentService) GetCommentByID(ctx context.Context, id int64) (*dto.Com
 	if comment == nil {
 		return nil, domainErrors.NewNotFoundError("Comment", id)
 	}
+	if err := s.ensureCanSee(ctx, comment, userID, userRole); err != nil {
+		return nil, err
+	}
 
 	user, err := s.userRepo.Find(ctx, comment.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("user lookup failed: %w", err)
 	}
 
-	return s.toCommentResponseWithUser(comment, user), nil
+	response := s.toCommentResponseWithUser(comment, user)
+	if err := s.attachCommentMentions(ctx, []*dto.CommentResponse{response}); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 // GetCommentsByContent - FindAllを使わずにFindByContentを使用
-func (s *CommentService) GetCommentsByContent(ctx context.Context, contentID int64, limit, offset int) (*dto.CommentListResponse, error) {
+// sortByはnewest（デフォルト）/oldest/top/controversialのいずれかで、ピン留めされたコメントは常に先頭になります
+// 承認待ちのコメントは閲覧者がコンテンツの投稿者・管理者の場合はすべて、それ以外の場合は自分のもののみ含みます
+func (s *CommentService) GetCommentsByContent(ctx context.Context, contentID int64, userID int64, userRole string, sortBy string, limit, offset int) (*dto.CommentListResponse, error) {
+	commentSort, err := parseCommentSort(sortBy, entity.CommentSortNewest)
+	if err != nil {
+		return nil, err
+	}
+
 	// コンテンツの存在確認
 	content, err := s.contentRepo.Find(ctx, contentID)
 	if err != nil {
@@ -132,14 +220,16 @@ func (s *CommentService) GetCommentsByContent(ctx context.Context, contentID int
 		offset = 0
 	}
 
+	viewer := newCommentViewer(content, userID, userRole)
+
 	// 親コメント（トップレベル）を取得
-	comments, err := s.commentRepo.FindByContent(ctx, contentID, limit, offset)
+	comments, err := s.commentRepo.FindByContent(ctx, contentID, viewer, commentSort, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("comments lookup failed: %w", err)
 	}
 
 	// 総コメント数の取得
-	totalCount, err := s.commentRepo.CountByContent(ctx, contentID)
+	totalCount, err := s.commentRepo.CountByContent(ctx, contentID, viewer)
 	if err != nil {
 		return nil, fmt.Errorf("comments count failed: %w", err)
 	}
@@ -156,7 +246,7 @@ func (s *CommentService) GetCommentsByContent(ctx context.Context, contentID int
 		response := s.toCommentResponseWithUser(comment, user)
 
 		// 返信の取得（最初の数件のみ）
-		replies, err := s.commentRepo.FindReplies(ctx, comment.ID, 5, 0)
+		replies, err := s.commentRepo.FindReplies(ctx, comment.ID, viewer, entity.CommentSortOldest, 5, 0)
 		if err == nil && len(replies) > 0 {
 			response.Replies = make([]*dto.CommentResponse, 0, len(replies))
 			for _, reply := range replies {
@@ -173,10 +263,19 @@ func (s *CommentService) GetCommentsByContent(ctx context.Context, contentID int
 		responses = append(responses, response)
 	}
 
+	if err := s.attachCommentMentions(ctx, responses); err != nil {
+		return nil, err
+	}
 	return s.toCommentListResponse(responses, totalCount, limit), nil
 }
 
-func (s *CommentService) GetReplies(ctx context.Context, parentID int64, limit, offset int) ([]*dto.CommentResponse, error) {
+// sortByはoldest（デフォルト）/newest/top/controversialのいずれかです
+func (s *CommentService) GetReplies(ctx context.Context, parentID int64, userID int64, userRole string, sortBy string, limit, offset int) ([]*dto.CommentResponse, error) {
+	commentSort, err := parseCommentSort(sortBy, entity.CommentSortOldest)
+	if err != nil {
+		return nil, err
+	}
+
 	// 親コメントの存在確認
 	parentComment, err := s.commentRepo.Find(ctx, parentID)
 	if err != nil {
@@ -186,6 +285,18 @@ func (s *CommentService) GetReplies(ctx context.Context, parentID int64, limit,
 		return nil, domainErrors.NewNotFoundError("Comment", parentID)
 	}
 
+	content, err := s.contentRepo.Find(ctx, parentComment.ContentID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("content lookup failed: %w", err)
+	}
+	viewer := newCommentViewer(content, userID, userRole)
+	if !parentComment.CanSee(viewer) {
+		return nil, domainErrors.NewNotFoundError("Comment", parentID)
+	}
+
 	// デフォルト値の設定
 	if limit <= 0 {
 		limit = 10
@@ -198,7 +309,7 @@ func (s *CommentService) GetReplies(ctx context.Context, parentID int64, limit,
 	}
 
 	// 返信の取得
-	replies, err := s.commentRepo.FindReplies(ctx, parentID, limit, offset)
+	replies, err := s.commentRepo.FindReplies(ctx, parentID, viewer, commentSort, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("replies lookup failed: %w", err)
 	}
@@ -215,9 +326,252 @@ func (s *CommentService) GetReplies(ctx context.Context, parentID int64, limit,
 		responses = append(responses, response)
 	}
 
+	if err := s.attachCommentMentions(ctx, responses); err != nil {
+		return nil, err
+	}
 	return responses, nil
 }
 
+// GetCommentTree はコメントをツリー構造で取得します
+// 投稿者の概要と返信数は1回のクエリで取得し、打ち切られた枝には続きを取得するカーソルを付けます
+func (s *CommentService) GetCommentTree(ctx context.Context, contentID int64, userID int64, userRole string, req *dto.CommentTreeRequest) (*dto.CommentTreeResponse, error) {
+	// コンテンツの存在確認
+	content, err := s.contentRepo.Find(ctx, contentID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("content lookup failed: %w", err)
+	}
+	viewer := newCommentViewer(content, userID, userRole)
+
+	// 起点のコメントの確認（指定されている場合）
+	if req.ParentID != nil {
+		parentComment, err := s.commentRepo.Find(ctx, *req.ParentID)
+		if err != nil {
+			if domainErrors.IsNotFoundError(err) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("parent comment lookup failed: %w", err)
+		}
+		if parentComment.ContentID != contentID || !parentComment.CanSee(viewer) {
+			return nil, domainErrors.NewNotFoundError("Comment", *req.ParentID)
+		}
+	}
+
+	// パラメータの正規化
+	depth := req.Depth
+	if depth < 0 {
+		depth = entity.CommentTreeDefaultDepth
+	}
+	if depth > entity.CommentMaxDepth {
+		depth = entity.CommentMaxDepth
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > entity.CommentTreeMaxRoots {
+		limit = entity.CommentTreeMaxRoots
+	}
+	repliesLimit := req.RepliesLimit
+	if repliesLimit <= 0 {
+		repliesLimit = entity.CommentTreeDefaultReplies
+	}
+	if repliesLimit > entity.CommentTreeMaxReplies {
+		repliesLimit = entity.CommentTreeMaxReplies
+	}
+
+	query := entity.CommentTreeQuery{
+		ContentID:  contentID,
+		ParentID:   req.ParentID,
+		RootLimit:  limit + 1, // 次のページの有無を判定するために1件多く取得する
+		ReplyLimit: repliesLimit,
+		MaxDepth:   depth,
+		Viewer:     viewer,
+	}
+	if req.Cursor != "" {
+		cursor, err := decodeCommentCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		query.After = cursor
+	}
+
+	nodes, err := s.commentRepo.FindTree(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("comment tree lookup failed: %w", err)
+	}
+
+	tree := s.buildCommentTree(contentID, req.ParentID, nodes, limit)
+	if err := s.attachCommentTreeMentions(ctx, tree.Comments); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// buildCommentTree は走査順のフラットなノード一覧をツリーに組み立てます
+func (s *CommentService) buildCommentTree(contentID int64, parentID *int64, nodes []*entity.CommentNode, limit int) *dto.CommentTreeResponse {
+	tree := &dto.CommentTreeResponse{
+		ContentID: contentID,
+		ParentID:  parentID,
+		Comments:  []*dto.CommentTreeNodeResponse{},
+	}
+
+	// ノードは階層順に並んでいるため、親は常に子より先に現れる
+	byID := make(map[int64]*dto.CommentTreeNodeResponse, len(nodes))
+	var roots []*dto.CommentTreeNodeResponse
+	for _, node := range nodes {
+		response := s.toCommentTreeNodeResponse(node)
+		byID[node.Comment.ID] = response
+
+		if node.Depth == 0 {
+			roots = append(roots, response)
+			continue
+		}
+		// 1件多く取得したルートの子孫は親が見つからないため含めない
+		if parent, ok := byID[*node.Comment.ParentID]; ok {
+			parent.Replies = append(parent.Replies, response)
+		}
+	}
+
+	// ルートコメントは新着順、返信の続きは古い順に並べる
+	sort.SliceStable(roots, func(i, j int) bool {
+		if parentID == nil {
+			return commentCursorLess(roots[j], roots[i])
+		}
+		return commentCursorLess(roots[i], roots[j])
+	})
+
+	if len(roots) > limit {
+		// 1件多く取得した分は次のページの有無の判定にのみ使用する
+		roots = roots[:limit]
+		tree.HasMore = true
+		tree.NextCursor = encodeCommentCursor(roots[limit-1].CreatedAt, roots[limit-1].ID)
+	}
+
+	for _, root := range roots {
+		markTruncatedReplies(root)
+	}
+
+	if roots != nil {
+		tree.Comments = roots
+	}
+	return tree
+}
+
+// attachCommentMentions はコメント（返信を含む）のレスポンスにメンションの位置を一括で設定します
+func (s *CommentService) attachCommentMentions(ctx context.Context, responses []*dto.CommentResponse) error {
+	bodies := make(map[int64]string)
+	var all []*dto.CommentResponse
+	var collect func(responses []*dto.CommentResponse)
+	collect = func(responses []*dto.CommentResponse) {
+		for _, response := range responses {
+			all = append(all, response)
+			if !response.IsDeleted {
+				bodies[response.ID] = response.Body
+			}
+			collect(response.Replies)
+		}
+	}
+	collect(responses)
+
+	mentions, err := s.mentionService.FindMentions(ctx, entity.MentionSourceComment, bodies)
+	if err != nil {
+		return err
+	}
+	for _, response := range all {
+		response.Mentions = mentions[response.ID]
+		if response.Mentions == nil {
+			response.Mentions = []*dto.MentionResponse{}
+		}
+	}
+	return nil
+}
+
+// attachCommentTreeMentions はコメントツリーの各ノードにメンションの位置を一括で設定します
+func (s *CommentService) attachCommentTreeMentions(ctx context.Context, nodes []*dto.CommentTreeNodeResponse) error {
+	bodies := make(map[int64]string)
+	var all []*dto.CommentTreeNodeResponse
+	var collect func(nodes []*dto.CommentTreeNodeResponse)
+	collect = func(nodes []*dto.CommentTreeNodeResponse) {
+		for _, node := range nodes {
+			all = append(all, node)
+			if !node.IsDeleted {
+				bodies[node.ID] = node.Body
+			}
+			collect(node.Replies)
+		}
+	}
+	collect(nodes)
+
+	mentions, err := s.mentionService.FindMentions(ctx, entity.MentionSourceComment, bodies)
+	if err != nil {
+		return err
+	}
+	for _, node := range all {
+		node.Mentions = mentions[node.ID]
+		if node.Mentions == nil {
+			node.Mentions = []*dto.MentionResponse{}
+		}
+	}
+	return nil
+}
+
+// markTruncatedReplies は返信が打ち切られたノードに続きを取得するためのカーソルを設定します
+func markTruncatedReplies(node *dto.CommentTreeNodeResponse) {
+	if int64(len(node.Replies)) < node.ReplyCount {
+		node.HasMoreReplies = true
+		if n := len(node.Replies); n > 0 {
+			last := node.Replies[n-1]
+			node.RepliesCursor = encodeCommentCursor(last.CreatedAt, last.ID)
+		}
+	}
+
+	for _, reply := range node.Replies {
+		markTruncatedReplies(reply)
+	}
+}
+
+// commentCursorLess はコメントの(作成日時, ID)の順序を比較します
+func commentCursorLess(a, b *dto.CommentTreeNodeResponse) bool {
+	if a.CreatedAt.Equal(b.CreatedAt) {
+		return a.ID < b.ID
+	}
+	return a.CreatedAt.Before(b.CreatedAt)
+}
+
+// encodeCommentCursor はコメントの位置を不透明なカーソル文字列に変換します
+func encodeCommentCursor(createdAt time.Time, id int64) string {
+	raw := fmt.Sprintf("%d:%d", createdAt.UnixNano(), id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCommentCursor はカーソル文字列をコメントの位置に変換します
+func decodeCommentCursor(cursor string) (*entity.CommentCursor, error) {
+	invalid := domainErrors.NewValidationError("無効なカーソルです")
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, invalid
+	}
+
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return nil, invalid
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, invalid
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, invalid
+	}
+
+	return &entity.CommentCursor{CreatedAt: time.Unix(0, nanos), ID: id}, nil
+}
+
 func (s *CommentService) GetCommentsByUser(ctx context.Context, userID int64, limit, offset int) (*dto.CommentListResponse, error) {
 	// ユーザーの存在確認
 	user, err := s.userRepo.Find(ctx, userID)
@@ -258,10 +612,14 @@ func (s *CommentService) GetCommentsByUser(ctx context.Context, userID int64, li
 		responses = append(responses, response)
 	}
 
+	if err := s.attachCommentMentions(ctx, responses); err != nil {
+		return nil, err
+	}
 	return s.toCommentListResponse(responses, totalCount, limit), nil
 }
 
-func (s *CommentService) CreateComment(ctx context.Context, userID int64, req *dto.CreateCommentRequest) (*dto.CommentResponse, error) {
+// コンテンツのコメント設定に従い、受け付けない場合はPermissionErrorを返し、承認制の場合は承認待ちとして保存します
+func (s *CommentService) CreateComment(ctx context.Context, userID int64, userRole string, req *dto.CreateCommentRequest) (*dto.CommentResponse, error) {
 	// コンテンツの存在確認
 	content, err := s.contentRepo.Find(ctx, req.ContentID)
 	if err != nil {
@@ -271,6 +629,11 @@ func (s *CommentService) CreateComment(ctx context.Context, userID int64, req *d
 		return nil, domainErrors.NewNotFoundError("Content", req.ContentID)
 	}
 
+	// コメント設定の確認
+	if err := s.ensureCanComment(ctx, content, userID, userRole); err != nil {
+		return nil, err
+	}
+
 	// 親コメントの存在確認（指定されている場合）
 	if req.ParentID != nil {
 		parentComment, err := s.commentRepo.Find(ctx, *req.ParentID)
@@ -281,14 +644,25 @@ func (s *CommentService) CreateComment(ctx context.Context, userID int64, req *d
 			return nil, domainErrors.NewNotFoundError("Comment", *req.ParentID)
 		}
 
+		if parentComment.IsDeleted() {
+			return nil, domainErrors.NewValidationError("削除されたコメントには返信できません")
+		}
+		if parentComment.IsPending {
+			return nil, domainErrors.NewValidationError("承認待ちのコメントには返信できません")
+		}
+
 		// 親コメントが同じコンテンツに属していることを確認
 		if parentComment.ContentID != req.ContentID {
 			return nil, domainErrors.NewValidationError("親コメントは同じコンテンツに属している必要があります")
 		}
 
-		// ネストレベルのチェック（返信の返信は許可しない）
-		if parentComment.ParentID != nil {
-			return nil, domainErrors.NewValidationError("コメントの入れ子は1レベルまでです")
+		// ネストレベルのチェック
+		parentDepth, err := s.commentRepo.FindDepth(ctx, parentComment.ID)
+		if err != nil {
+			return nil, fmt.Errorf("parent comment depth lookup failed: %w", err)
+		}
+		if parentDepth+1 > entity.CommentMaxDepth {
+			return nil, domainErrors.NewValidationError(fmt.Sprintf("コメントの入れ子は%dレベルまでです", entity.CommentMaxDepth))
 		}
 	}
 
@@ -297,19 +671,47 @@ func (s *CommentService) CreateComment(ctx context.Context, userID int64, req *d
 	if err != nil {
 		return nil, err
 	}
+	comment.IsPending = content.RequiresCommentApproval(userID, userRole)
 
-	// コメントの保存
-	if err := s.commentRepo.Create(ctx, comment); err != nil {
+	// コメントの保存とCommentCreatedイベントの記録
+	err = s.eventService.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.commentRepo.Create(ctx, comment); err != nil {
+			return err
+		}
+		return s.eventService.Emit(ctx, &entity.CommentCreated{
+			CommentID: comment.ID,
+			ContentID: comment.ContentID,
+			UserID:    comment.UserID,
+			ParentID:  comment.ParentID,
+			IsPending: comment.IsPending,
+		})
+	})
+	if err != nil {
 		return nil, fmt.Errorf("comment creation failed: %w", err)
 	}
 
+	// メンションの保存とコメントの通知（承認待ちの場合は承認時に行う）
+	mentions := []*dto.MentionResponse{}
+	if !comment.IsPending {
+		mentions, err = s.mentionService.SyncMentions(ctx, entity.MentionSourceComment, comment.ID, comment.ContentID, userID, comment.Body, true)
+		if err != nil {
+			return nil, err
+		}
+		s.notificationService.NotifyCommented(ctx, comment)
+	}
+
 	// ユーザー情報の取得
 	user, err := s.userRepo.Find(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("user lookup failed: %w", err)
 	}
 
-	return s.toCommentResponseWithUser(comment, user), nil
+	response := s.toCommentResponseWithUser(comment, user)
+	response.Mentions = mentions
+	if !comment.IsPending {
+		s.streamService.PublishComment(ctx, response)
+	}
+	return response, nil
 }
 
 func (s *CommentService) UpdateComment(ctx context.Context, id int64, userID int64, userRole string, req *dto.UpdateCommentRequest) (*dto.CommentResponse, error) {
@@ -322,6 +724,10 @@ func (s *CommentService) UpdateComment(ctx context.Context, id int64, userID int
 		return nil, domainErrors.NewNotFoundError("Comment", id)
 	}
 
+	if comment.IsDeleted() {
+		return nil, domainErrors.NewNotFoundError("Comment", id)
+	}
+
 	// 編集権限のチェック
 	if !comment.CanEdit(userID, userRole) {
 		return nil, domainErrors.NewValidationError("このコメントを編集する権限がありません")
@@ -333,17 +739,305 @@ func (s *CommentService) UpdateComment(ctx context.Context, id int64, userID int
 	}
 
 	// コメントの更新
-	if err := s.commentRepo.Update(ctx, comment); err != nil {
+	if err := s.commentRepo.Update(ctx, comment, userID); err != nil {
 		return nil, fmt.Errorf("comment update failed: %w", err)
 	}
 
+	// メンションの保存（編集で追加されたユーザーにのみ通知される、承認待ちの場合は承認時に保存する）
+	mentions := []*dto.MentionResponse{}
+	if !comment.IsPending {
+		mentions, err = s.mentionService.SyncMentions(ctx, entity.MentionSourceComment, comment.ID, comment.ContentID, userID, comment.Body, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// ユーザー情報の取得
 	user, err := s.userRepo.Find(ctx, comment.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("user lookup failed: %w", err)
 	}
 
-	return s.toCommentResponseWithUser(comment, user), nil
+	response := s.toCommentResponseWithUser(comment, user)
+	response.Mentions = mentions
+	if !comment.IsPending {
+		s.streamService.PublishCommentUpdated(ctx, response)
+	}
+	return response, nil
+}
+
+// PinComment はコメントをコンテンツの先頭にピン留めします（コンテンツの投稿者または管理者のみ、既存のピン留めは解除されます）
+func (s *CommentService) PinComment(ctx context.Context, contentID, commentID, userID int64, userRole string) (*dto.CommentResponse, error) {
+	if err := s.ensureCanPin(ctx, contentID, userID, userRole); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.commentRepo.Find(ctx, commentID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("comment lookup failed: %w", err)
+	}
+	if comment.ContentID != contentID || comment.IsDeleted() {
+		return nil, domainErrors.NewNotFoundError("Comment", commentID)
+	}
+	if comment.IsPending {
+		return nil, domainErrors.NewValidationError("承認待ちのコメントはピン留めできません")
+	}
+	if !comment.CanPin() {
+		return nil, domainErrors.NewValidationError("ピン留めできるのは返信ではないコメントのみです")
+	}
+
+	if err := s.commentRepo.SetPinned(ctx, contentID, &commentID); err != nil {
+		if domainErrors.IsNotFoundError(err) || domainErrors.IsConflictError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("comment pin failed: %w", err)
+	}
+	comment.IsPinned = true
+
+	user, err := s.userRepo.Find(ctx, comment.UserID)
+	if err != nil {
+		user = nil // ユーザー情報取得エラーは無視
+	}
+
+	response := s.toCommentResponseWithUser(comment, user)
+	if err := s.attachCommentMentions(ctx, []*dto.CommentResponse{response}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// UnpinComment はコンテンツのピン留めを解除します（コンテンツの投稿者または管理者のみ）
+func (s *CommentService) UnpinComment(ctx context.Context, contentID, userID int64, userRole string) error {
+	if err := s.ensureCanPin(ctx, contentID, userID, userRole); err != nil {
+		return err
+	}
+
+	if err := s.commentRepo.SetPinned(ctx, contentID, nil); err != nil {
+		return fmt.Errorf("comment unpin failed: %w", err)
+	}
+	return nil
+}
+
+// ensureCanPin はユーザーがコンテンツのコメントをピン留めできるか確認します
+func (s *CommentService) ensureCanPin(ctx context.Context, contentID, userID int64, userRole string) error {
+	content, err := s.contentRepo.Find(ctx, contentID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("content lookup failed: %w", err)
+	}
+
+	if !content.CanEdit(userID, userRole) {
+		return domainErrors.NewPermissionError("コメントをピン留めできるのはコンテンツの投稿者のみです")
+	}
+	return nil
+}
+
+// GetPendingComments はコンテンツの承認待ちのコメントを古い順に取得します（コンテンツの投稿者と管理者のみ）
+func (s *CommentService) GetPendingComments(ctx context.Context, contentID, userID int64, userRole string, limit, offset int) (*dto.CommentListResponse, error) {
+	if _, err := s.findModeratedContent(ctx, contentID, userID, userRole); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	comments, err := s.commentRepo.FindPending(ctx, contentID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("pending comments lookup failed: %w", err)
+	}
+
+	totalCount, err := s.commentRepo.CountPending(ctx, contentID)
+	if err != nil {
+		return nil, fmt.Errorf("pending comments count failed: %w", err)
+	}
+
+	responses := []*dto.CommentResponse{}
+	for _, comment := range comments {
+		user, err := s.userRepo.Find(ctx, comment.UserID)
+		if err != nil {
+			user = nil
+		}
+		responses = append(responses, s.toCommentResponseWithUser(comment, user))
+	}
+
+	if err := s.attachCommentMentions(ctx, responses); err != nil {
+		return nil, err
+	}
+	return s.toCommentListResponse(responses, totalCount, limit), nil
+}
+
+// ApproveComment は承認待ちのコメントを承認して公開します（コンテンツの投稿者と管理者のみ）
+// 承認時に本文のメンションを保存し、メンションされたユーザーに通知します
+func (s *CommentService) ApproveComment(ctx context.Context, id, userID int64, userRole string) (*dto.CommentResponse, error) {
+	comment, err := s.findPendingComment(ctx, id, userID, userRole)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.eventService.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.commentRepo.Approve(ctx, id); err != nil {
+			return err
+		}
+		return s.eventService.Emit(ctx, &entity.CommentApproved{
+			CommentID:  comment.ID,
+			ContentID:  comment.ContentID,
+			UserID:     comment.UserID,
+			ApproverID: userID,
+		})
+	})
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("comment approval failed: %w", err)
+	}
+	comment.IsPending = false
+
+	mentions, err := s.mentionService.SyncMentions(ctx, entity.MentionSourceComment, comment.ID, comment.ContentID, comment.UserID, comment.Body, true)
+	if err != nil {
+		return nil, err
+	}
+	s.notificationService.NotifyCommented(ctx, comment)
+
+	user, err := s.userRepo.Find(ctx, comment.UserID)
+	if err != nil {
+		user = nil // ユーザー情報取得エラーは無視
+	}
+
+	response := s.toCommentResponseWithUser(comment, user)
+	response.Mentions = mentions
+	s.streamService.PublishComment(ctx, response)
+	return response, nil
+}
+
+// RejectComment は承認待ちのコメントを却下して削除します（コンテンツの投稿者と管理者のみ）
+func (s *CommentService) RejectComment(ctx context.Context, id, userID int64, userRole string) error {
+	if _, err := s.findPendingComment(ctx, id, userID, userRole); err != nil {
+		return err
+	}
+
+	if err := s.commentRepo.Delete(ctx, id); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("comment rejection failed: %w", err)
+	}
+	return nil
+}
+
+// findPendingComment は承認待ちのコメントを取得し、ユーザーが承認・却下できるか確認します
+func (s *CommentService) findPendingComment(ctx context.Context, id, userID int64, userRole string) (*entity.Comment, error) {
+	comment, err := s.commentRepo.Find(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("comment lookup failed: %w", err)
+	}
+
+	if _, err := s.findModeratedContent(ctx, comment.ContentID, userID, userRole); err != nil {
+		return nil, err
+	}
+	if !comment.IsPending {
+		return nil, domainErrors.NewValidationError("このコメントは承認待ちではありません")
+	}
+	return comment, nil
+}
+
+// findModeratedContent はコンテンツを取得し、ユーザーがコメントを承認できるか確認します
+func (s *CommentService) findModeratedContent(ctx context.Context, contentID, userID int64, userRole string) (*entity.Content, error) {
+	content, err := s.contentRepo.Find(ctx, contentID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("content lookup failed: %w", err)
+	}
+
+	if !content.CanEdit(userID, userRole) {
+		return nil, domainErrors.NewPermissionError("コメントを承認できるのはコンテンツの投稿者のみです")
+	}
+	return content, nil
+}
+
+// ensureCanComment はコンテンツのコメント設定に従いユーザーがコメントできるか確認します
+func (s *CommentService) ensureCanComment(ctx context.Context, content *entity.Content, userID int64, userRole string) error {
+	return checkCommentPolicy(ctx, s.followRepo, content, userID, userRole)
+}
+
+// checkCommentPolicy はコンテンツのコメント設定（締め切り・フォロワー限定）を確認します（注釈にも同じ設定を適用します）
+// コンテンツの投稿者と管理者は設定にかかわらず投稿できます
+func checkCommentPolicy(ctx context.Context, followRepo repository.FollowRepository, content *entity.Content, userID int64, userRole string) error {
+	if content.CanEdit(userID, userRole) {
+		return nil
+	}
+
+	switch content.CommentPolicy {
+	case entity.CommentPolicyClosed:
+		return domainErrors.NewPermissionError("このコンテンツはコメントを受け付けていません")
+	case entity.CommentPolicyFollowersOnly:
+		following, err := followRepo.Exists(ctx, userID, content.AuthorID)
+		if err != nil {
+			return fmt.Errorf("follow lookup failed: %w", err)
+		}
+		if !following {
+			return domainErrors.NewPermissionError("このコンテンツには投稿者のフォロワーのみコメントできます")
+		}
+	}
+	return nil
+}
+
+// ensureCanSee は承認待ちのコメントを閲覧者が表示できるか確認します（表示できない場合はNotFoundError）
+func (s *CommentService) ensureCanSee(ctx context.Context, comment *entity.Comment, userID int64, userRole string) error {
+	if !comment.IsPending {
+		return nil
+	}
+
+	content, err := s.contentRepo.Find(ctx, comment.ContentID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("content lookup failed: %w", err)
+	}
+
+	if !comment.CanSee(newCommentViewer(content, userID, userRole)) {
+		return domainErrors.NewNotFoundError("Comment", comment.ID)
+	}
+	return nil
+}
+
+// newCommentViewer はコンテンツに対する閲覧者を作成します（未ログインの場合はuserIDを0とする）
+func newCommentViewer(content *entity.Content, userID int64, userRole string) entity.CommentViewer {
+	return entity.CommentViewer{
+		UserID:      userID,
+		CanModerate: userID != 0 && content.CanEdit(userID, userRole),
+	}
+}
+
+// parseCommentSort は並び順の文字列を検証します（空の場合はdefaultSort）
+func parseCommentSort(sortBy string, defaultSort entity.CommentSort) (entity.CommentSort, error) {
+	if sortBy == "" {
+		return defaultSort, nil
+	}
+
+	commentSort := entity.CommentSort(sortBy)
+	if !entity.IsValidCommentSort(commentSort) {
+		return "", domainErrors.NewValidationError("sortは newest, oldest, top, controversial のいずれかを指定してください")
+	}
+	return commentSort, nil
 }
 
 func (s *CommentService) DeleteComment(ctx context.Context, id int64, userID int64, userRole string) error {
@@ -356,15 +1050,79 @@ func (s *CommentService) DeleteComment(ctx context.Context, id int64, userID int
 		return domainErrors.NewNotFoundError("Comment", id)
 	}
 
+	if comment.IsDeleted() {
+		return domainErrors.NewNotFoundError("Comment", id)
+	}
+
 	// 削除権限のチェック
 	if !comment.CanDelete(userID, userRole) {
 		return domainErrors.NewValidationError("このコメントを削除する権限がありません")
 	}
 
-	// コメントの削除
-	if err := s.commentRepo.Delete(ctx, id); err != nil {
+	// コメントの削除（返信がある場合は墓標として残し、スレッドの構造を保つ）
+	tombstoned, err := s.commentRepo.DeleteOrTombstone(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
 		return fmt.Errorf("comment deletion failed: %w", err)
 	}
 
+	if !comment.IsPending {
+		s.streamService.PublishCommentDeleted(ctx, comment.ContentID, comment.ID, tombstoned)
+	}
 	return nil
 }
+
+// GetCommentRevisions はコメントの編集履歴を取得します
+// 投稿者と管理者は常に閲覧でき、それ以外のユーザーは履歴が公開設定の場合のみ閲覧できます
+func (s *CommentService) GetCommentRevisions(ctx context.Context, id int64, userID int64, userRole string) (*dto.CommentRevisionListResponse, error) {
+	comment, err := s.commentRepo.Find(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("comment lookup failed: %w", err)
+	}
+	if comment.IsDeleted() {
+		return nil, domainErrors.NewNotFoundError("Comment", id)
+	}
+	if err := s.ensureCanSee(ctx, comment, userID, userRole); err != nil {
+		return nil, err
+	}
+
+	if !comment.CanViewRevisions(userID, userRole, s.publicRevisions) {
+		return nil, domainErrors.NewPermissionError("このコメントの編集履歴を閲覧する権限がありません")
+	}
+
+	revisions, err := s.commentRepo.FindRevisions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("comment revisions lookup failed: %w", err)
+	}
+
+	response := &dto.CommentRevisionListResponse{
+		CommentID:   comment.ID,
+		CurrentBody: comment.Body,
+		EditedAt:    comment.EditedAt,
+		Revisions:   make([]*dto.CommentRevisionResponse, len(revisions)),
+	}
+	for i, revision := range revisions {
+		response.Revisions[i] = &dto.CommentRevisionResponse{
+			ID:        revision.ID,
+			Body:      revision.Body,
+			EditorID:  revision.EditorID,
+			CreatedAt: revision.CreatedAt,
+		}
+	}
+
+	return response, nil
+}
+
+// PurgeOrphanTombstones は返信がなくなった墓標を削除します（定期ジョブ用）
+func (s *CommentService) PurgeOrphanTombstones(ctx context.Context) (int64, error) {
+	purged, err := s.commentRepo.PurgeOrphanTombstones(ctx)
+	if err != nil {
+		return purged, fmt.Errorf("tombstone purge failed: %w", err)
+	}
+	return purged, nil
+}
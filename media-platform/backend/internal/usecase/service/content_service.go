@@ -14,38 +14,72 @@ import (
 )
 
 type ContentService struct {
-	contentRepo  repository.ContentRepository
-	categoryRepo repository.CategoryRepository
-	userRepo     repository.UserRepository
+	contentRepo       repository.ContentRepository
+	categoryRepo      repository.CategoryRepository
+	userRepo          repository.UserRepository
+	workRepo          repository.WorkRepository
+	contentTypeRepo   repository.ContentTypeRepository
+	scoreAxisRepo     repository.ScoreAxisRepository
+	mentionService    *MentionService
+	annotationService *AnnotationService
+	eventService      *EventService
 }
 
 func NewContentService(
 	contentRepo repository.ContentRepository,
 	categoryRepo repository.CategoryRepository,
 	userRepo repository.UserRepository,
+	workRepo repository.WorkRepository,
+	contentTypeRepo repository.ContentTypeRepository,
+	scoreAxisRepo repository.ScoreAxisRepository,
+	mentionService *MentionService,
+	annotationService *AnnotationService,
+	eventService *EventService,
 ) *ContentService {
 	return &ContentService{
-		contentRepo:  contentRepo,
-		categoryRepo: categoryRepo,
-		userRepo:     userRepo,
+		contentRepo:       contentRepo,
+		categoryRepo:      categoryRepo,
+		userRepo:          userRepo,
+		workRepo:          workRepo,
+		contentTypeRepo:   contentTypeRepo,
+		scoreAxisRepo:     scoreAxisRepo,
+		mentionService:    mentionService,
+		annotationService: annotationService,
+		eventService:      eventService,
 	}
 }
 
 // Entity → DTO 変換（Service層の責務）
 func (s *ContentService) toContentResponse(content *entity.Content) *dto.ContentResponse {
 	return &dto.ContentResponse{
-		ID:          content.ID,
-		Title:       content.Title,
-		Body:        content.Body,
-		Type:        string(content.Type),
-		Genre:       content.Genre,
-		Status:      string(content.Status),
-		AuthorID:    content.AuthorID,
-		CategoryID:  content.CategoryID,
-		ViewCount:   content.ViewCount,
-		CreatedAt:   content.CreatedAt,
-		UpdatedAt:   content.UpdatedAt,
-		PublishedAt: content.PublishedAt,
+		ID:           content.ID,
+		Title:        content.Title,
+		Body:         content.Body,
+		Type:         string(content.Type),
+		Genre:        content.Genre,
+		Status:       string(content.Status),
+		AuthorID:     content.AuthorID,
+		CategoryID:   content.CategoryID,
+		ViewCount:    content.ViewCount,
+		LikeCount:    content.LikeCount,
+		CommentCount: content.CommentCount,
+		CreatedAt:    content.CreatedAt,
+		UpdatedAt:    content.UpdatedAt,
+		PublishedAt:  content.PublishedAt,
+
+		WorkID:              content.WorkID,
+		ReviewScore:         content.ReviewScore,
+		RecommendationLevel: string(content.RecommendationLevel),
+
+		ArtistName:  content.ArtistName,
+		ReleaseYear: content.ReleaseYear,
+		ImageURL:    content.ImageURL,
+		ExternalURL: content.ExternalURL,
+		Tags:        content.Tags,
+
+		CommentPolicy: string(content.CommentPolicy),
+
+		Metadata: content.Metadata,
 	}
 }
 
@@ -81,6 +115,12 @@ func (s *ContentService) GetContents(ctx context.Context, query *dto.ContentQuer
 	var contents []*entity.Content
 	var err error
 
+	// 種類・構造化項目による絞り込み（公開済みのみ、カテゴリも併せて絞り込める）
+	typeOnly := query.Type != nil && *query.Type != "" && query.AuthorID == nil && (query.SearchQuery == nil || *query.SearchQuery == "")
+	if len(query.Metadata) > 0 || typeOnly {
+		return s.getContentsByTypeAndMetadata(ctx, query)
+	}
+
 	// statusのみで published の場合
 	if query.Status != nil && *query.Status == "published" && query.AuthorID == nil {
 		log.Printf("🔍 公開コンテンツ一覧取得: FindPublished")
@@ -154,7 +194,17 @@ func (s *ContentService) GetContentByID(ctx context.Context, id int64) (*dto.Con
 		// ログ出力のみ（ビジネスロジックに影響させない）
 	}
 
-	return s.toContentResponse(content), nil
+	response := s.toContentResponse(content)
+	if err := s.attachAxisScores(ctx, content, response); err != nil {
+		return nil, err
+	}
+
+	mentions, err := s.mentionService.FindMentions(ctx, entity.MentionSourceContent, map[int64]string{content.ID: content.Body})
+	if err != nil {
+		return nil, err
+	}
+	response.Mentions = mentions[content.ID]
+	return response, nil
 }
 
 func (s *ContentService) GetPublishedContents(ctx context.Context, limit, offset int) ([]*dto.ContentResponse, error) {
@@ -284,16 +334,32 @@ func (s *ContentService) CreateContent(ctx context.Context, authorID int64, req
 	}
 	log.Printf("✅ 著者確認完了: %s", author.Username)
 
+	// コンテンツタイプの確認（新規投稿は有効な種類のみ）
+	contentType, err := s.findContentType(ctx, entity.ContentType(req.Type), true)
+	if err != nil {
+		log.Printf("❌ コンテンツタイプ確認エラー: %v", err)
+		return nil, err
+	}
+
+	// カテゴリ未指定の場合はコンテンツタイプの既定カテゴリを使用
+	categoryID := req.CategoryID
+	if categoryID == 0 && contentType.DefaultCategoryID != nil {
+		categoryID = *contentType.DefaultCategoryID
+	}
+	if categoryID == 0 {
+		return nil, domainErrors.NewValidationError("カテゴリIDは必須です")
+	}
+
 	// カテゴリの存在確認
-	log.Printf("🔍 カテゴリ確認中: categoryID=%d", req.CategoryID)
-	category, err := s.categoryRepo.FindByID(ctx, req.CategoryID)
+	log.Printf("🔍 カテゴリ確認中: categoryID=%d", categoryID)
+	category, err := s.categoryRepo.FindByID(ctx, categoryID)
 	if err != nil {
 		log.Printf("❌ カテゴリ検索エラー: %v", err)
 		return nil, fmt.Errorf("category lookup failed: %w", err)
 	}
 	if category == nil {
-		log.Printf("❌ カテゴリが見つかりません: categoryID=%d", req.CategoryID)
-		return nil, domainErrors.NewNotFoundError("Category", req.CategoryID)
+		log.Printf("❌ カテゴリが見つかりません: categoryID=%d", categoryID)
+		return nil, domainErrors.NewNotFoundError("Category", categoryID)
 	}
 	log.Printf("✅ カテゴリ確認完了: %s", category.Name)
 
@@ -317,8 +383,42 @@ func (s *ContentService) CreateContent(ctx context.Context, authorID int64, req
 		Genre:      req.Genre,
 		Status:     status, // ✅ リクエストのstatusを使用
 		AuthorID:   authorID,
-		CategoryID: req.CategoryID,
+		CategoryID: categoryID,
 		ViewCount:  0,
+
+		ReviewScore:         req.ReviewScore,
+		RecommendationLevel: entity.RecommendationLevel(req.RecommendationLevel),
+
+		ArtistName:  req.ArtistName,
+		ReleaseYear: req.ReleaseYear,
+		ImageURL:    req.ImageURL,
+		ExternalURL: req.ExternalURL,
+		Tags:        req.Tags,
+
+		CommentPolicy: entity.CommentPolicyOpen,
+	}
+	if req.CommentPolicy != "" {
+		if err := content.SetCommentPolicy(entity.CommentPolicy(req.CommentPolicy)); err != nil {
+			return nil, domainErrors.NewValidationError(err.Error())
+		}
+	}
+
+	// 種類ごとに許可された追加フィールドのみ受け付ける
+	if err := contentType.ValidateContent(content); err != nil {
+		return nil, err
+	}
+
+	// 種類別の構造化項目（種類が参照するスキーマで検証・正規化）
+	if err := content.SetMetadata(contentType, req.Metadata); err != nil {
+		return nil, err
+	}
+
+	// レビュー対象の作品の紐付け
+	if req.WorkID != nil {
+		if err := s.ensureWorkExists(ctx, *req.WorkID); err != nil {
+			return nil, err
+		}
+		content.WorkID = req.WorkID
 	}
 
 	// ✅ publishedの場合、published_atを設定（これを追加！）
@@ -335,17 +435,54 @@ func (s *ContentService) CreateContent(ctx context.Context, authorID int64, req
 		log.Printf("❌ バリデーションエラー: %v", err)
 		return nil, domainErrors.NewValidationError(err.Error())
 	}
+	axisScores, err := s.resolveAxisScores(ctx, content.Type, req.AxisScores)
+	if err != nil {
+		log.Printf("❌ 軸別スコアのバリデーションエラー: %v", err)
+		return nil, err
+	}
 	log.Printf("✅ バリデーション完了")
 
 	// コンテンツの保存
 	log.Printf("💾 DB保存開始...")
-	if err := s.contentRepo.Create(ctx, content); err != nil {
+	// 公開する場合は保存とContentPublishedイベントを同じトランザクションで記録する
+	err = s.eventService.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.contentRepo.Create(ctx, content); err != nil {
+			return err
+		}
+		if content.Status != entity.ContentStatusPublished {
+			return nil
+		}
+		return s.eventService.Emit(ctx, &entity.ContentPublished{
+			ContentID:   content.ID,
+			AuthorID:    content.AuthorID,
+			PublishedAt: *content.PublishedAt,
+		})
+	})
+	if err != nil {
 		log.Printf("❌ DB保存エラー: %v", err)
 		return nil, fmt.Errorf("content creation failed: %w", err)
 	}
 	log.Printf("✅ DB保存完了: contentID=%d", content.ID)
 
+	if len(axisScores) > 0 {
+		if err := s.scoreAxisRepo.ReplaceContentScores(ctx, content.ID, axisScores); err != nil {
+			log.Printf("❌ 軸別スコア保存エラー: %v", err)
+			return nil, fmt.Errorf("axis scores save failed: %w", err)
+		}
+	}
+
+	// メンションの保存（公開した場合のみメンションされたユーザーに通知される）
+	mentions, err := s.mentionService.SyncMentions(ctx, entity.MentionSourceContent, content.ID, content.ID, authorID, content.Body, content.Status == entity.ContentStatusPublished)
+	if err != nil {
+		log.Printf("❌ メンション保存エラー: %v", err)
+		return nil, err
+	}
+
 	response := s.toContentResponse(content)
+	if err := s.attachAxisScores(ctx, content, response); err != nil {
+		return nil, err
+	}
+	response.Mentions = mentions
 	log.Printf("✅ CreateContent完了: %+v", response)
 	return response, nil
 }
@@ -365,6 +502,9 @@ func (s *ContentService) UpdateContent(ctx context.Context, id int64, userID int
 		return nil, domainErrors.NewValidationError("このコンテンツを編集する権限がありません")
 	}
 
+	// 注釈の位置を付け直すため編集前の本文を保持する
+	oldBody := content.Body
+
 	// フィールドの更新
 	if req.Title != "" {
 		if err := content.SetTitle(req.Title); err != nil {
@@ -376,10 +516,12 @@ func (s *ContentService) UpdateContent(ctx context.Context, id int64, userID int
 			return nil, domainErrors.NewValidationError(err.Error())
 		}
 	}
-	if req.Type != "" {
+	typeChanged := false
+	if req.Type != "" && entity.ContentType(req.Type) != content.Type {
 		if err := content.SetType(entity.ContentType(req.Type)); err != nil {
 			return nil, domainErrors.NewValidationError(err.Error())
 		}
+		typeChanged = true
 	}
 	if req.Genre != "" {
 		content.SetGenre(req.Genre)
@@ -399,17 +541,100 @@ func (s *ContentService) UpdateContent(ctx context.Context, id int64, userID int
 		}
 	}
 
+	if req.WorkID != nil {
+		var workID *int64
+		if *req.WorkID != 0 {
+			if err := s.ensureWorkExists(ctx, *req.WorkID); err != nil {
+				return nil, err
+			}
+			workID = req.WorkID
+		}
+		if err := content.SetWorkID(workID); err != nil {
+			return nil, domainErrors.NewValidationError(err.Error())
+		}
+	}
+	if req.ReviewScore != nil {
+		if err := content.SetReviewScore(req.ReviewScore); err != nil {
+			return nil, domainErrors.NewValidationError(err.Error())
+		}
+	}
+	if req.RecommendationLevel != nil {
+		if err := content.SetRecommendationLevel(entity.RecommendationLevel(*req.RecommendationLevel)); err != nil {
+			return nil, domainErrors.NewValidationError(err.Error())
+		}
+	}
+	if req.CommentPolicy != nil {
+		if err := content.SetCommentPolicy(entity.CommentPolicy(*req.CommentPolicy)); err != nil {
+			return nil, domainErrors.NewValidationError(err.Error())
+		}
+	}
+	s.applyExtraFields(content, req)
+
+	// 種類の定義を取得（種類を変更する場合は有効な種類のみ）
+	contentType, err := s.findContentType(ctx, content.Type, typeChanged)
+	if err != nil {
+		return nil, err
+	}
+
+	// 構造化項目は種類ごとのスキーマに従うため、種類を変更して項目を指定しない場合はクリアする
+	if req.Metadata != nil {
+		if err := content.SetMetadata(contentType, req.Metadata); err != nil {
+			return nil, err
+		}
+	} else if typeChanged {
+		if err := content.SetMetadata(contentType, nil); err != nil {
+			return nil, err
+		}
+	}
+
 	// ドメインルールのバリデーション
 	if err := content.Validate(); err != nil {
 		return nil, domainErrors.NewValidationError(err.Error())
 	}
 
+	// 種類ごとに許可された追加フィールド・構造化項目か確認
+	if err := contentType.ValidateContent(content); err != nil {
+		return nil, err
+	}
+
+	// 軸別スコアは種類ごとの評価軸に従うため、種類を変更して指定しない場合はクリアする
+	replaceAxisScores := req.AxisScores != nil || typeChanged
+	axisScores, err := s.resolveAxisScores(ctx, content.Type, req.AxisScores)
+	if err != nil {
+		return nil, err
+	}
+
 	// コンテンツの更新
 	if err := s.contentRepo.Update(ctx, content); err != nil {
 		return nil, fmt.Errorf("content update failed: %w", err)
 	}
 
-	return s.toContentResponse(content), nil
+	if replaceAxisScores {
+		if err := s.scoreAxisRepo.ReplaceContentScores(ctx, content.ID, axisScores); err != nil {
+			if domainErrors.IsValidationError(err) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("axis scores save failed: %w", err)
+		}
+	}
+
+	// メンションの保存（公開中の場合は編集で追加されたユーザーにのみ通知される、下書きは公開時に通知する）
+	mentions, err := s.mentionService.SyncMentions(ctx, entity.MentionSourceContent, content.ID, content.ID, userID, content.Body, content.Status == entity.ContentStatusPublished)
+	if err != nil {
+		return nil, err
+	}
+
+	// 注釈の段落の位置を編集後の本文に合わせる
+	if err := s.annotationService.Reanchor(ctx, content.ID, oldBody, content.Body); err != nil {
+		return nil, err
+	}
+
+	response := s.toContentResponse(content)
+	if err := s.attachAxisScores(ctx, content, response); err != nil {
+		return nil, err
+	}
+	response.Mentions = mentions
+	return response, nil
 }
 
 func (s *ContentService) UpdateContentStatus(ctx context.Context, id int64, userID int64, userRole string, req *dto.UpdateContentStatusRequest) (*dto.ContentResponse, error) {
@@ -428,16 +653,41 @@ func (s *ContentService) UpdateContentStatus(ctx context.Context, id int64, user
 	}
 
 	// ステータスの更新
+	wasPublished := content.Status == entity.ContentStatusPublished
 	if err := content.SetStatus(entity.ContentStatus(req.Status)); err != nil {
 		return nil, domainErrors.NewValidationError(err.Error())
 	}
 
-	// コンテンツの更新
-	if err := s.contentRepo.Update(ctx, content); err != nil {
+	// コンテンツの更新（公開した場合はContentPublishedイベントを同じトランザクションで記録する）
+	err = s.eventService.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.contentRepo.Update(ctx, content); err != nil {
+			return err
+		}
+		if wasPublished || content.Status != entity.ContentStatusPublished {
+			return nil
+		}
+		return s.eventService.Emit(ctx, &entity.ContentPublished{
+			ContentID:   content.ID,
+			AuthorID:    content.AuthorID,
+			PublishedAt: *content.PublishedAt,
+		})
+	})
+	if err != nil {
 		return nil, fmt.Errorf("content status update failed: %w", err)
 	}
 
-	return s.toContentResponse(content), nil
+	response := s.toContentResponse(content)
+
+	// 公開した場合は下書きの間に保存したメンションをここで通知する
+	if !wasPublished && content.Status == entity.ContentStatusPublished {
+		mentions, err := s.mentionService.SyncMentions(ctx, entity.MentionSourceContent, content.ID, content.ID, content.AuthorID, content.Body, true)
+		if err != nil {
+			return nil, err
+		}
+		response.Mentions = mentions
+	}
+
+	return response, nil
 }
 
 func (s *ContentService) DeleteContent(ctx context.Context, id int64, userID int64, userRole string) error {
@@ -463,8 +713,182 @@ func (s *ContentService) DeleteContent(ctx context.Context, id int64, userID int
 	return nil
 }
 
+// ReconcileCounters はいいね数・コメント数のずれを実際の件数で補正します（定期ジョブ・管理者用）
+func (s *ContentService) ReconcileCounters(ctx context.Context) (*dto.ReconcileCountersResponse, error) {
+	fixed, err := s.contentRepo.ReconcileCounters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("counter reconciliation failed: %w", err)
+	}
+
+	if fixed > 0 {
+		log.Printf("🔧 カウンターのずれを補正しました: %d件", fixed)
+	}
+
+	return &dto.ReconcileCountersResponse{FixedContents: fixed}, nil
+}
+
 // ========== ヘルパーメソッド ==========
 
+// getContentsByTypeAndMetadata は種類と構造化項目で公開済みのコンテンツを絞り込みます
+// カテゴリは併せて絞り込み、公開済み以外のステータス・著者・キーワードとの組み合わせはエラーにします
+func (s *ContentService) getContentsByTypeAndMetadata(ctx context.Context, query *dto.ContentQuery) ([]*dto.ContentResponse, int, error) {
+	if query.Type == nil || *query.Type == "" {
+		return nil, 0, domainErrors.NewValidationError("構造化項目で絞り込む場合はtypeを指定してください")
+	}
+	if query.Status != nil && *query.Status != "" && *query.Status != string(entity.ContentStatusPublished) {
+		return nil, 0, domainErrors.NewValidationError("typeで絞り込む場合は公開済みのコンテンツのみ取得できます")
+	}
+	if query.AuthorID != nil || (query.SearchQuery != nil && *query.SearchQuery != "") {
+		return nil, 0, domainErrors.NewValidationError("構造化項目による絞り込みは著者・キーワードと併用できません")
+	}
+	contentType := entity.ContentType(*query.Type)
+
+	filter := make(map[string]interface{}, len(query.Metadata))
+	if len(query.Metadata) > 0 {
+		definition, err := s.findContentType(ctx, contentType, false)
+		if err != nil {
+			return nil, 0, err
+		}
+		schema, ok := definition.MetadataSchema()
+		if !ok {
+			return nil, 0, domainErrors.NewValidationError(fmt.Sprintf("コンテンツタイプ「%s」には構造化項目がありません", contentType))
+		}
+		for key, raw := range query.Metadata {
+			field, ok := schema.Field(key)
+			if !ok {
+				return nil, 0, domainErrors.NewValidationError(fmt.Sprintf("コンテンツタイプ「%s」では%sで絞り込めません", contentType, key))
+			}
+			value, err := field.ParseFilterValue(raw)
+			if err != nil {
+				return nil, 0, err
+			}
+			filter[key] = value
+		}
+	}
+
+	contents, err := s.contentRepo.FindByTypeAndMetadata(ctx, contentType, query.CategoryID, filter, query.Limit, query.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("contents by metadata lookup failed: %w", err)
+	}
+
+	return s.toContentResponseList(contents), len(contents), nil
+}
+
+// resolveAxisScores は評価軸のキーで指定されたスコアを検証し、評価軸と対応付けます
+func (s *ContentService) resolveAxisScores(ctx context.Context, contentType entity.ContentType, scores map[string]float64) ([]*entity.AxisScore, error) {
+	if len(scores) == 0 {
+		return nil, nil
+	}
+
+	axes, err := s.scoreAxisRepo.FindByType(ctx, contentType)
+	if err != nil {
+		return nil, fmt.Errorf("score axes lookup failed: %w", err)
+	}
+
+	known := make(map[string]bool, len(axes))
+	result := make([]*entity.AxisScore, 0, len(scores))
+	for _, axis := range axes {
+		known[axis.Key] = true
+		score, ok := scores[axis.Key]
+		if !ok {
+			continue
+		}
+		if err := entity.ValidateAxisScore(axis.Label, score); err != nil {
+			return nil, err
+		}
+		result = append(result, &entity.AxisScore{AxisID: axis.ID, Key: axis.Key, Label: axis.Label, Score: score})
+	}
+
+	for key := range scores {
+		if !known[key] {
+			return nil, domainErrors.NewValidationError(fmt.Sprintf("コンテンツタイプ「%s」に評価軸%sはありません", contentType, key))
+		}
+	}
+
+	return result, nil
+}
+
+// attachAxisScores はレスポンスに軸別スコアとレーダーチャート用データを設定します
+func (s *ContentService) attachAxisScores(ctx context.Context, content *entity.Content, response *dto.ContentResponse) error {
+	axes, err := s.scoreAxisRepo.FindByType(ctx, content.Type)
+	if err != nil {
+		return fmt.Errorf("score axes lookup failed: %w", err)
+	}
+	if len(axes) == 0 {
+		return nil
+	}
+
+	scores, err := s.scoreAxisRepo.FindScoresByContent(ctx, content.ID)
+	if err != nil {
+		return fmt.Errorf("axis scores lookup failed: %w", err)
+	}
+
+	values := make(map[int64]float64, len(scores))
+	for _, score := range scores {
+		values[score.AxisID] = score.Score
+	}
+
+	response.AxisScores = toAxisScoreResponses(scores)
+	response.Radar = toRadarChartResponse(entity.NewRadarChart(axes, values))
+	return nil
+}
+
+// findContentType はコンテンツタイプ定義を取得します（requireActiveがtrueの場合は無効化された種類を拒否）
+func (s *ContentService) findContentType(ctx context.Context, name entity.ContentType, requireActive bool) (*entity.ContentTypeDefinition, error) {
+	contentType, err := s.contentTypeRepo.FindByName(ctx, name)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, domainErrors.NewValidationError("無効なコンテンツタイプです")
+		}
+		return nil, fmt.Errorf("content type lookup failed: %w", err)
+	}
+
+	if requireActive && !contentType.IsActive {
+		return nil, domainErrors.NewValidationError("このコンテンツタイプは現在選択できません")
+	}
+
+	return contentType, nil
+}
+
+// applyExtraFields は更新リクエストで指定された追加フィールドを反映します
+func (s *ContentService) applyExtraFields(content *entity.Content, req *dto.UpdateContentRequest) {
+	if req.ArtistName == nil && req.ReleaseYear == nil && req.ImageURL == nil && req.ExternalURL == nil && req.Tags == nil {
+		return
+	}
+
+	artistName, releaseYear, imageURL, externalURL, tags := content.ArtistName, content.ReleaseYear, content.ImageURL, content.ExternalURL, content.Tags
+	if req.ArtistName != nil {
+		artistName = *req.ArtistName
+	}
+	if req.ReleaseYear != nil {
+		releaseYear = req.ReleaseYear
+		if *req.ReleaseYear == 0 {
+			releaseYear = nil
+		}
+	}
+	if req.ImageURL != nil {
+		imageURL = *req.ImageURL
+	}
+	if req.ExternalURL != nil {
+		externalURL = *req.ExternalURL
+	}
+	if req.Tags != nil {
+		tags = *req.Tags
+	}
+	content.SetExtraFields(artistName, releaseYear, imageURL, externalURL, tags)
+}
+
+// ensureWorkExists は紐付け先の作品が存在するか確認します
+func (s *ContentService) ensureWorkExists(ctx context.Context, workID int64) error {
+	if _, err := s.workRepo.Find(ctx, workID); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return domainErrors.NewValidationError("指定された作品が存在しません")
+		}
+		return fmt.Errorf("work lookup failed: %w", err)
+	}
+	return nil
+}
+
 func (s *ContentService) searchByKeywordAndCategory(ctx context.Context, keyword string, categoryID int64, limit, offset int) ([]*entity.Content, error) {
 	// カテゴリ別取得後にキーワードでフィルタリング
 	contents, err := s.contentRepo.FindByCategory(ctx, categoryID, limit*2, offset)
@@ -501,3 +925,29 @@ func (s *ContentService) filterByKeyword(contents []*entity.Content, keyword str
 
 	return filtered
 }
+
+// toAxisScoreResponses は軸別スコアをレスポンスに変換します
+func toAxisScoreResponses(scores []*entity.AxisScore) []*dto.AxisScoreResponse {
+	responses := make([]*dto.AxisScoreResponse, len(scores))
+	for i, score := range scores {
+		responses[i] = &dto.AxisScoreResponse{
+			Key:   score.Key,
+			Label: score.Label,
+			Score: score.Score,
+		}
+	}
+	return responses
+}
+
+// toRadarChartResponse はレーダーチャート用データをレスポンスに変換します
+func toRadarChartResponse(chart *entity.RadarChart) *dto.RadarChartResponse {
+	if chart == nil {
+		return nil
+	}
+	return &dto.RadarChartResponse{
+		Keys:   chart.Keys,
+		Labels: chart.Labels,
+		Values: chart.Values,
+		Max:    chart.Max,
+	}
+}
@@ -0,0 +1,357 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+	"media-platform/internal/usecase/dto"
+)
+
+// ContentTypeService はコンテンツタイプの管理に関するユースケースを提供します
+type ContentTypeService struct {
+	contentTypeRepo repository.ContentTypeRepository
+	categoryRepo    repository.CategoryRepository
+	scoreAxisRepo   repository.ScoreAxisRepository
+}
+
+// NewContentTypeService は新しいContentTypeServiceのインスタンスを生成します
+func NewContentTypeService(
+	contentTypeRepo repository.ContentTypeRepository,
+	categoryRepo repository.CategoryRepository,
+	scoreAxisRepo repository.ScoreAxisRepository,
+) *ContentTypeService {
+	return &ContentTypeService{
+		contentTypeRepo: contentTypeRepo,
+		categoryRepo:    categoryRepo,
+		scoreAxisRepo:   scoreAxisRepo,
+	}
+}
+
+// GetContentTypes はコンテンツタイプの一覧を取得します
+func (s *ContentTypeService) GetContentTypes(ctx context.Context, includeInactive bool) ([]*dto.ContentTypeResponse, error) {
+	definitions, err := s.contentTypeRepo.FindAll(ctx, includeInactive)
+	if err != nil {
+		return nil, fmt.Errorf("content types lookup failed: %w", err)
+	}
+
+	responses := make([]*dto.ContentTypeResponse, len(definitions))
+	for i, definition := range definitions {
+		responses[i] = s.toContentTypeResponse(definition)
+	}
+	return responses, nil
+}
+
+// GetContentType は指定されたIDのコンテンツタイプを取得します
+func (s *ContentTypeService) GetContentType(ctx context.Context, id int64) (*dto.ContentTypeResponse, error) {
+	definition, err := s.contentTypeRepo.Find(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("content type lookup failed: %w", err)
+	}
+
+	return s.toContentTypeResponse(definition), nil
+}
+
+// CreateContentType はコンテンツタイプを作成します（管理者用）
+func (s *ContentTypeService) CreateContentType(ctx context.Context, req *dto.CreateContentTypeRequest) (*dto.ContentTypeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureCategoryExists(ctx, req.DefaultCategoryID); err != nil {
+		return nil, err
+	}
+
+	definition, err := entity.NewContentTypeDefinition(
+		entity.ContentType(req.Name),
+		req.DisplayName,
+		req.Description,
+		req.AllowedFields,
+		req.MetadataSchema,
+		req.DefaultCategoryID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	definition.SortOrder = req.SortOrder
+
+	if err := s.contentTypeRepo.Create(ctx, definition); err != nil {
+		if domainErrors.IsConflictError(err) || domainErrors.IsValidationError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("content type creation failed: %w", err)
+	}
+
+	return s.toContentTypeResponse(definition), nil
+}
+
+// UpdateContentType はコンテンツタイプを更新します（管理者用）
+func (s *ContentTypeService) UpdateContentType(ctx context.Context, id int64, req *dto.UpdateContentTypeRequest) (*dto.ContentTypeResponse, error) {
+	definition, err := s.contentTypeRepo.Find(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("content type lookup failed: %w", err)
+	}
+
+	// 名前はコンテンツ・作品・評価軸と種類別のスキーマが参照する識別子のため変更できない
+	if req.Name != nil && entity.ContentType(*req.Name) != definition.Name {
+		return nil, domainErrors.NewValidationError("コンテンツタイプ名は作成後に変更できません（表示名はdisplay_nameで変更してください）")
+	}
+	displayName := definition.DisplayName
+	if req.DisplayName != nil {
+		displayName = *req.DisplayName
+	}
+	description := definition.Description
+	if req.Description != nil {
+		description = *req.Description
+	}
+	allowedFields := definition.AllowedFields
+	if req.AllowedFields != nil {
+		allowedFields = *req.AllowedFields
+	}
+	metadataSchemaKey := definition.MetadataSchemaKey
+	if req.MetadataSchema != nil {
+		metadataSchemaKey = *req.MetadataSchema
+	}
+	defaultCategoryID := definition.DefaultCategoryID
+	if req.DefaultCategoryID != nil {
+		defaultCategoryID = nil
+		if *req.DefaultCategoryID != 0 {
+			if err := s.ensureCategoryExists(ctx, req.DefaultCategoryID); err != nil {
+				return nil, err
+			}
+			defaultCategoryID = req.DefaultCategoryID
+		}
+	}
+	sortOrder := definition.SortOrder
+	if req.SortOrder != nil {
+		sortOrder = *req.SortOrder
+	}
+	isActive := definition.IsActive
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	if err := definition.Update(displayName, description, allowedFields, metadataSchemaKey, defaultCategoryID, sortOrder, isActive); err != nil {
+		return nil, err
+	}
+
+	if err := s.contentTypeRepo.Update(ctx, definition); err != nil {
+		if domainErrors.IsNotFoundError(err) || domainErrors.IsConflictError(err) || domainErrors.IsValidationError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("content type update failed: %w", err)
+	}
+
+	return s.toContentTypeResponse(definition), nil
+}
+
+// DeleteContentType はコンテンツタイプを削除します（管理者用、使用中の種類は無効化で対応）
+func (s *ContentTypeService) DeleteContentType(ctx context.Context, id int64) error {
+	if err := s.contentTypeRepo.Delete(ctx, id); err != nil {
+		if domainErrors.IsNotFoundError(err) || domainErrors.IsConflictError(err) {
+			return err
+		}
+		return fmt.Errorf("content type deletion failed: %w", err)
+	}
+	return nil
+}
+
+// ========== 評価軸 ==========
+
+// GetScoreAxes はコンテンツタイプの評価軸を表示順で取得します
+func (s *ContentTypeService) GetScoreAxes(ctx context.Context, typeID int64) ([]*dto.ScoreAxisResponse, error) {
+	definition, err := s.findDefinition(ctx, typeID)
+	if err != nil {
+		return nil, err
+	}
+
+	axes, err := s.scoreAxisRepo.FindByType(ctx, definition.Name)
+	if err != nil {
+		return nil, fmt.Errorf("score axes lookup failed: %w", err)
+	}
+
+	responses := make([]*dto.ScoreAxisResponse, len(axes))
+	for i, axis := range axes {
+		responses[i] = s.toScoreAxisResponse(axis)
+	}
+	return responses, nil
+}
+
+// CreateScoreAxis はコンテンツタイプに評価軸を追加します（管理者用）
+func (s *ContentTypeService) CreateScoreAxis(ctx context.Context, typeID int64, req *dto.CreateScoreAxisRequest) (*dto.ScoreAxisResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	definition, err := s.findDefinition(ctx, typeID)
+	if err != nil {
+		return nil, err
+	}
+
+	axis, err := entity.NewScoreAxis(definition.Name, req.Key, req.Label, req.SortOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.scoreAxisRepo.Create(ctx, axis); err != nil {
+		if domainErrors.IsConflictError(err) || domainErrors.IsValidationError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("score axis creation failed: %w", err)
+	}
+
+	return s.toScoreAxisResponse(axis), nil
+}
+
+// UpdateScoreAxis は評価軸の表示名・表示順を更新します（管理者用）
+func (s *ContentTypeService) UpdateScoreAxis(ctx context.Context, typeID, axisID int64, req *dto.UpdateScoreAxisRequest) (*dto.ScoreAxisResponse, error) {
+	axis, err := s.findScoreAxis(ctx, typeID, axisID)
+	if err != nil {
+		return nil, err
+	}
+
+	label := axis.Label
+	if req.Label != nil {
+		label = *req.Label
+	}
+	sortOrder := axis.SortOrder
+	if req.SortOrder != nil {
+		sortOrder = *req.SortOrder
+	}
+
+	if err := axis.Update(label, sortOrder); err != nil {
+		return nil, err
+	}
+
+	if err := s.scoreAxisRepo.Update(ctx, axis); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("score axis update failed: %w", err)
+	}
+
+	return s.toScoreAxisResponse(axis), nil
+}
+
+// DeleteScoreAxis は評価軸を削除します（管理者用、その軸のスコアも削除されます）
+func (s *ContentTypeService) DeleteScoreAxis(ctx context.Context, typeID, axisID int64) error {
+	if _, err := s.findScoreAxis(ctx, typeID, axisID); err != nil {
+		return err
+	}
+
+	if err := s.scoreAxisRepo.Delete(ctx, axisID); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("score axis deletion failed: %w", err)
+	}
+	return nil
+}
+
+// ========== ヘルパーメソッド ==========
+
+func (s *ContentTypeService) findDefinition(ctx context.Context, id int64) (*entity.ContentTypeDefinition, error) {
+	definition, err := s.contentTypeRepo.Find(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("content type lookup failed: %w", err)
+	}
+	return definition, nil
+}
+
+// findScoreAxis はコンテンツタイプに属する評価軸を取得します（他の種類の評価軸は存在しないものとして扱う）
+func (s *ContentTypeService) findScoreAxis(ctx context.Context, typeID, axisID int64) (*entity.ScoreAxis, error) {
+	definition, err := s.findDefinition(ctx, typeID)
+	if err != nil {
+		return nil, err
+	}
+
+	axis, err := s.scoreAxisRepo.Find(ctx, axisID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("score axis lookup failed: %w", err)
+	}
+
+	if axis.ContentType != definition.Name {
+		return nil, domainErrors.NewNotFoundError("score axis", axisID)
+	}
+	return axis, nil
+}
+
+// ensureCategoryExists は既定カテゴリが存在するか確認します
+func (s *ContentTypeService) ensureCategoryExists(ctx context.Context, categoryID *int64) error {
+	if categoryID == nil {
+		return nil
+	}
+	if _, err := s.categoryRepo.FindByID(ctx, *categoryID); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return domainErrors.NewValidationError("指定された既定カテゴリが存在しません")
+		}
+		return fmt.Errorf("category lookup failed: %w", err)
+	}
+	return nil
+}
+
+// ========== Entity → DTO 変換 ==========
+
+func (s *ContentTypeService) toContentTypeResponse(definition *entity.ContentTypeDefinition) *dto.ContentTypeResponse {
+	allowedFields := definition.AllowedFields
+	if allowedFields == nil {
+		allowedFields = []string{}
+	}
+
+	metadataFields := []*dto.MetadataFieldResponse{}
+	if schema, ok := definition.MetadataSchema(); ok {
+		for _, field := range schema.Fields {
+			metadataFields = append(metadataFields, &dto.MetadataFieldResponse{
+				Key:      field.Key,
+				Label:    field.Label,
+				Kind:     string(field.Kind),
+				Required: field.Required,
+				Options:  field.Options,
+				Min:      field.Min,
+				Max:      field.Max,
+			})
+		}
+	}
+
+	return &dto.ContentTypeResponse{
+		ID:                definition.ID,
+		Name:              string(definition.Name),
+		DisplayName:       definition.DisplayName,
+		Description:       definition.Description,
+		AllowedFields:     allowedFields,
+		MetadataSchema:    definition.MetadataSchemaKey,
+		DefaultCategoryID: definition.DefaultCategoryID,
+		SortOrder:         definition.SortOrder,
+		IsActive:          definition.IsActive,
+		CreatedAt:         definition.CreatedAt,
+		UpdatedAt:         definition.UpdatedAt,
+
+		MetadataFields: metadataFields,
+	}
+}
+
+func (s *ContentTypeService) toScoreAxisResponse(axis *entity.ScoreAxis) *dto.ScoreAxisResponse {
+	return &dto.ScoreAxisResponse{
+		ID:          axis.ID,
+		ContentType: string(axis.ContentType),
+		Key:         axis.Key,
+		Label:       axis.Label,
+		SortOrder:   axis.SortOrder,
+		CreatedAt:   axis.CreatedAt,
+		UpdatedAt:   axis.UpdatedAt,
+	}
+}
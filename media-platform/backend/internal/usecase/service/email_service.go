@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"media-platform/internal/domain/entity"
+	"media-platform/internal/domain/repository"
+	"media-platform/internal/usecase/dto"
+)
+
+// メール送信の設定
+const (
+	emailSendTimeout      = 30 * time.Second // 1通のメールの生成・送信のタイムアウト
+	emailExcerptLength    = 200              // 本文の抜粋の最大文字数
+	digestFeedScanLimit   = 100              // ダイジェストの候補として読むフィードの件数
+	digestRecipientsBatch = 100              // 1回に取得するダイジェストの送信先の件数
+)
+
+// ========== Dependencies (Interfaces) ==========
+
+// Mailer はメールの送信先（SMTP・開発用のログ出力など）を抽象化します
+type Mailer interface {
+	// Send はメールを送信します
+	Send(ctx context.Context, message *entity.EmailMessage) error
+}
+
+// EmailRenderer は言語ごとのテンプレートからメールの件名と本文を生成します
+type EmailRenderer interface {
+	// Render は件名と本文を生成します（宛先は設定しません）
+	Render(locale string, template entity.EmailTemplate, data interface{}) (*entity.EmailMessage, error)
+}
+
+// ========== Use Case Interactor ==========
+
+// EmailService はメール通知と週間ダイジェストに関するユースケースを提供します
+// フォロー・返信・メンションのメールは受信者の設定を確認し、元の操作を遅らせないよう非同期に送信します
+type EmailService struct {
+	mailer         Mailer
+	renderer       EmailRenderer
+	preferenceRepo repository.NotificationPreferenceRepository
+	userRepo       repository.UserRepository
+	contentRepo    repository.ContentRepository
+	commentRepo    repository.CommentRepository
+	followRepo     repository.FollowRepository
+	baseURL        string
+}
+
+// NewEmailService は新しいEmailServiceのインスタンスを生成します
+// baseURLはメール内のリンクに使うフロントエンドのURLです
+func NewEmailService(
+	mailer Mailer,
+	renderer EmailRenderer,
+	preferenceRepo repository.NotificationPreferenceRepository,
+	userRepo repository.UserRepository,
+	contentRepo repository.ContentRepository,
+	commentRepo repository.CommentRepository,
+	followRepo repository.FollowRepository,
+	baseURL string,
+) *EmailService {
+	return &EmailService{
+		mailer:         mailer,
+		renderer:       renderer,
+		preferenceRepo: preferenceRepo,
+		userRepo:       userRepo,
+		contentRepo:    contentRepo,
+		commentRepo:    commentRepo,
+		followRepo:     followRepo,
+		baseURL:        strings.TrimRight(baseURL, "/"),
+	}
+}
+
+// ========== 通知メール ==========
+
+// SendFollowEmail はフォローされたユーザーにメールを送信します
+func (s *EmailService) SendFollowEmail(ctx context.Context, followerID, followingID int64) {
+	s.dispatch(ctx, "follow", func(ctx context.Context) error {
+		recipient, err := s.recipientFor(ctx, followingID, entity.NotificationTypeFollow)
+		if err != nil || recipient == nil {
+			return err
+		}
+		follower, err := s.userRepo.Find(ctx, followerID)
+		if err != nil {
+			return fmt.Errorf("follower lookup failed: %w", err)
+		}
+
+		return s.send(ctx, recipient, entity.EmailTemplateFollow, &dto.FollowEmailData{
+			Recipient:   recipient.Username,
+			Follower:    follower.Username,
+			ProfileURL:  s.url("/profile"),
+			SettingsURL: s.url("/profile"),
+		})
+	})
+}
+
+// SendReplyEmail は親コメントの投稿者に返信をメールで知らせます
+func (s *EmailService) SendReplyEmail(ctx context.Context, recipientID int64, reply *entity.Comment) {
+	s.dispatch(ctx, "reply", func(ctx context.Context) error {
+		recipient, err := s.recipientFor(ctx, recipientID, entity.NotificationTypeReply)
+		if err != nil || recipient == nil {
+			return err
+		}
+		replier, err := s.userRepo.Find(ctx, reply.UserID)
+		if err != nil {
+			return fmt.Errorf("replier lookup failed: %w", err)
+		}
+		content, err := s.contentRepo.Find(ctx, reply.ContentID)
+		if err != nil {
+			return fmt.Errorf("content lookup failed: %w", err)
+		}
+
+		return s.send(ctx, recipient, entity.EmailTemplateReply, &dto.ReplyEmailData{
+			Recipient:    recipient.Username,
+			Replier:      replier.Username,
+			ContentTitle: content.Title,
+			ReplyBody:    excerpt(reply.Body),
+			CommentURL:   s.commentURL(reply.ContentID, reply.ID),
+			SettingsURL:  s.url("/profile"),
+		})
+	})
+}
+
+// SendMentionEmail はメンションされたユーザーにメールを送信します
+func (s *EmailService) SendMentionEmail(ctx context.Context, recipientID int64, event *entity.MentionEvent) {
+	s.dispatch(ctx, "mention", func(ctx context.Context) error {
+		recipient, err := s.recipientFor(ctx, recipientID, entity.NotificationTypeMention)
+		if err != nil || recipient == nil {
+			return err
+		}
+		mentioner, err := s.userRepo.Find(ctx, event.ActorID)
+		if err != nil {
+			return fmt.Errorf("mentioner lookup failed: %w", err)
+		}
+		content, err := s.contentRepo.Find(ctx, event.ContentID)
+		if err != nil {
+			return fmt.Errorf("content lookup failed: %w", err)
+		}
+
+		body := content.Body
+		link := s.url(fmt.Sprintf("/contents/%d", content.ID))
+		if event.Source == entity.MentionSourceComment {
+			comment, err := s.commentRepo.Find(ctx, event.SourceID)
+			if err != nil {
+				return fmt.Errorf("comment lookup failed: %w", err)
+			}
+			body = comment.Body
+			link = s.commentURL(content.ID, comment.ID)
+		}
+
+		return s.send(ctx, recipient, entity.EmailTemplateMention, &dto.MentionEmailData{
+			Recipient:    recipient.Username,
+			Mentioner:    mentioner.Username,
+			ContentTitle: content.Title,
+			Excerpt:      excerpt(body),
+			URL:          link,
+			SettingsURL:  s.url("/profile"),
+		})
+	})
+}
+
+// ========== 週間ダイジェスト ==========
+
+// SendWeeklyDigests は前回の処理から1週間以上経ったユーザーに、フォロー中のユーザーの
+// 直近1週間の投稿をいいね数・コメント数の多い順にまとめて送信し、送信した件数を返します
+// 処理した日時をユーザーごとに記録するため、定期的（1時間ごと）に実行すれば再起動をまたいでも週1回ずつ送信され、
+// 複数のインスタンスで実行しても同じユーザーに重複して送信しません
+// 対象の投稿がないユーザーには送信せず、処理済みとして記録します。個別の送信エラーはログに出力して続行し、
+// 処理中の状態のリース期限が切れた後の実行で再試行します
+func (s *EmailService) SendWeeklyDigests(ctx context.Context) (int, error) {
+	authors := map[int64]string{}
+	sent := 0
+
+	for {
+		now := time.Now()
+		since := now.Add(-entity.DigestPeriod)
+		recipients, err := s.preferenceRepo.ClaimDueDigestRecipients(ctx, since, now, now.Add(entity.DigestClaimLease), digestRecipientsBatch)
+		if err != nil {
+			return sent, fmt.Errorf("digest recipients claim failed: %w", err)
+		}
+
+		for _, recipient := range recipients {
+			if err := ctx.Err(); err != nil {
+				// 取得済みのユーザーはリース期限が切れた後に再度処理される
+				return sent, err
+			}
+
+			ok, err := s.sendDigest(ctx, recipient, since, authors)
+			if err != nil {
+				log.Printf("❌ ダイジェストの送信エラー: user %d: %v", recipient.UserID, err)
+				continue
+			}
+			if ok {
+				sent++
+			}
+
+			if err := s.preferenceRepo.MarkDigestSent(ctx, recipient.UserID, time.Now()); err != nil {
+				log.Printf("❌ ダイジェストの送信記録の保存エラー: user %d: %v", recipient.UserID, err)
+			}
+		}
+
+		if len(recipients) < digestRecipientsBatch {
+			break
+		}
+	}
+
+	if sent > 0 {
+		log.Printf("📧 週間ダイジェストを%d件送信しました", sent)
+	}
+	return sent, nil
+}
+
+// sendDigest は1人のユーザーにダイジェストを送信します（対象の投稿がない場合はfalse）
+func (s *EmailService) sendDigest(ctx context.Context, recipient *entity.EmailRecipient, since time.Time, authors map[int64]string) (bool, error) {
+	feed, err := s.followRepo.GetFollowingFeed(ctx, recipient.UserID, digestFeedScanLimit, 0)
+	if err != nil {
+		return false, fmt.Errorf("following feed lookup failed: %w", err)
+	}
+
+	// フィードは公開日時の新しい順なので、期間外の投稿が出たらそこで打ち切る
+	var contents []*entity.Content
+	for _, content := range feed {
+		if content.PublishedAt == nil || content.PublishedAt.Before(since) {
+			break
+		}
+		contents = append(contents, content)
+	}
+	if len(contents) == 0 {
+		return false, nil
+	}
+
+	sort.SliceStable(contents, func(i, j int) bool {
+		if contents[i].LikeCount != contents[j].LikeCount {
+			return contents[i].LikeCount > contents[j].LikeCount
+		}
+		return contents[i].CommentCount > contents[j].CommentCount
+	})
+	if len(contents) > entity.DigestMaxItems {
+		contents = contents[:entity.DigestMaxItems]
+	}
+
+	items := make([]dto.DigestEmailItem, len(contents))
+	for i, content := range contents {
+		author, ok := authors[content.AuthorID]
+		if !ok {
+			user, err := s.userRepo.Find(ctx, content.AuthorID)
+			if err != nil {
+				return false, fmt.Errorf("author lookup failed: %w", err)
+			}
+			author = user.Username
+			authors[content.AuthorID] = author
+		}
+
+		items[i] = dto.DigestEmailItem{
+			Title:        content.Title,
+			Author:       author,
+			Excerpt:      excerpt(content.Body),
+			LikeCount:    content.LikeCount,
+			CommentCount: content.CommentCount,
+			URL:          s.url(fmt.Sprintf("/contents/%d", content.ID)),
+		}
+	}
+
+	err = s.send(ctx, recipient, entity.EmailTemplateDigest, &dto.DigestEmailData{
+		Recipient:   recipient.Username,
+		Items:       items,
+		FeedURL:     s.url("/following"),
+		SettingsURL: s.url("/profile"),
+	})
+	return err == nil, err
+}
+
+// ========== ヘルパーメソッド ==========
+
+// dispatch はメールの送信を非同期に実行し、エラーはログに出力します
+// リクエストの終了でキャンセルされないよう、元のコンテキストからはキャンセルを引き継ぎません
+func (s *EmailService) dispatch(ctx context.Context, kind string, send func(ctx context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), emailSendTimeout)
+	go func() {
+		defer cancel()
+		if err := send(ctx); err != nil {
+			log.Printf("❌ メール送信エラー (%s): %v", kind, err)
+		}
+	}()
+}
+
+// recipientFor は通知の種類のメールを受け取る設定のユーザーを取得します（受け取らない場合はnil）
+func (s *EmailService) recipientFor(ctx context.Context, userID int64, notificationType entity.NotificationType) (*entity.EmailRecipient, error) {
+	preference, err := s.preferenceRepo.Find(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("notification preference lookup failed: %w", err)
+	}
+	if !preference.WantsEmail(notificationType) {
+		return nil, nil
+	}
+
+	user, err := s.userRepo.Find(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("recipient lookup failed: %w", err)
+	}
+	if user.Email == "" {
+		return nil, nil
+	}
+
+	return &entity.EmailRecipient{
+		UserID:   user.ID,
+		Username: user.Username,
+		Email:    user.Email,
+		Locale:   preference.Locale,
+	}, nil
+}
+
+// send は受信者の言語のテンプレートからメールを生成して送信します
+func (s *EmailService) send(ctx context.Context, recipient *entity.EmailRecipient, template entity.EmailTemplate, data interface{}) error {
+	message, err := s.renderer.Render(recipient.Locale, template, data)
+	if err != nil {
+		return fmt.Errorf("email render failed: %w", err)
+	}
+	message.To = recipient.Email
+
+	if err := s.mailer.Send(ctx, message); err != nil {
+		return fmt.Errorf("email send failed: %w", err)
+	}
+	return nil
+}
+
+func (s *EmailService) url(path string) string {
+	return s.baseURL + path
+}
+
+func (s *EmailService) commentURL(contentID, commentID int64) string {
+	return s.url(fmt.Sprintf("/contents/%d#comment-%d", contentID, commentID))
+}
+
+// excerpt は本文の先頭から抜粋を作成します
+func excerpt(body string) string {
+	body = strings.TrimSpace(body)
+	if utf8.RuneCountInString(body) <= emailExcerptLength {
+		return body
+	}
+	return string([]rune(body)[:emailExcerptLength]) + "…"
+}
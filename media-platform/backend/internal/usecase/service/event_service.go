@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"media-platform/internal/domain/entity"
+	"media-platform/internal/domain/repository"
+)
+
+// イベントのディスパッチャーの設定
+const eventDispatchBatch = 100 // 1回に取得する配信待ちのイベント数
+
+// ========== Dependencies (Interfaces) ==========
+
+// EventHandler はドメインイベントを処理する購読者です
+// 配信は少なくとも1回（at-least-once）で、自身や他の購読者が失敗すると同じイベントで再度呼ばれるため、
+// event.EventIDなどを使って冪等に処理する必要があります
+type EventHandler func(ctx context.Context, event *entity.OutboxEvent) error
+
+// ========== Use Case Interactor ==========
+
+// EventService はドメインイベントの発行と配信（トランザクショナル・アウトボックス）を提供します
+// サービスは状態の変更とイベントの保存を Transaction 内で行い、ディスパッチャー（DispatchPending）が
+// コミットされたイベントを発生順にプロセス内の購読者へ配信します
+type EventService struct {
+	outboxRepo repository.OutboxRepository
+	transactor repository.Transactor
+
+	mu          sync.RWMutex
+	subscribers map[entity.DomainEventType][]eventSubscriber
+}
+
+type eventSubscriber struct {
+	name   string
+	handle EventHandler
+}
+
+// NewEventService は新しいEventServiceのインスタンスを生成します
+func NewEventService(
+	outboxRepo repository.OutboxRepository,
+	transactor repository.Transactor,
+) *EventService {
+	return &EventService{
+		outboxRepo:  outboxRepo,
+		transactor:  transactor,
+		subscribers: map[entity.DomainEventType][]eventSubscriber{},
+	}
+}
+
+// ========== 購読 ==========
+
+// Subscribe は指定した種類のイベントの購読者を登録します（nameはログとエラーの記録に使います）
+func (s *EventService) Subscribe(name string, handler EventHandler, eventTypes ...entity.DomainEventType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, eventType := range eventTypes {
+		s.subscribers[eventType] = append(s.subscribers[eventType], eventSubscriber{name: name, handle: handler})
+	}
+}
+
+func (s *EventService) subscribersFor(eventType entity.DomainEventType) []eventSubscriber {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.subscribers[eventType]
+}
+
+// ========== 発行 ==========
+
+// Transaction はfnをトランザクション内で実行します
+// fnに渡されたコンテキストで行ったリポジトリの変更とEmitしたイベントは、まとめてコミットまたはロールバックされます
+func (s *EventService) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return s.transactor.WithinTransaction(ctx, fn)
+}
+
+// Emit はイベントをアウトボックスに保存します
+// Transactionのfn内で呼ぶと状態の変更と同じトランザクションで保存され、コミットされたイベントだけが配信されます
+func (s *EventService) Emit(ctx context.Context, events ...entity.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	outboxEvents := make([]*entity.OutboxEvent, len(events))
+	for i, event := range events {
+		eventID, err := generateEventID()
+		if err != nil {
+			return err
+		}
+		outboxEvent, err := entity.NewOutboxEvent(eventID, event)
+		if err != nil {
+			return err
+		}
+		outboxEvents[i] = outboxEvent
+	}
+
+	if err := s.outboxRepo.Create(ctx, outboxEvents); err != nil {
+		return fmt.Errorf("outbox event creation failed: %w", err)
+	}
+	return nil
+}
+
+// ========== ディスパッチャー ==========
+
+// DispatchPending は配信待ちのイベントを発生順に購読者へ配信し、配信できた件数を返します
+// 購読者が1つでも失敗したイベントは指数バックオフで再試行し、上限に達したらデッドレターにします
+func (s *EventService) DispatchPending(ctx context.Context) (int, error) {
+	dispatched := 0
+	for {
+		now := time.Now()
+		events, err := s.outboxRepo.ClaimPending(ctx, now, now.Add(entity.OutboxDispatchLease), eventDispatchBatch)
+		if err != nil {
+			return dispatched, fmt.Errorf("outbox events claim failed: %w", err)
+		}
+
+		for _, event := range events {
+			if err := ctx.Err(); err != nil {
+				// 取得済みのイベントはリース期限が切れた後に再度配信される
+				return dispatched, err
+			}
+			if s.dispatch(ctx, event) {
+				dispatched++
+			}
+		}
+
+		if len(events) < eventDispatchBatch {
+			break
+		}
+	}
+
+	if dispatched > 0 {
+		log.Printf("📨 ドメインイベントを%d件配信しました", dispatched)
+	}
+	return dispatched, nil
+}
+
+// dispatch は1つのイベントをすべての購読者に配信し、結果を保存します（すべて成功した場合はtrue）
+func (s *EventService) dispatch(ctx context.Context, event *entity.OutboxEvent) bool {
+	var failures []string
+	for _, subscriber := range s.subscribersFor(event.Type) {
+		if err := s.handle(ctx, subscriber, event); err != nil {
+			failures = append(failures, subscriber.name+": "+err.Error())
+		}
+	}
+
+	now := time.Now()
+	if len(failures) == 0 {
+		event.MarkDispatched(now)
+	} else {
+		event.Fail(strings.Join(failures, "; "), now)
+		if event.Status == entity.OutboxEventDead {
+			log.Printf("❌ ドメインイベントをデッドレターにしました: %s (%s): %s", event.EventID, event.Type, event.LastError)
+		} else {
+			log.Printf("⚠️  ドメインイベントの配信に失敗しました（%d回目）: %s (%s): %s", event.Attempts, event.EventID, event.Type, event.LastError)
+		}
+	}
+
+	if err := s.outboxRepo.Update(ctx, event); err != nil {
+		// 保存できなかった場合はリース期限が切れた後に再度配信される
+		log.Printf("❌ ドメインイベントの配信結果の保存エラー: %s: %v", event.EventID, err)
+		return false
+	}
+	return len(failures) == 0
+}
+
+// handle は購読者を呼び出します（パニックはエラーとして扱います）
+func (s *EventService) handle(ctx context.Context, subscriber eventSubscriber, event *entity.OutboxEvent) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return subscriber.handle(ctx, event)
+}
+
+// PurgeDispatched は保持期間を過ぎた配信済みのイベントを削除し、削除した件数を返します
+func (s *EventService) PurgeDispatched(ctx context.Context) (int64, error) {
+	deleted, err := s.outboxRepo.DeleteDispatchedBefore(ctx, time.Now().Add(-entity.OutboxRetention))
+	if err != nil {
+		return 0, fmt.Errorf("dispatched outbox events purge failed: %w", err)
+	}
+	if deleted > 0 {
+		log.Printf("🧹 配信済みのドメインイベントを%d件削除しました", deleted)
+	}
+	return deleted, nil
+}
+
+// generateEventID はイベントのIDを生成します（Webhookのペイロードのidにも使います）
+func generateEventID() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("event id generation failed: %w", err)
+	}
+	return "evt_" + hex.EncodeToString(b), nil
+}
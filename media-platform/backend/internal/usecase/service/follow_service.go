@@ -12,18 +12,27 @@ import (
 
 // FollowService はフォロー機能のサービスです
 type FollowService struct {
-	followRepo repository.FollowRepository
-	userRepo   repository.UserRepository
+	followRepo          repository.FollowRepository
+	userRepo            repository.UserRepository
+	notificationService *NotificationService
+	eventService        *EventService
+	avatarURLPath       string // ファイルストレージの公開パス（アップロードしたアバターの判定に使う）
 }
 
 // NewFollowService はFollowServiceを作成します
 func NewFollowService(
 	followRepo repository.FollowRepository,
 	userRepo repository.UserRepository,
+	notificationService *NotificationService,
+	eventService *EventService,
+	avatarURLPath string,
 ) *FollowService {
 	return &FollowService{
-		followRepo: followRepo,
-		userRepo:   userRepo,
+		followRepo:          followRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+		eventService:        eventService,
+		avatarURLPath:       avatarURLPath,
 	}
 }
 
@@ -52,14 +61,15 @@ func (s *FollowService) toFollowStatsResponse(stats *entity.FollowStats) *dto.Fo
 // toUserResponse はUserエンティティをUserResponseに変換します
 func (s *FollowService) toUserResponse(user *entity.User) dto.UserResponse {
 	return dto.UserResponse{
-		ID:        user.ID,
-		Username:  user.Username,
-		Email:     user.Email,
-		Bio:       user.Bio,
-		Avatar:    user.Avatar,
-		Role:      user.Role,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:         user.ID,
+		Username:   user.Username,
+		Email:      user.Email,
+		Bio:        user.Bio,
+		Avatar:     user.Avatar,
+		AvatarURLs: toAvatarURLs(s.avatarURLPath, user.Avatar),
+		Role:       user.Role,
+		CreatedAt:  user.CreatedAt,
+		UpdatedAt:  user.UpdatedAt,
 	}
 }
 
@@ -78,17 +88,33 @@ func (s *FollowService) toFollowUserResponseList(users []*entity.User) []dto.Fol
 // toContentResponse はContentエンティティをContentResponseに変換します
 func (s *FollowService) toContentResponse(content *entity.Content) dto.ContentResponse {
 	response := dto.ContentResponse{
-		ID:          content.ID,
-		Title:       content.Title,
-		Body:        content.Body,
-		Type:        string(content.Type),
-		AuthorID:    content.AuthorID,
-		CategoryID:  content.CategoryID,
-		Status:      string(content.Status),
-		ViewCount:   content.ViewCount,
-		PublishedAt: content.PublishedAt,
-		CreatedAt:   content.CreatedAt,
-		UpdatedAt:   content.UpdatedAt,
+		ID:           content.ID,
+		Title:        content.Title,
+		Body:         content.Body,
+		Type:         string(content.Type),
+		AuthorID:     content.AuthorID,
+		CategoryID:   content.CategoryID,
+		Status:       string(content.Status),
+		ViewCount:    content.ViewCount,
+		LikeCount:    content.LikeCount,
+		CommentCount: content.CommentCount,
+		PublishedAt:  content.PublishedAt,
+		CreatedAt:    content.CreatedAt,
+		UpdatedAt:    content.UpdatedAt,
+
+		WorkID:              content.WorkID,
+		ReviewScore:         content.ReviewScore,
+		RecommendationLevel: string(content.RecommendationLevel),
+
+		ArtistName:  content.ArtistName,
+		ReleaseYear: content.ReleaseYear,
+		ImageURL:    content.ImageURL,
+		ExternalURL: content.ExternalURL,
+		Tags:        content.Tags,
+
+		CommentPolicy: string(content.CommentPolicy),
+
+		Metadata: content.Metadata,
 	}
 
 	// 趣味投稿専用フィールド
@@ -144,10 +170,22 @@ func (s *FollowService) FollowUser(ctx context.Context, followerID, followingID
 		return nil, err
 	}
 
-	if err := s.followRepo.Create(ctx, follow); err != nil {
+	err = s.eventService.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.followRepo.Create(ctx, follow); err != nil {
+			return err
+		}
+		return s.eventService.Emit(ctx, &entity.UserFollowed{
+			FollowID:    follow.ID,
+			FollowerID:  follow.FollowerID,
+			FollowingID: follow.FollowingID,
+		})
+	})
+	if err != nil {
 		return nil, fmt.Errorf("follow creation failed: %w", err)
 	}
 
+	s.notificationService.NotifyFollowed(ctx, followerID, followingID)
+
 	return s.toFollowResponse(follow), nil
 }
 
@@ -163,7 +201,16 @@ func (s *FollowService) UnfollowUser(ctx context.Context, followerID, followingI
 	}
 
 	// フォロー解除
-	if err := s.followRepo.Delete(ctx, followerID, followingID); err != nil {
+	err = s.eventService.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.followRepo.Delete(ctx, followerID, followingID); err != nil {
+			return err
+		}
+		return s.eventService.Emit(ctx, &entity.UserUnfollowed{
+			FollowerID:  followerID,
+			FollowingID: followingID,
+		})
+	})
+	if err != nil {
 		return fmt.Errorf("unfollow failed: %w", err)
 	}
 
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+	"media-platform/internal/usecase/dto"
+)
+
+// LibraryService はユーザーのライブラリ（消費記録）に関するユースケースを提供します
+type LibraryService struct {
+	libraryRepo repository.LibraryRepository
+	contentRepo repository.ContentRepository
+}
+
+// NewLibraryService は新しいLibraryServiceのインスタンスを生成します
+func NewLibraryService(libraryRepo repository.LibraryRepository, contentRepo repository.ContentRepository) *LibraryService {
+	return &LibraryService{
+		libraryRepo: libraryRepo,
+		contentRepo: contentRepo,
+	}
+}
+
+// GetLibrary はユーザーのライブラリ一覧を取得し、総件数も返します
+func (s *LibraryService) GetLibrary(ctx context.Context, userID int64, query *dto.LibraryQuery) ([]*dto.LibraryEntryResponse, int, error) {
+	if query.Limit <= 0 {
+		query.Limit = 20
+	}
+	if query.Limit > 100 {
+		query.Limit = 100
+	}
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+
+	filter := &entity.LibraryFilter{
+		Status:       entity.LibraryStatus(query.Status),
+		Type:         entity.ContentType(query.Type),
+		FinishedYear: query.Year,
+		Target:       query.Target,
+	}
+	if filter.Status != "" && !entity.IsValidLibraryStatus(filter.Status) {
+		return nil, 0, domainErrors.NewValidationError("無効なライブラリステータスです")
+	}
+	if filter.Target != "" && filter.Target != "work" && filter.Target != "content" {
+		return nil, 0, domainErrors.NewValidationError("targetは work または content を指定してください")
+	}
+
+	entries, total, err := s.libraryRepo.FindByUser(ctx, userID, filter, query.Limit, query.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("library lookup failed: %w", err)
+	}
+
+	responses := make([]*dto.LibraryEntryResponse, len(entries))
+	for i, entry := range entries {
+		responses[i] = s.toLibraryEntryResponse(entry)
+	}
+	return responses, total, nil
+}
+
+// GetLibraryStats はユーザーのライブラリ集計を取得します（yearがnilの場合は全期間）
+func (s *LibraryService) GetLibraryStats(ctx context.Context, userID int64, year *int) (*dto.LibraryStatsResponse, error) {
+	stats, err := s.libraryRepo.GetStats(ctx, userID, year)
+	if err != nil {
+		return nil, fmt.Errorf("library stats lookup failed: %w", err)
+	}
+
+	byStatus := make(map[string]int, len(stats.ByStatus))
+	for status, count := range stats.ByStatus {
+		byStatus[string(status)] = count
+	}
+	completedByType := make(map[string]int, len(stats.CompletedByType))
+	for itemType, count := range stats.CompletedByType {
+		completedByType[string(itemType)] = count
+	}
+
+	return &dto.LibraryStatsResponse{
+		Year:            stats.Year,
+		Total:           stats.Total,
+		ByStatus:        byStatus,
+		CompletedByType: completedByType,
+	}, nil
+}
+
+// AddToLibrary は作品またはコンテンツをライブラリに登録します
+// 閲覧できないコンテンツ（他のユーザーの下書きなど）は存在しないものとして扱います
+func (s *LibraryService) AddToLibrary(ctx context.Context, userID int64, userRole string, req *dto.CreateLibraryEntryRequest) (*dto.LibraryEntryResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.ContentID != nil {
+		if err := s.ensureContentViewable(ctx, *req.ContentID, userID, userRole); err != nil {
+			return nil, err
+		}
+	}
+
+	startedOn, _, err := parseLibraryDate(req.StartedOn)
+	if err != nil {
+		return nil, err
+	}
+	finishedOn, _, err := parseLibraryDate(req.FinishedOn)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &entity.LibraryEntry{
+		UserID:        userID,
+		WorkID:        req.WorkID,
+		ContentID:     req.ContentID,
+		Progress:      req.Progress,
+		ProgressTotal: req.ProgressTotal,
+		ProgressUnit:  entity.ProgressUnit(req.ProgressUnit),
+		StartedOn:     startedOn,
+		FinishedOn:    finishedOn,
+		CreatedAt:     time.Now(),
+	}
+	// 日付が未指定の場合はステータスに応じて補完される
+	if err := entry.SetStatus(entity.LibraryStatus(req.Status)); err != nil {
+		return nil, err
+	}
+	if err := entry.SetNote(req.Note); err != nil {
+		return nil, err
+	}
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.libraryRepo.Create(ctx, entry); err != nil {
+		if domainErrors.IsConflictError(err) || domainErrors.IsValidationError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("library entry creation failed: %w", err)
+	}
+
+	// 一覧と同じ形式（タイトル・種類付き）で返す
+	return s.getOwnedEntry(ctx, userID, entry.ID)
+}
+
+// UpdateLibraryEntry はライブラリエントリを更新します
+func (s *LibraryService) UpdateLibraryEntry(ctx context.Context, userID, id int64, req *dto.UpdateLibraryEntryRequest) (*dto.LibraryEntryResponse, error) {
+	entry, err := s.findOwnedEntry(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StartedOn != nil || req.FinishedOn != nil {
+		startedOn, finishedOn := entry.StartedOn, entry.FinishedOn
+		if parsed, set, err := parseLibraryDate(req.StartedOn); err != nil {
+			return nil, err
+		} else if set {
+			startedOn = parsed
+		}
+		if parsed, set, err := parseLibraryDate(req.FinishedOn); err != nil {
+			return nil, err
+		} else if set {
+			finishedOn = parsed
+		}
+		entry.StartedOn, entry.FinishedOn = startedOn, finishedOn
+	}
+
+	if req.Progress != nil || req.ProgressTotal != nil || req.ProgressUnit != nil {
+		progress, total, unit := entry.Progress, entry.ProgressTotal, entry.ProgressUnit
+		if req.Progress != nil {
+			progress = *req.Progress
+		}
+		if req.ProgressTotal != nil {
+			total = req.ProgressTotal
+			if *req.ProgressTotal == 0 {
+				total = nil
+			}
+		}
+		if req.ProgressUnit != nil {
+			unit = entity.ProgressUnit(*req.ProgressUnit)
+		}
+		entry.Progress, entry.ProgressTotal, entry.ProgressUnit = progress, total, unit
+	}
+
+	if req.Status != nil {
+		if err := entry.SetStatus(entity.LibraryStatus(*req.Status)); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Note != nil {
+		if err := entry.SetNote(*req.Note); err != nil {
+			return nil, err
+		}
+	}
+
+	entry.UpdatedAt = time.Now()
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.libraryRepo.Update(ctx, entry); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("library entry update failed: %w", err)
+	}
+
+	return s.toLibraryEntryResponse(entry), nil
+}
+
+// RemoveFromLibrary はライブラリエントリを削除します
+func (s *LibraryService) RemoveFromLibrary(ctx context.Context, userID, id int64) error {
+	if _, err := s.findOwnedEntry(ctx, userID, id); err != nil {
+		return err
+	}
+
+	if err := s.libraryRepo.Delete(ctx, id); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("library entry deletion failed: %w", err)
+	}
+	return nil
+}
+
+// ========== ヘルパーメソッド ==========
+
+// findOwnedEntry は本人のライブラリエントリを取得します（他人のエントリは存在しないものとして扱う）
+func (s *LibraryService) findOwnedEntry(ctx context.Context, userID, id int64) (*entity.LibraryEntry, error) {
+	entry, err := s.libraryRepo.Find(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("library entry lookup failed: %w", err)
+	}
+
+	if !entry.IsOwnedBy(userID) {
+		return nil, domainErrors.NewNotFoundError("library entry", id)
+	}
+
+	return entry, nil
+}
+
+func (s *LibraryService) getOwnedEntry(ctx context.Context, userID, id int64) (*dto.LibraryEntryResponse, error) {
+	entry, err := s.findOwnedEntry(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.toLibraryEntryResponse(entry), nil
+}
+
+// parseLibraryDate はYYYY-MM-DD形式の日付を解析します
+// nilの場合はset=false、空文字の場合はクリア（nil, set=true）を返します
+func parseLibraryDate(value *string) (*time.Time, bool, error) {
+	if value == nil {
+		return nil, false, nil
+	}
+	if *value == "" {
+		return nil, true, nil
+	}
+
+	date, err := time.Parse(dto.LibraryDateLayout, *value)
+	if err != nil {
+		return nil, false, domainErrors.NewValidationError("日付はYYYY-MM-DD形式で指定してください")
+	}
+	return &date, true, nil
+}
+
+// ========== Entity → DTO 変換 ==========
+
+func (s *LibraryService) toLibraryEntryResponse(entry *entity.LibraryEntry) *dto.LibraryEntryResponse {
+	return &dto.LibraryEntryResponse{
+		ID:            entry.ID,
+		WorkID:        entry.WorkID,
+		ContentID:     entry.ContentID,
+		ItemTitle:     entry.ItemTitle,
+		ItemType:      string(entry.ItemType),
+		Status:        string(entry.Status),
+		Progress:      entry.Progress,
+		ProgressTotal: entry.ProgressTotal,
+		ProgressUnit:  string(entry.ProgressUnit),
+		StartedOn:     entry.StartedOn,
+		FinishedOn:    entry.FinishedOn,
+		Note:          entry.Note,
+		CreatedAt:     entry.CreatedAt,
+		UpdatedAt:     entry.UpdatedAt,
+	}
+}
+
+// ensureContentViewable はユーザーが閲覧できるコンテンツかどうかを確認し、閲覧できない場合はNotFoundを返します
+func (s *LibraryService) ensureContentViewable(ctx context.Context, contentID, userID int64, userRole string) error {
+	content, err := s.contentRepo.Find(ctx, contentID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("content lookup failed: %w", err)
+	}
+	if content == nil || !content.CanView(userID, userRole) {
+		return domainErrors.NewNotFoundError("Content", contentID)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+	"media-platform/internal/usecase/dto"
+)
+
+var errLibraryCreateCalled = errors.New("create called")
+
+// fakeLibraryRepo はCreateが呼ばれたことを記録するLibraryRepositoryです
+type fakeLibraryRepo struct {
+	repository.LibraryRepository
+	created bool
+}
+
+func (r *fakeLibraryRepo) Create(ctx context.Context, entry *entity.LibraryEntry) error {
+	r.created = true
+	return errLibraryCreateCalled
+}
+
+func TestLibraryService_AddToLibrary_ContentVisibility(t *testing.T) {
+	publishedAt := time.Now().Add(-time.Hour)
+	scheduledAt := time.Now().Add(time.Hour)
+	contents := map[int64]*entity.Content{
+		1: {ID: 1, AuthorID: streamTestAuthorID, Status: entity.ContentStatusPublished, PublishedAt: &publishedAt},
+		2: {ID: 2, AuthorID: streamTestAuthorID, Status: entity.ContentStatusDraft},
+		4: {ID: 4, AuthorID: streamTestAuthorID, Status: entity.ContentStatusPublished, PublishedAt: &scheduledAt},
+	}
+
+	tests := []struct {
+		name        string
+		contentID   int64
+		userID      int64
+		userRole    string
+		wantCreated bool
+	}{
+		{name: "公開済みは登録できる", contentID: 1, userID: streamTestOtherID, userRole: "user", wantCreated: true},
+		{name: "他のユーザーの下書きは登録できない", contentID: 2, userID: streamTestOtherID, userRole: "user"},
+		{name: "公開予約中は登録できない", contentID: 4, userID: streamTestOtherID, userRole: "user"},
+		{name: "投稿者は自分の下書きを登録できる", contentID: 2, userID: streamTestAuthorID, userRole: "user", wantCreated: true},
+		{name: "存在しないコンテンツ", contentID: 99, userID: streamTestOtherID, userRole: "admin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			libraryRepo := &fakeLibraryRepo{}
+			s := NewLibraryService(libraryRepo, &fakeStreamContentRepo{contents: contents})
+
+			contentID := tt.contentID
+			_, err := s.AddToLibrary(context.Background(), tt.userID, tt.userRole, &dto.CreateLibraryEntryRequest{
+				ContentID: &contentID,
+				Status:    string(entity.LibraryStatusWant),
+			})
+
+			if libraryRepo.created != tt.wantCreated {
+				t.Fatalf("created = %v, want %v (err = %v)", libraryRepo.created, tt.wantCreated, err)
+			}
+			if !tt.wantCreated && !domainErrors.IsNotFoundError(err) {
+				t.Fatalf("AddToLibrary() error = %v, want NotFoundError", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+	"media-platform/internal/usecase/dto"
+)
+
+// ========== Dependencies (Interfaces) ==========
+
+// MentionNotifier は新たにメンションされたユーザーへの通知先を抽象化します
+type MentionNotifier interface {
+	// NotifyMentioned はメンションのイベントを通知します
+	NotifyMentioned(ctx context.Context, event *entity.MentionEvent) error
+}
+
+// logMentionNotifier は通知先が設定されていない場合にイベントをログに出力します
+type logMentionNotifier struct{}
+
+func (logMentionNotifier) NotifyMentioned(ctx context.Context, event *entity.MentionEvent) error {
+	log.Printf("📣 mention: %s#%d by user %d -> users %v", event.Source, event.SourceID, event.ActorID, event.MentionedUserIDs)
+	return nil
+}
+
+// ========== Use Case Interactor ==========
+
+// MentionService はコンテンツ・コメントの本文中の@usernameの解決と保存を行います
+type MentionService struct {
+	mentionRepo repository.MentionRepository
+	userRepo    repository.UserRepository
+	notifier    MentionNotifier
+}
+
+// NewMentionService はMentionServiceを作成します（notifierがnilの場合はログに出力します）
+func NewMentionService(
+	mentionRepo repository.MentionRepository,
+	userRepo repository.UserRepository,
+	notifier MentionNotifier,
+) *MentionService {
+	if notifier == nil {
+		notifier = logMentionNotifier{}
+	}
+	return &MentionService{
+		mentionRepo: mentionRepo,
+		userRepo:    userRepo,
+		notifier:    notifier,
+	}
+}
+
+// SyncMentions は本文のメンションを保存し直し、レスポンス用のメンションの位置を返します
+// notifyがtrueの場合はまだ通知していないユーザー（本人を除く）に通知します
+// 下書きなど公開前の本文はnotifyをfalseにして保存のみ行い、公開時に改めて呼び出します
+// 通知の失敗は投稿・編集を失敗させません
+func (s *MentionService) SyncMentions(ctx context.Context, source entity.MentionSource, sourceID, contentID, actorID int64, body string, notify bool) ([]*dto.MentionResponse, error) {
+	tokens := entity.ParseMentions(body)
+
+	users := make(map[string]int64)
+	userIDs := []int64{}
+	for _, username := range entity.MentionedUsernames(tokens) {
+		user, err := s.userRepo.FindByUsername(ctx, username)
+		if err != nil {
+			if domainErrors.IsNotFoundError(err) {
+				continue // 存在しないユーザー名は通常の文字列として扱う
+			}
+			return nil, fmt.Errorf("mentioned user lookup failed: %w", err)
+		}
+		if user == nil {
+			continue
+		}
+		users[user.Username] = user.ID
+		userIDs = append(userIDs, user.ID)
+	}
+
+	if err := s.mentionRepo.Replace(ctx, source, sourceID, userIDs); err != nil {
+		return nil, fmt.Errorf("mentions save failed: %w", err)
+	}
+
+	if notify {
+		s.notifyPending(ctx, source, sourceID, contentID, actorID)
+	}
+
+	return toMentionResponses(tokens, users), nil
+}
+
+// notifyPending はまだ通知していないメンションを通知済みにし、本人以外のユーザーに通知します
+func (s *MentionService) notifyPending(ctx context.Context, source entity.MentionSource, sourceID, contentID, actorID int64) {
+	pending, err := s.mentionRepo.MarkNotified(ctx, source, sourceID)
+	if err != nil {
+		log.Printf("❌ メンション通知エラー: %v", err)
+		return
+	}
+
+	notified := make([]int64, 0, len(pending))
+	for _, userID := range pending {
+		if userID != actorID {
+			notified = append(notified, userID)
+		}
+	}
+	if len(notified) == 0 {
+		return
+	}
+
+	event := &entity.MentionEvent{
+		Source:           source,
+		SourceID:         sourceID,
+		ContentID:        contentID,
+		ActorID:          actorID,
+		MentionedUserIDs: notified,
+	}
+	if err := s.notifier.NotifyMentioned(ctx, event); err != nil {
+		log.Printf("❌ メンション通知エラー: %v", err)
+	}
+}
+
+// FindMentions は複数の本文のメンションの位置を一括取得します（bodiesのキーは本文のID）
+func (s *MentionService) FindMentions(ctx context.Context, source entity.MentionSource, bodies map[int64]string) (map[int64][]*dto.MentionResponse, error) {
+	result := make(map[int64][]*dto.MentionResponse, len(bodies))
+	if len(bodies) == 0 {
+		return result, nil
+	}
+
+	sourceIDs := make([]int64, 0, len(bodies))
+	for id := range bodies {
+		sourceIDs = append(sourceIDs, id)
+	}
+
+	mentions, err := s.mentionRepo.FindBySources(ctx, source, sourceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("mentions lookup failed: %w", err)
+	}
+
+	for id, body := range bodies {
+		users := make(map[string]int64, len(mentions[id]))
+		for _, mention := range mentions[id] {
+			users[mention.Username] = mention.UserID
+		}
+		result[id] = toMentionResponses(entity.ParseMentions(body), users)
+	}
+
+	return result, nil
+}
+
+// toMentionResponses は本文から抽出した位置のうち、解決できたユーザーのものだけをレスポンスに変換します
+func toMentionResponses(tokens []entity.MentionToken, users map[string]int64) []*dto.MentionResponse {
+	responses := []*dto.MentionResponse{}
+	for _, token := range tokens {
+		userID, ok := users[token.Username]
+		if !ok {
+			continue
+		}
+		responses = append(responses, &dto.MentionResponse{
+			UserID:   userID,
+			Username: token.Username,
+			Start:    token.Start,
+			End:      token.End,
+		})
+	}
+	return responses
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+)
+
+// fakeMentionRepo は通知済みの状態をメモリ上で管理するMentionRepositoryです
+type fakeMentionRepo struct {
+	notified map[int64]bool // ユーザーID -> 通知済みか
+}
+
+func (r *fakeMentionRepo) Replace(ctx context.Context, source entity.MentionSource, sourceID int64, userIDs []int64) error {
+	next := make(map[int64]bool, len(userIDs))
+	for _, userID := range userIDs {
+		next[userID] = r.notified[userID]
+	}
+	r.notified = next
+	return nil
+}
+
+func (r *fakeMentionRepo) MarkNotified(ctx context.Context, source entity.MentionSource, sourceID int64) ([]int64, error) {
+	userIDs := []int64{}
+	for userID, notified := range r.notified {
+		if !notified {
+			r.notified[userID] = true
+			userIDs = append(userIDs, userID)
+		}
+	}
+	return userIDs, nil
+}
+
+func (r *fakeMentionRepo) FindBySources(ctx context.Context, source entity.MentionSource, sourceIDs []int64) (map[int64][]*entity.Mention, error) {
+	return map[int64][]*entity.Mention{}, nil
+}
+
+// fakeMentionUserRepo はFindByUsernameのみを実装したUserRepositoryです
+type fakeMentionUserRepo struct {
+	repository.UserRepository
+	users map[string]int64
+}
+
+func (r *fakeMentionUserRepo) FindByUsername(ctx context.Context, username string) (*entity.User, error) {
+	id, ok := r.users[username]
+	if !ok {
+		return nil, domainErrors.NewNotFoundError("user", username)
+	}
+	return &entity.User{ID: id, Username: username}, nil
+}
+
+// fakeMentionNotifier は通知されたユーザーを記録します
+type fakeMentionNotifier struct {
+	userIDs []int64
+}
+
+func (n *fakeMentionNotifier) NotifyMentioned(ctx context.Context, event *entity.MentionEvent) error {
+	n.userIDs = append(n.userIDs, event.MentionedUserIDs...)
+	return nil
+}
+
+const (
+	mentionTestAuthorID = int64(1)
+	mentionTestBobID    = int64(2)
+	mentionTestCarolID  = int64(3)
+)
+
+func newMentionTestService() (*MentionService, *fakeMentionNotifier) {
+	users := &fakeMentionUserRepo{users: map[string]int64{"author": mentionTestAuthorID, "bob": mentionTestBobID, "carol": mentionTestCarolID}}
+	notifier := &fakeMentionNotifier{}
+	return NewMentionService(&fakeMentionRepo{}, users, notifier), notifier
+}
+
+func TestMentionService_SyncMentions_DraftNotifiesOnPublish(t *testing.T) {
+	s, notifier := newMentionTestService()
+	ctx := context.Background()
+
+	// 下書きの保存では通知しない
+	mentions, err := s.SyncMentions(ctx, entity.MentionSourceContent, 10, 10, mentionTestAuthorID, "@bob @author @nobody", false)
+	if err != nil {
+		t.Fatalf("SyncMentions() error = %v", err)
+	}
+	if len(mentions) != 2 {
+		t.Fatalf("mentions = %d, want 2", len(mentions))
+	}
+	if len(notifier.userIDs) != 0 {
+		t.Fatalf("draft should not notify: %v", notifier.userIDs)
+	}
+
+	// 公開時に下書きの間のメンションを通知する（本人は除く）
+	if _, err := s.SyncMentions(ctx, entity.MentionSourceContent, 10, 10, mentionTestAuthorID, "@bob @author", true); err != nil {
+		t.Fatalf("SyncMentions() error = %v", err)
+	}
+	if len(notifier.userIDs) != 1 || notifier.userIDs[0] != mentionTestBobID {
+		t.Fatalf("notified = %v, want [%d]", notifier.userIDs, mentionTestBobID)
+	}
+
+	// 公開後の編集では追加されたユーザーのみ通知する
+	if _, err := s.SyncMentions(ctx, entity.MentionSourceContent, 10, 10, mentionTestAuthorID, "@bob @carol", true); err != nil {
+		t.Fatalf("SyncMentions() error = %v", err)
+	}
+	if len(notifier.userIDs) != 2 || notifier.userIDs[1] != mentionTestCarolID {
+		t.Fatalf("notified = %v, want [%d %d]", notifier.userIDs, mentionTestBobID, mentionTestCarolID)
+	}
+}
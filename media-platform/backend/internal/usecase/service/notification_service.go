@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+	"media-platform/internal/usecase/dto"
+)
+
+// NotificationService はいいね・コメント・返信・フォロー・メンションの通知に関するユースケースを提供します
+// 通知の記録は各サービスから呼ばれ、失敗しても元の操作は失敗させません（ログに出力します）
+// フォロー・返信・メンションは新たに数えられた場合に受信者の設定に応じてメールでも知らせます
+type NotificationService struct {
+	notificationRepo repository.NotificationRepository
+	preferenceRepo   repository.NotificationPreferenceRepository
+	contentRepo      repository.ContentRepository
+	commentRepo      repository.CommentRepository
+	streamService    *StreamService
+	emailService     *EmailService
+}
+
+// NewNotificationService は新しいNotificationServiceのインスタンスを生成します
+func NewNotificationService(
+	notificationRepo repository.NotificationRepository,
+	preferenceRepo repository.NotificationPreferenceRepository,
+	contentRepo repository.ContentRepository,
+	commentRepo repository.CommentRepository,
+	streamService *StreamService,
+	emailService *EmailService,
+) *NotificationService {
+	return &NotificationService{
+		notificationRepo: notificationRepo,
+		preferenceRepo:   preferenceRepo,
+		contentRepo:      contentRepo,
+		commentRepo:      commentRepo,
+		streamService:    streamService,
+		emailService:     emailService,
+	}
+}
+
+// ========== 通知の記録 ==========
+
+// NotifyLiked はコンテンツの投稿者にいいねを通知します
+func (s *NotificationService) NotifyLiked(ctx context.Context, actorID, contentID int64) {
+	content, err := s.contentRepo.Find(ctx, contentID)
+	if err != nil || content == nil {
+		log.Printf("❌ いいね通知エラー: content %d: %v", contentID, err)
+		return
+	}
+
+	s.record(ctx, content.AuthorID, actorID, entity.NewNotification(
+		content.AuthorID, entity.NotificationTypeLike, entity.ContentGroupKey(contentID), &contentID, nil,
+	))
+}
+
+// NotifyCommented はコメントをコンテンツの投稿者に、返信を親コメントの投稿者に通知します
+// 返信の場合も親コメントの投稿者とコンテンツの投稿者が異なればコンテンツの投稿者に通知します
+func (s *NotificationService) NotifyCommented(ctx context.Context, comment *entity.Comment) {
+	content, err := s.contentRepo.Find(ctx, comment.ContentID)
+	if err != nil || content == nil {
+		log.Printf("❌ コメント通知エラー: content %d: %v", comment.ContentID, err)
+		return
+	}
+
+	contentID := comment.ContentID
+	commentID := comment.ID
+
+	var parentAuthorID int64
+	if comment.ParentID != nil {
+		parent, err := s.commentRepo.Find(ctx, *comment.ParentID)
+		if err != nil || parent == nil {
+			log.Printf("❌ 返信通知エラー: comment %d: %v", *comment.ParentID, err)
+		} else if !parent.IsDeleted() {
+			parentAuthorID = parent.UserID
+			if s.record(ctx, parentAuthorID, comment.UserID, entity.NewNotification(
+				parentAuthorID, entity.NotificationTypeReply, entity.CommentGroupKey(parent.ID), &contentID, &commentID,
+			)) {
+				s.emailService.SendReplyEmail(ctx, parentAuthorID, comment)
+			}
+		}
+	}
+
+	if content.AuthorID != parentAuthorID {
+		s.record(ctx, content.AuthorID, comment.UserID, entity.NewNotification(
+			content.AuthorID, entity.NotificationTypeComment, entity.ContentGroupKey(contentID), &contentID, &commentID,
+		))
+	}
+}
+
+// NotifyFollowed はフォローされたユーザーに通知します
+func (s *NotificationService) NotifyFollowed(ctx context.Context, followerID, followingID int64) {
+	if s.record(ctx, followingID, followerID, entity.NewNotification(
+		followingID, entity.NotificationTypeFollow, entity.FollowGroupKey, nil, nil,
+	)) {
+		s.emailService.SendFollowEmail(ctx, followerID, followingID)
+	}
+}
+
+// NotifyMentioned はメンションされたユーザーに通知します（MentionNotifierの実装）
+func (s *NotificationService) NotifyMentioned(ctx context.Context, event *entity.MentionEvent) error {
+	contentID := event.ContentID
+	groupKey := entity.ContentGroupKey(event.SourceID)
+	var commentID *int64
+	if event.Source == entity.MentionSourceComment {
+		sourceID := event.SourceID
+		commentID = &sourceID
+		groupKey = entity.CommentGroupKey(sourceID)
+	}
+
+	for _, userID := range event.MentionedUserIDs {
+		if s.record(ctx, userID, event.ActorID, entity.NewNotification(
+			userID, entity.NotificationTypeMention, groupKey, &contentID, commentID,
+		)) {
+			s.emailService.SendMentionEmail(ctx, userID, event)
+		}
+	}
+	return nil
+}
+
+// record は通知を記録し、新たに数えられた場合はリアルタイムに配信してtrueを返します（自分自身の操作は通知しません）
+func (s *NotificationService) record(ctx context.Context, userID, actorID int64, notification *entity.Notification) bool {
+	if userID == 0 || userID == actorID {
+		return false
+	}
+
+	added, err := s.notificationRepo.Record(ctx, notification, actorID)
+	if err != nil {
+		log.Printf("❌ 通知の記録エラー: %s to user %d by user %d: %v", notification.Type, userID, actorID, err)
+		return false
+	}
+	if added {
+		s.publish(ctx, notification.ID, userID)
+	}
+	return added
+}
+
+// publish は記録した通知を未読数とともに配信します
+func (s *NotificationService) publish(ctx context.Context, id, userID int64) {
+	notification, err := s.notificationRepo.Find(ctx, id)
+	if err != nil {
+		log.Printf("❌ 通知の配信エラー: notification %d: %v", id, err)
+		return
+	}
+	unreadCount, err := s.notificationRepo.CountByUser(ctx, userID, true)
+	if err != nil {
+		log.Printf("❌ 通知の配信エラー: notification %d: %v", id, err)
+		return
+	}
+
+	s.streamService.PublishNotification(ctx, userID, s.toNotificationResponse(notification), unreadCount)
+}
+
+// ========== 通知の取得・既読 ==========
+
+// GetNotifications はユーザーの通知一覧と未読数を取得します
+func (s *NotificationService) GetNotifications(ctx context.Context, userID int64, unreadOnly bool, limit, offset int) (*dto.NotificationListResponse, error) {
+	limit, offset = normalizeNotificationPagination(limit, offset)
+
+	notifications, err := s.notificationRepo.FindByUser(ctx, userID, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("notifications lookup failed: %w", err)
+	}
+
+	totalCount, err := s.notificationRepo.CountByUser(ctx, userID, unreadOnly)
+	if err != nil {
+		return nil, fmt.Errorf("notifications count failed: %w", err)
+	}
+
+	unreadCount := totalCount
+	if !unreadOnly {
+		unreadCount, err = s.notificationRepo.CountByUser(ctx, userID, true)
+		if err != nil {
+			return nil, fmt.Errorf("unread notifications count failed: %w", err)
+		}
+	}
+
+	responses := make([]*dto.NotificationResponse, len(notifications))
+	for i, notification := range notifications {
+		responses[i] = s.toNotificationResponse(notification)
+	}
+
+	return &dto.NotificationListResponse{
+		Notifications: responses,
+		UnreadCount:   unreadCount,
+		TotalCount:    totalCount,
+		HasMore:       int64(offset+len(notifications)) < totalCount,
+	}, nil
+}
+
+// GetUnreadCount はユーザーの未読の通知数を取得します
+func (s *NotificationService) GetUnreadCount(ctx context.Context, userID int64) (int64, error) {
+	count, err := s.notificationRepo.CountByUser(ctx, userID, true)
+	if err != nil {
+		return 0, fmt.Errorf("unread notifications count failed: %w", err)
+	}
+	return count, nil
+}
+
+// MarkRead はユーザーの通知を既読にします
+func (s *NotificationService) MarkRead(ctx context.Context, id, userID int64) error {
+	if err := s.notificationRepo.MarkRead(ctx, id, userID); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("notification mark read failed: %w", err)
+	}
+	return nil
+}
+
+// MarkAllRead はユーザーの未読の通知をすべて既読にします
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID int64) (*dto.MarkAllNotificationsReadResponse, error) {
+	marked, err := s.notificationRepo.MarkAllRead(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("notifications mark all read failed: %w", err)
+	}
+	return &dto.MarkAllNotificationsReadResponse{MarkedCount: marked}, nil
+}
+
+// ========== メール通知の設定 ==========
+
+// GetPreferences はユーザーのメール通知の設定を取得します
+func (s *NotificationService) GetPreferences(ctx context.Context, userID int64) (*dto.NotificationPreferenceResponse, error) {
+	preference, err := s.preferenceRepo.Find(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("notification preference lookup failed: %w", err)
+	}
+	return s.toNotificationPreferenceResponse(preference), nil
+}
+
+// UpdatePreferences はユーザーのメール通知の設定を更新します（指定した項目のみ）
+func (s *NotificationService) UpdatePreferences(ctx context.Context, userID int64, req *dto.UpdateNotificationPreferenceRequest) (*dto.NotificationPreferenceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	preference, err := s.preferenceRepo.Find(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("notification preference lookup failed: %w", err)
+	}
+
+	if req.EmailFollow != nil {
+		preference.EmailFollow = *req.EmailFollow
+	}
+	if req.EmailReply != nil {
+		preference.EmailReply = *req.EmailReply
+	}
+	if req.EmailMention != nil {
+		preference.EmailMention = *req.EmailMention
+	}
+	if req.EmailDigest != nil {
+		preference.EmailDigest = *req.EmailDigest
+	}
+	if req.Locale != nil {
+		preference.Locale = *req.Locale
+	}
+	preference.UpdatedAt = time.Now()
+
+	if err := s.preferenceRepo.Upsert(ctx, preference); err != nil {
+		return nil, fmt.Errorf("notification preference update failed: %w", err)
+	}
+	return s.toNotificationPreferenceResponse(preference), nil
+}
+
+// ========== ヘルパーメソッド ==========
+
+func normalizeNotificationPagination(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// Entity → DTO 変換（Service層の責務）
+func (s *NotificationService) toNotificationResponse(notification *entity.Notification) *dto.NotificationResponse {
+	response := &dto.NotificationResponse{
+		ID:           notification.ID,
+		Type:         string(notification.Type),
+		Message:      notification.Message(),
+		ContentID:    notification.ContentID,
+		CommentID:    notification.CommentID,
+		ContentTitle: notification.ContentTitle,
+		ActorCount:   notification.ActorCount,
+		IsRead:       notification.IsRead,
+		ReadAt:       notification.ReadAt,
+		CreatedAt:    notification.CreatedAt,
+		UpdatedAt:    notification.UpdatedAt,
+	}
+
+	if notification.LatestActor != nil {
+		response.Actor = &dto.UserBrief{
+			ID:       notification.LatestActor.ID,
+			Username: notification.LatestActor.Username,
+			Avatar:   notification.LatestActor.Avatar,
+		}
+	}
+	return response
+}
+
+func (s *NotificationService) toNotificationPreferenceResponse(preference *entity.NotificationPreference) *dto.NotificationPreferenceResponse {
+	return &dto.NotificationPreferenceResponse{
+		EmailFollow:  preference.EmailFollow,
+		EmailReply:   preference.EmailReply,
+		EmailMention: preference.EmailMention,
+		EmailDigest:  preference.EmailDigest,
+		Locale:       preference.Locale,
+		UpdatedAt:    preference.UpdatedAt,
+	}
+}
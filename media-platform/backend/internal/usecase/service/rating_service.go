@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"media-platform/internal/domain/entity"
 	domainErrors "media-platform/internal/domain/errors"
@@ -12,9 +13,12 @@ import (
 
 // RatingService は評価に関するアプリケーションサービスを提供します
 type RatingService struct {
-	ratingRepo  repository.RatingRepository
-	contentRepo repository.ContentRepository
-	userRepo    repository.UserRepository
+	ratingRepo          repository.RatingRepository
+	contentRepo         repository.ContentRepository
+	userRepo            repository.UserRepository
+	notificationService *NotificationService
+	streamService       *StreamService
+	eventService        *EventService
 }
 
 // NewRatingService は新しいRatingServiceのインスタンスを生成します
@@ -22,11 +26,17 @@ func NewRatingService(
 	ratingRepo repository.RatingRepository,
 	contentRepo repository.ContentRepository,
 	userRepo repository.UserRepository,
+	notificationService *NotificationService,
+	streamService *StreamService,
+	eventService *EventService,
 ) *RatingService {
 	return &RatingService{
-		ratingRepo:  ratingRepo,
-		contentRepo: contentRepo,
-		userRepo:    userRepo,
+		ratingRepo:          ratingRepo,
+		contentRepo:         contentRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+		streamService:       streamService,
+		eventService:        eventService,
 	}
 }
 
@@ -34,7 +44,7 @@ func NewRatingService(
 
 // toRatingResponse はEntityをRatingResponseに変換します
 func (s *RatingService) toRatingResponse(rating *entity.Rating) *dto.RatingResponse {
-	return &dto.RatingResponse{
+	response := &dto.RatingResponse{
 		ID:        rating.ID,
 		Value:     rating.Value,
 		UserID:    rating.UserID,
@@ -42,6 +52,25 @@ func (s *RatingService) toRatingResponse(rating *entity.Rating) *dto.RatingRespo
 		CreatedAt: rating.CreatedAt,
 		UpdatedAt: rating.UpdatedAt,
 	}
+
+	if rating.User != nil {
+		response.User = &dto.UserBrief{
+			ID:       rating.User.ID,
+			Username: rating.User.Username,
+			Avatar:   rating.User.Avatar,
+		}
+	}
+
+	return response
+}
+
+// toRatingListResponse はEntityスライスをページネーション付きの一覧レスポンスに変換します
+func (s *RatingService) toRatingListResponse(ratings []*entity.Rating, totalCount int64, offset int) *dto.RatingListResponse {
+	return &dto.RatingListResponse{
+		Ratings:    s.toRatingResponseList(ratings),
+		TotalCount: totalCount,
+		HasMore:    int64(offset+len(ratings)) < totalCount,
+	}
 }
 
 // toRatingResponseList はEntityスライスをRatingResponseスライスに変換します
@@ -55,15 +84,46 @@ func (s *RatingService) toRatingResponseList(ratings []*entity.Rating) []*dto.Ra
 
 // toRatingStatsResponse は統計情報をRatingStatsResponseに変換します
 func (s *RatingService) toRatingStatsResponse(stats *entity.RatingStats) *dto.RatingStatsResponse {
+	histogram := make(map[string]int, len(stats.Histogram))
+	for stars, count := range stats.Histogram {
+		histogram[strconv.Itoa(stars)] = count
+	}
+
 	return &dto.RatingStatsResponse{
 		ContentID: stats.ContentID,
 		LikeCount: stats.LikeCount,
 		Count:     stats.Count,
+		StarCount: stats.StarCount,
+		Average:   stats.Average,
+		Histogram: histogram,
+	}
+}
+
+// toLikeStateResponse はいいねの状態をLikeStateResponseに変換します
+func (s *RatingService) toLikeStateResponse(state *entity.LikeState) *dto.LikeStateResponse {
+	return &dto.LikeStateResponse{
+		ContentID: state.ContentID,
+		Liked:     state.Liked,
+		RatingID:  state.RatingID,
+		LikeCount: state.LikeCount,
+		Changed:   state.Changed,
+	}
+}
+
+// toStarRatingResponse は星評価をStarRatingResponseに変換します
+func (s *RatingService) toStarRatingResponse(rating *entity.StarRating) *dto.StarRatingResponse {
+	return &dto.StarRatingResponse{
+		ID:        rating.ID,
+		Stars:     rating.Stars,
+		UserID:    rating.UserID,
+		ContentID: rating.ContentID,
+		CreatedAt: rating.CreatedAt,
+		UpdatedAt: rating.UpdatedAt,
 	}
 }
 
 // toUserRatingStatusResponse はユーザー評価状態をレスポンスに変換します
-func (s *RatingService) toUserRatingStatusResponse(contentID int64, rating *entity.Rating) *dto.UserRatingStatusResponse {
+func (s *RatingService) toUserRatingStatusResponse(contentID int64, rating *entity.Rating, starRating *entity.StarRating) *dto.UserRatingStatusResponse {
 	response := &dto.UserRatingStatusResponse{
 		ContentID: contentID,
 		HasRated:  rating != nil,
@@ -74,65 +134,74 @@ func (s *RatingService) toUserRatingStatusResponse(contentID int64, rating *enti
 		response.RatingID = &rating.ID
 	}
 
+	if starRating != nil {
+		response.Stars = &starRating.Stars
+	}
+
 	return response
 }
 
 // ========== Use Cases ==========
 
 // GetRatingsByContentID は指定したコンテンツIDの評価一覧を取得します
-func (s *RatingService) GetRatingsByContentID(ctx context.Context, contentID int64, limit, offset int) ([]*dto.RatingResponse, error) {
+// includeUserがtrueの場合は評価したユーザーの概要を含めます（「いいねした人」の表示用）
+func (s *RatingService) GetRatingsByContentID(ctx context.Context, contentID int64, limit, offset int, includeUser bool) (*dto.RatingListResponse, error) {
 	// コンテンツの存在確認
 	content, err := s.contentRepo.Find(ctx, contentID)
 	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("content lookup failed: %w", err)
 	}
 	if content == nil {
 		return nil, domainErrors.NewNotFoundError("Content", contentID)
 	}
 
-	// パラメータの正規化
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-	if offset < 0 {
-		offset = 0
-	}
+	limit, offset = normalizeRatingPagination(limit, offset)
 
 	// 評価の取得
-	ratings, err := s.ratingRepo.FindByContentID(ctx, contentID)
+	ratings, err := s.ratingRepo.FindByContentID(ctx, contentID, limit, offset, includeUser)
 	if err != nil {
 		return nil, fmt.Errorf("ratings lookup failed: %w", err)
 	}
 
-	// ページネーション適用
-	start := offset
-	end := offset + limit
-	if start > len(ratings) {
-		return []*dto.RatingResponse{}, nil
-	}
-	if end > len(ratings) {
-		end = len(ratings)
-	}
-
-	// レスポンスの作成
-	return s.toRatingResponseList(ratings[start:end]), nil
+	// 総件数はトリガーで更新されるいいね数のカウンターを使用する
+	return s.toRatingListResponse(ratings, content.LikeCount, offset), nil
 }
 
 // GetRatingsByUserID は指定したユーザーIDの評価一覧を取得します
-func (s *RatingService) GetRatingsByUserID(ctx context.Context, userID int64, limit, offset int) ([]*dto.RatingResponse, error) {
+func (s *RatingService) GetRatingsByUserID(ctx context.Context, userID int64, limit, offset int, includeUser bool) (*dto.RatingListResponse, error) {
 	// ユーザーの存在確認
 	user, err := s.userRepo.Find(ctx, userID)
 	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("user lookup failed: %w", err)
 	}
 	if user == nil {
 		return nil, domainErrors.NewNotFoundError("User", userID)
 	}
 
-	// パラメータの正規化
+	limit, offset = normalizeRatingPagination(limit, offset)
+
+	// 評価の取得
+	ratings, err := s.ratingRepo.FindByUserID(ctx, userID, limit, offset, includeUser)
+	if err != nil {
+		return nil, fmt.Errorf("ratings lookup failed: %w", err)
+	}
+
+	totalCount, err := s.ratingRepo.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("ratings count failed: %w", err)
+	}
+
+	return s.toRatingListResponse(ratings, totalCount, offset), nil
+}
+
+// normalizeRatingPagination はページネーションのパラメータを正規化します
+func normalizeRatingPagination(limit, offset int) (int, int) {
 	if limit <= 0 {
 		limit = 10
 	}
@@ -142,24 +211,7 @@ func (s *RatingService) GetRatingsByUserID(ctx context.Context, userID int64, li
 	if offset < 0 {
 		offset = 0
 	}
-
-	// 評価の取得
-	ratings, err := s.ratingRepo.FindByUserID(ctx, userID)
-	if err != nil {
-		return nil, fmt.Errorf("ratings lookup failed: %w", err)
-	}
-
-	// ページネーション適用
-	start := offset
-	end := offset + limit
-	if start > len(ratings) {
-		return []*dto.RatingResponse{}, nil
-	}
-	if end > len(ratings) {
-		end = len(ratings)
-	}
-
-	return s.toRatingResponseList(ratings[start:end]), nil
+	return limit, offset
 }
 
 // GetStatsByContentID は指定したコンテンツIDの評価統計を取得します
@@ -208,92 +260,105 @@ func (s *RatingService) GetUserRatingStatus(ctx context.Context, userID, content
 		return nil, fmt.Errorf("user rating lookup failed: %w", err)
 	}
 
-	return s.toUserRatingStatusResponse(contentID, rating), nil
-}
-
-// CreateOrUpdateRating は評価の作成/削除を行います（トグル動作）
-func (s *RatingService) CreateOrUpdateRating(ctx context.Context, userID int64, req *dto.CreateRatingRequest) (*dto.RatingResponse, error) {
-	// コンテンツの存在確認
-	content, err := s.contentRepo.Find(ctx, req.ContentID)
+	starRating, err := s.ratingRepo.FindStarRating(ctx, userID, contentID)
 	if err != nil {
-		return nil, fmt.Errorf("content lookup failed: %w", err)
-	}
-	if content == nil {
-		return nil, domainErrors.NewNotFoundError("Content", req.ContentID)
+		return nil, fmt.Errorf("user star rating lookup failed: %w", err)
 	}
 
-	// ユーザーの存在確認
-	user, err := s.userRepo.Find(ctx, userID)
-	if err != nil {
-		return nil, fmt.Errorf("user lookup failed: %w", err)
-	}
-	if user == nil {
-		return nil, domainErrors.NewNotFoundError("User", userID)
-	}
+	return s.toUserRatingStatusResponse(contentID, rating, starRating), nil
+}
 
+// CreateOrUpdateRating は評価の作成/削除を行います（トグル動作）
+func (s *RatingService) CreateOrUpdateRating(ctx context.Context, userID int64, req *dto.CreateRatingRequest) (*dto.LikeStateResponse, error) {
 	// 評価値を1に強制設定（いいね機能）
 	req.Value = 1
 
 	// リクエストのバリデーション
-	if req.ContentID == 0 {
-		return nil, domainErrors.NewValidationError("コンテンツIDは必須です")
-	}
-	if req.Value != 1 {
-		return nil, domainErrors.NewValidationError("評価値は1（いいね）である必要があります")
+	if err := req.Validate(); err != nil {
+		return nil, err
 	}
 
-	// 既存の評価を検索
-	existingRating, err := s.ratingRepo.FindByUserAndContentID(ctx, userID, req.ContentID)
-	if err != nil {
-		return nil, fmt.Errorf("existing rating lookup failed: %w", err)
+	return s.ApplyLike(ctx, userID, req.ContentID, entity.LikeActionToggle)
+}
+
+// ApplyLike はいいね操作を適用し、操作後の状態といいね数を返します
+// 存在確認・変更・件数取得を1回のクエリで行うため、同時リクエストでも結果が矛盾しません
+func (s *RatingService) ApplyLike(ctx context.Context, userID, contentID int64, action entity.LikeAction) (*dto.LikeStateResponse, error) {
+	if contentID == 0 {
+		return nil, domainErrors.NewValidationError("コンテンツIDは必須です")
 	}
 
-	// トグル動作：既存評価がある場合は削除、ない場合は作成
-	if existingRating != nil {
-		// 既存の評価がある場合は削除（トグルオフ）
-		err = s.ratingRepo.Delete(ctx, existingRating.ID)
-		if err != nil {
-			return nil, fmt.Errorf("rating deletion failed: %w", err)
+	// いいねの状態が変わった場合はLikeToggledイベントを同じトランザクションで記録する
+	var state *entity.LikeState
+	err := s.eventService.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		state, err = s.ratingRepo.ApplyLike(ctx, userID, contentID, action)
+		if err != nil || !state.Changed {
+			return err
 		}
-		return nil, nil // nilを返して削除されたことを示す
+		return s.eventService.Emit(ctx, &entity.LikeToggled{
+			ContentID: contentID,
+			UserID:    state.UserID,
+			Liked:     state.Liked,
+			LikeCount: state.LikeCount,
+		})
+	})
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) || domainErrors.IsValidationError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("like operation failed: %w", err)
 	}
 
-	// 新規評価エンティティを作成
-	rating, err := entity.NewRating(userID, req.ContentID, req.Value)
-	if err != nil {
-		return nil, err
+	if state.Changed {
+		s.streamService.PublishLikeCount(ctx, contentID, state.LikeCount)
 	}
 
-	// 評価の保存
-	if err := s.ratingRepo.Create(ctx, rating); err != nil {
-		return nil, fmt.Errorf("rating creation failed: %w", err)
+	// 新たにいいねした場合のみ投稿者に通知する
+	if state.Liked && state.Changed {
+		s.notificationService.NotifyLiked(ctx, userID, contentID)
 	}
 
-	return s.toRatingResponse(rating), nil
+	return s.toLikeStateResponse(state), nil
 }
 
-// DeleteRating は評価を削除します
-func (s *RatingService) DeleteRating(ctx context.Context, id int64, userID int64, isAdmin bool) error {
-	// 評価の取得
-	rating, err := s.ratingRepo.FindByID(ctx, id)
+// DeleteRating は評価を削除し、削除後のいいね状態を返します
+func (s *RatingService) DeleteRating(ctx context.Context, id int64, userID int64, isAdmin bool) (*dto.LikeStateResponse, error) {
+	// 権限の確認と削除を1回のクエリで行い、削除した場合はLikeToggledイベントを同じトランザクションで記録する
+	var state *entity.LikeState
+	err := s.eventService.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		state, err = s.ratingRepo.DeleteLikeByID(ctx, id, userID, isAdmin)
+		if err != nil || state == nil {
+			return err
+		}
+		return s.eventService.Emit(ctx, &entity.LikeToggled{
+			ContentID: state.ContentID,
+			UserID:    state.UserID,
+			Liked:     false,
+			LikeCount: state.LikeCount,
+		})
+	})
 	if err != nil {
-		return fmt.Errorf("rating lookup failed: %w", err)
+		return nil, fmt.Errorf("rating deletion failed: %w", err)
 	}
-	if rating == nil {
-		return domainErrors.NewNotFoundError("Rating", id)
+	if state != nil {
+		s.streamService.PublishLikeCount(ctx, state.ContentID, state.LikeCount)
+		return s.toLikeStateResponse(state), nil
 	}
 
-	// 削除権限のチェック
-	if !isAdmin && rating.UserID != userID {
-		return domainErrors.NewValidationError("この評価を削除する権限がありません")
+	// 削除されなかった場合は存在しないか権限がない
+	rating, err := s.ratingRepo.FindByID(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("rating lookup failed: %w", err)
 	}
-
-	// 評価の削除
-	if err := s.ratingRepo.Delete(ctx, id); err != nil {
-		return fmt.Errorf("rating deletion failed: %w", err)
+	if rating == nil {
+		return nil, domainErrors.NewNotFoundError("Rating", id)
 	}
-
-	return nil
+	return nil, domainErrors.NewValidationError("この評価を削除する権限がありません")
 }
 
 // GetRatingsByContentIDs は複数のコンテンツIDの評価統計を一括取得します
@@ -317,11 +382,7 @@ func (s *RatingService) GetRatingsByContentIDs(ctx context.Context, contentIDs [
 	// 統計がないコンテンツに対してはゼロ値を設定
 	for _, contentID := range contentIDs {
 		if _, exists := responseMap[contentID]; !exists {
-			responseMap[contentID] = &dto.RatingStatsResponse{
-				ContentID: contentID,
-				LikeCount: 0,
-				Count:     0,
-			}
+			responseMap[contentID] = s.toRatingStatsResponse(entity.NewRatingStats(contentID, 0))
 		}
 	}
 
@@ -329,7 +390,8 @@ func (s *RatingService) GetRatingsByContentIDs(ctx context.Context, contentIDs [
 }
 
 // GetTopRatedContents は評価の高いコンテンツを取得します
-func (s *RatingService) GetTopRatedContents(ctx context.Context, limit int, days int) ([]int64, error) {
+// rankBy が bayesian の場合は星評価のベイズ平均順、それ以外はいいね数順です
+func (s *RatingService) GetTopRatedContents(ctx context.Context, limit int, days int, rankBy string) ([]int64, error) {
 	if limit <= 0 {
 		limit = 10
 	}
@@ -341,8 +403,52 @@ func (s *RatingService) GetTopRatedContents(ctx context.Context, limit int, days
 		days = 7 // デフォルト7日間
 	}
 
+	mode := entity.RatingRankMode(rankBy)
+	if mode == "" {
+		mode = entity.RatingRankByLikes
+	}
+	if !entity.IsValidRatingRankMode(mode) {
+		return nil, domainErrors.NewValidationError("sortは likes または bayesian を指定してください")
+	}
+
 	// 指定期間の評価の高いコンテンツを取得
-	return s.ratingRepo.FindTopRatedContentIDs(ctx, limit, days)
+	return s.ratingRepo.FindTopRatedContentIDs(ctx, limit, days, mode)
+}
+
+// RateWithStars はコンテンツに星評価（1〜5）を付けます（既に評価済みの場合は更新）
+func (s *RatingService) RateWithStars(ctx context.Context, userID, contentID int64, req *dto.StarRatingRequest) (*dto.StarRatingResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.contentRepo.Find(ctx, contentID); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("content lookup failed: %w", err)
+	}
+
+	rating, err := entity.NewStarRating(userID, contentID, req.Stars)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ratingRepo.UpsertStarRating(ctx, rating); err != nil {
+		return nil, fmt.Errorf("star rating save failed: %w", err)
+	}
+
+	return s.toStarRatingResponse(rating), nil
+}
+
+// RemoveStarRating は自分の星評価を取り消します
+func (s *RatingService) RemoveStarRating(ctx context.Context, userID, contentID int64) error {
+	if err := s.ratingRepo.DeleteStarRating(ctx, userID, contentID); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("star rating deletion failed: %w", err)
+	}
+	return nil
 }
 
 // GetUserLikedContentIDs はユーザーがいいねしたコンテンツIDの一覧を取得します
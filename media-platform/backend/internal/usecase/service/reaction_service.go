@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+	"media-platform/internal/usecase/dto"
+)
+
+// ReactionService は絵文字リアクションに関するユースケースを提供します
+type ReactionService struct {
+	reactionRepo repository.ReactionRepository
+	contentRepo  repository.ContentRepository
+	commentRepo  repository.CommentRepository
+}
+
+// NewReactionService は新しいReactionServiceのインスタンスを生成します
+func NewReactionService(
+	reactionRepo repository.ReactionRepository,
+	contentRepo repository.ContentRepository,
+	commentRepo repository.CommentRepository,
+) *ReactionService {
+	return &ReactionService{
+		reactionRepo: reactionRepo,
+		contentRepo:  contentRepo,
+		commentRepo:  commentRepo,
+	}
+}
+
+// ========== パレット ==========
+
+// GetPalette はリアクションのパレットを表示順で取得します
+func (s *ReactionService) GetPalette(ctx context.Context, includeInactive bool) ([]*dto.ReactionKindResponse, error) {
+	kinds, err := s.reactionRepo.FindKinds(ctx, includeInactive)
+	if err != nil {
+		return nil, fmt.Errorf("reaction kinds lookup failed: %w", err)
+	}
+
+	responses := make([]*dto.ReactionKindResponse, len(kinds))
+	for i, kind := range kinds {
+		responses[i] = s.toReactionKindResponse(kind)
+	}
+	return responses, nil
+}
+
+// CreateReactionKind はパレットにリアクションの種類を追加します（管理者用）
+func (s *ReactionService) CreateReactionKind(ctx context.Context, req *dto.CreateReactionKindRequest) (*dto.ReactionKindResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	kind, err := entity.NewReactionKind(req.Key, req.Emoji, req.Label, req.SortOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reactionRepo.CreateKind(ctx, kind); err != nil {
+		if domainErrors.IsConflictError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("reaction kind creation failed: %w", err)
+	}
+
+	return s.toReactionKindResponse(kind), nil
+}
+
+// UpdateReactionKind はリアクションの種類を更新します（管理者用）
+func (s *ReactionService) UpdateReactionKind(ctx context.Context, id int64, req *dto.UpdateReactionKindRequest) (*dto.ReactionKindResponse, error) {
+	kind, err := s.reactionRepo.FindKind(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("reaction kind lookup failed: %w", err)
+	}
+
+	emoji := kind.Emoji
+	if req.Emoji != nil {
+		emoji = *req.Emoji
+	}
+	label := kind.Label
+	if req.Label != nil {
+		label = *req.Label
+	}
+	sortOrder := kind.SortOrder
+	if req.SortOrder != nil {
+		sortOrder = *req.SortOrder
+	}
+	isActive := kind.IsActive
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	if err := kind.Update(emoji, label, sortOrder, isActive); err != nil {
+		return nil, err
+	}
+
+	if err := s.reactionRepo.UpdateKind(ctx, kind); err != nil {
+		if domainErrors.IsNotFoundError(err) || domainErrors.IsConflictError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("reaction kind update failed: %w", err)
+	}
+
+	return s.toReactionKindResponse(kind), nil
+}
+
+// DeleteReactionKind はリアクションの種類を削除します（管理者用、その種類のリアクションも削除されます）
+func (s *ReactionService) DeleteReactionKind(ctx context.Context, id int64) error {
+	if err := s.reactionRepo.DeleteKind(ctx, id); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("reaction kind deletion failed: %w", err)
+	}
+	return nil
+}
+
+// ========== リアクション ==========
+
+// ToggleReaction はコンテンツまたはコメントへのリアクションを追加/取り消しします（トグル動作）
+func (s *ReactionService) ToggleReaction(ctx context.Context, userID int64, targetType string, targetID int64, req *dto.ToggleReactionRequest) (*dto.ReactionToggleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	target := entity.ReactionTarget(targetType)
+	if err := s.ensureTargetExists(ctx, target, targetID); err != nil {
+		return nil, err
+	}
+
+	kind, err := s.reactionRepo.FindKindByKey(ctx, req.Kind)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, domainErrors.NewValidationError("無効なリアクションです")
+		}
+		return nil, fmt.Errorf("reaction kind lookup failed: %w", err)
+	}
+
+	existing, err := s.reactionRepo.Find(ctx, userID, kind.ID, target, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("existing reaction lookup failed: %w", err)
+	}
+
+	reacted := existing == nil
+	if existing != nil {
+		// 既存のリアクションがある場合は取り消し（無効化された種類でも取り消しは可能）
+		if err := s.reactionRepo.Delete(ctx, existing.ID); err != nil && !domainErrors.IsNotFoundError(err) {
+			return nil, fmt.Errorf("reaction deletion failed: %w", err)
+		}
+	} else {
+		if !kind.IsActive {
+			return nil, domainErrors.NewValidationError("このリアクションは現在使用できません")
+		}
+
+		reaction, err := entity.NewReaction(userID, kind.ID, target, targetID)
+		if err != nil {
+			return nil, err
+		}
+		// 同時リクエストで既に作成済みの場合はリアクション済みとして扱う
+		if err := s.reactionRepo.Create(ctx, reaction); err != nil && !domainErrors.IsConflictError(err) {
+			if domainErrors.IsValidationError(err) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("reaction creation failed: %w", err)
+		}
+	}
+
+	countsByTarget, err := s.countByTargets(ctx, target, []int64{targetID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ReactionToggleResponse{
+		TargetType: string(target),
+		TargetID:   targetID,
+		Kind:       kind.Key,
+		Reacted:    reacted,
+		Counts:     countsByTarget[targetID],
+	}, nil
+}
+
+// GetBulkCounts は複数の対象のリアクション数を一括取得します
+func (s *ReactionService) GetBulkCounts(ctx context.Context, req *dto.BulkReactionCountsRequest) (map[int64][]*dto.ReactionCountResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	target := entity.ReactionTarget(req.TargetType)
+	if !entity.IsValidReactionTarget(target) {
+		return nil, domainErrors.NewValidationError("target_typeは content または comment を指定してください")
+	}
+
+	return s.countByTargets(ctx, target, req.TargetIDs)
+}
+
+// ========== ヘルパーメソッド ==========
+
+// ensureTargetExists はリアクションの対象が存在するか確認します
+func (s *ReactionService) ensureTargetExists(ctx context.Context, target entity.ReactionTarget, targetID int64) error {
+	var err error
+	switch target {
+	case entity.ReactionTargetContent:
+		_, err = s.contentRepo.Find(ctx, targetID)
+	case entity.ReactionTargetComment:
+		var comment *entity.Comment
+		comment, err = s.commentRepo.Find(ctx, targetID)
+		// 墓標と承認待ちのコメントにはリアクションできない
+		if err == nil && (comment.IsDeleted() || comment.IsPending) {
+			err = domainErrors.NewNotFoundError("comment", targetID)
+		}
+	default:
+		return domainErrors.NewValidationError("リアクションの対象は content または comment である必要があります")
+	}
+
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("reaction target lookup failed: %w", err)
+	}
+	return nil
+}
+
+// countByTargets は対象ごとのリアクション数をパレットの表示順で返します（リアクションがない対象は空配列）
+func (s *ReactionService) countByTargets(ctx context.Context, target entity.ReactionTarget, targetIDs []int64) (map[int64][]*dto.ReactionCountResponse, error) {
+	kinds, err := s.reactionRepo.FindKinds(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("reaction kinds lookup failed: %w", err)
+	}
+
+	counts, err := s.reactionRepo.CountByTargets(ctx, target, targetIDs)
+	if err != nil {
+		return nil, fmt.Errorf("reaction counts lookup failed: %w", err)
+	}
+
+	countMap := make(map[int64]map[int64]int, len(targetIDs))
+	for _, count := range counts {
+		if countMap[count.TargetID] == nil {
+			countMap[count.TargetID] = make(map[int64]int)
+		}
+		countMap[count.TargetID][count.KindID] = count.Count
+	}
+
+	result := make(map[int64][]*dto.ReactionCountResponse, len(targetIDs))
+	for _, targetID := range targetIDs {
+		responses := []*dto.ReactionCountResponse{}
+		for _, kind := range kinds {
+			if count := countMap[targetID][kind.ID]; count > 0 {
+				responses = append(responses, &dto.ReactionCountResponse{
+					Key:   kind.Key,
+					Emoji: kind.Emoji,
+					Count: count,
+				})
+			}
+		}
+		result[targetID] = responses
+	}
+
+	return result, nil
+}
+
+// ========== Entity → DTO 変換 ==========
+
+func (s *ReactionService) toReactionKindResponse(kind *entity.ReactionKind) *dto.ReactionKindResponse {
+	return &dto.ReactionKindResponse{
+		ID:        kind.ID,
+		Key:       kind.Key,
+		Emoji:     kind.Emoji,
+		Label:     kind.Label,
+		SortOrder: kind.SortOrder,
+		IsActive:  kind.IsActive,
+		CreatedAt: kind.CreatedAt,
+		UpdatedAt: kind.UpdatedAt,
+	}
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+	"media-platform/internal/usecase/dto"
+)
+
+// ========== Dependencies (Interfaces) ==========
+
+// EventHub はリアルタイム配信のpub/subハブを抽象化します
+// プロセス内の実装のほか、PostgreSQLのLISTEN/NOTIFYで複数のAPIインスタンスに配信する実装に差し替えられます
+type EventHub interface {
+	// Publish はイベントにIDを割り当てて、トピックを購読しているクライアントに配信します
+	Publish(ctx context.Context, event *entity.StreamEvent) error
+	// Subscribe は指定したトピックのイベントを受け取るチャネルを返します
+	// lastEventIDより後の保持しているイベントを先に配信し、チャネルはctxの終了時などに閉じられます
+	Subscribe(ctx context.Context, topics []string, lastEventID int64) (<-chan *entity.StreamEvent, error)
+}
+
+// ========== Use Case Interactor ==========
+
+// StreamService は通知・いいね数・新しいコメントのリアルタイム配信を行います
+// 配信の失敗はログに出力するのみで、元の操作は失敗させません
+type StreamService struct {
+	hub         EventHub
+	contentRepo repository.ContentRepository
+}
+
+// NewStreamService は新しいStreamServiceのインスタンスを生成します
+func NewStreamService(hub EventHub, contentRepo repository.ContentRepository) *StreamService {
+	return &StreamService{
+		hub:         hub,
+		contentRepo: contentRepo,
+	}
+}
+
+// Subscribe はユーザー宛てのイベントと、指定したコンテンツのイベントを購読します
+// 存在しないコンテンツやユーザーが閲覧できないコンテンツ（他のユーザーの下書きなど）は購読しません
+func (s *StreamService) Subscribe(ctx context.Context, userID int64, userRole string, contentIDs []int64, lastEventID int64) (<-chan *entity.StreamEvent, error) {
+	if len(contentIDs) > entity.StreamMaxContentTopics {
+		return nil, domainErrors.NewValidationError(fmt.Sprintf("購読できるコンテンツは%d件までです", entity.StreamMaxContentTopics))
+	}
+
+	topics := make([]string, 0, len(contentIDs)+1)
+	topics = append(topics, entity.UserTopic(userID))
+	seen := make(map[int64]bool, len(contentIDs))
+	for _, contentID := range contentIDs {
+		if seen[contentID] {
+			continue
+		}
+		seen[contentID] = true
+
+		if _, err := s.findViewableContent(ctx, contentID, userID, userRole); err != nil {
+			if domainErrors.IsNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+		topics = append(topics, entity.ContentTopic(contentID))
+	}
+
+	events, err := s.hub.Subscribe(ctx, topics, lastEventID)
+	if err != nil {
+		return nil, fmt.Errorf("stream subscription failed: %w", err)
+	}
+	return events, nil
+}
+
+// SubscribeLive はコンテンツのライブスレッド（コメントの投稿・編集・削除と入力中の表示）を購読します
+// ユーザーが閲覧できないコンテンツの場合は存在しない場合と同じくNotFoundErrorを返します
+func (s *StreamService) SubscribeLive(ctx context.Context, contentID, userID int64, userRole string, lastEventID int64) (<-chan *entity.StreamEvent, error) {
+	if _, err := s.findViewableContent(ctx, contentID, userID, userRole); err != nil {
+		return nil, err
+	}
+
+	events, err := s.hub.Subscribe(ctx, []string{entity.ContentTopic(contentID), entity.LiveTopic(contentID)}, lastEventID)
+	if err != nil {
+		return nil, fmt.Errorf("live subscription failed: %w", err)
+	}
+	return events, nil
+}
+
+// findViewableContent はユーザーが閲覧できるコンテンツを取得します
+// 閲覧できない場合はコンテンツの存在を明かさないようNotFoundErrorを返します
+func (s *StreamService) findViewableContent(ctx context.Context, contentID, userID int64, userRole string) (*entity.Content, error) {
+	content, err := s.contentRepo.Find(ctx, contentID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("content lookup failed: %w", err)
+	}
+	if content == nil || !content.CanView(userID, userRole) {
+		return nil, domainErrors.NewNotFoundError("content", contentID)
+	}
+	return content, nil
+}
+
+// PublishNotification はユーザーに新しい通知と未読数を配信します
+func (s *StreamService) PublishNotification(ctx context.Context, userID int64, notification *dto.NotificationResponse, unreadCount int64) {
+	s.publish(ctx, entity.UserTopic(userID), entity.StreamEventNotification, map[string]interface{}{
+		"notification": notification,
+		"unread_count": unreadCount,
+	})
+}
+
+// PublishLikeCount はコンテンツのいいね数の変化を配信します
+func (s *StreamService) PublishLikeCount(ctx context.Context, contentID, likeCount int64) {
+	s.publish(ctx, entity.ContentTopic(contentID), entity.StreamEventLikeCount, map[string]interface{}{
+		"content_id": contentID,
+		"like_count": likeCount,
+	})
+}
+
+// PublishComment はコンテンツに投稿された新しいコメントを配信します
+func (s *StreamService) PublishComment(ctx context.Context, comment *dto.CommentResponse) {
+	s.publish(ctx, entity.ContentTopic(comment.ContentID), entity.StreamEventComment, map[string]interface{}{
+		"comment": comment,
+	})
+}
+
+// PublishCommentUpdated はコメントの編集を配信します
+func (s *StreamService) PublishCommentUpdated(ctx context.Context, comment *dto.CommentResponse) {
+	s.publish(ctx, entity.ContentTopic(comment.ContentID), entity.StreamEventCommentEdit, map[string]interface{}{
+		"comment": comment,
+	})
+}
+
+// PublishCommentDeleted はコメントの削除を配信します（tombstonedがtrueの場合は返信が残るため墓標として表示する）
+func (s *StreamService) PublishCommentDeleted(ctx context.Context, contentID, commentID int64, tombstoned bool) {
+	s.publish(ctx, entity.ContentTopic(contentID), entity.StreamEventCommentDrop, map[string]interface{}{
+		"content_id": contentID,
+		"comment_id": commentID,
+		"tombstoned": tombstoned,
+	})
+}
+
+// PublishTyping はライブスレッドで入力中のユーザーを配信します（再送はしません）
+func (s *StreamService) PublishTyping(ctx context.Context, contentID, userID int64, username string) {
+	data, err := json.Marshal(map[string]interface{}{
+		"content_id": contentID,
+		"user_id":    userID,
+		"username":   username,
+	})
+	if err != nil {
+		log.Printf("❌ 配信イベントのエンコードエラー: typing: %v", err)
+		return
+	}
+
+	event := &entity.StreamEvent{Topic: entity.LiveTopic(contentID), Type: entity.StreamEventTyping, Data: data, Ephemeral: true}
+	if err := s.hub.Publish(ctx, event); err != nil {
+		log.Printf("❌ 配信エラー: %s typing: %v", event.Topic, err)
+	}
+}
+
+func (s *StreamService) publish(ctx context.Context, topic string, eventType entity.StreamEventType, payload interface{}) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("❌ 配信イベントのエンコードエラー: %s %s: %v", topic, eventType, err)
+		return
+	}
+
+	if err := s.hub.Publish(ctx, &entity.StreamEvent{Topic: topic, Type: eventType, Data: data}); err != nil {
+		log.Printf("❌ 配信エラー: %s %s: %v", topic, eventType, err)
+	}
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+)
+
+// fakeStreamContentRepo はFindのみを実装したContentRepositoryです
+type fakeStreamContentRepo struct {
+	repository.ContentRepository
+	contents map[int64]*entity.Content
+	findErr  error
+}
+
+func (r *fakeStreamContentRepo) Find(ctx context.Context, id int64) (*entity.Content, error) {
+	if r.findErr != nil {
+		return nil, r.findErr
+	}
+	content, ok := r.contents[id]
+	if !ok {
+		return nil, domainErrors.NewNotFoundError("content", id)
+	}
+	return content, nil
+}
+
+// fakeEventHub は購読したトピックを記録するEventHubです
+type fakeEventHub struct {
+	topics []string
+}
+
+func (h *fakeEventHub) Publish(ctx context.Context, event *entity.StreamEvent) error {
+	return nil
+}
+
+func (h *fakeEventHub) Subscribe(ctx context.Context, topics []string, lastEventID int64) (<-chan *entity.StreamEvent, error) {
+	h.topics = topics
+	return make(chan *entity.StreamEvent), nil
+}
+
+const (
+	streamTestAuthorID = int64(10)
+	streamTestOtherID  = int64(20)
+)
+
+func newStreamTestService() (*StreamService, *fakeEventHub) {
+	publishedAt := time.Now().Add(-time.Hour)
+	scheduledAt := time.Now().Add(time.Hour)
+	repo := &fakeStreamContentRepo{contents: map[int64]*entity.Content{
+		1: {ID: 1, AuthorID: streamTestAuthorID, Status: entity.ContentStatusPublished, PublishedAt: &publishedAt},
+		2: {ID: 2, AuthorID: streamTestAuthorID, Status: entity.ContentStatusDraft},
+		3: {ID: 3, AuthorID: streamTestAuthorID, Status: entity.ContentStatusArchived, PublishedAt: &publishedAt},
+		4: {ID: 4, AuthorID: streamTestAuthorID, Status: entity.ContentStatusPublished, PublishedAt: &scheduledAt},
+	}}
+	hub := &fakeEventHub{}
+	return NewStreamService(hub, repo), hub
+}
+
+func TestStreamService_SubscribeLive(t *testing.T) {
+	tests := []struct {
+		name      string
+		contentID int64
+		userID    int64
+		userRole  string
+		wantFound bool
+	}{
+		{name: "公開済みは誰でも購読できる", contentID: 1, userID: streamTestOtherID, userRole: "user", wantFound: true},
+		{name: "他のユーザーの下書きは購読できない", contentID: 2, userID: streamTestOtherID, userRole: "user"},
+		{name: "他のユーザーのアーカイブは購読できない", contentID: 3, userID: streamTestOtherID, userRole: "user"},
+		{name: "公開予約中は購読できない", contentID: 4, userID: streamTestOtherID, userRole: "user"},
+		{name: "投稿者は下書きを購読できる", contentID: 2, userID: streamTestAuthorID, userRole: "user", wantFound: true},
+		{name: "管理者は下書きを購読できる", contentID: 2, userID: streamTestOtherID, userRole: "admin", wantFound: true},
+		{name: "存在しないコンテンツ", contentID: 99, userID: streamTestAuthorID, userRole: "admin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, hub := newStreamTestService()
+			_, err := s.SubscribeLive(context.Background(), tt.contentID, tt.userID, tt.userRole, 0)
+			if tt.wantFound {
+				if err != nil {
+					t.Fatalf("SubscribeLive() error = %v", err)
+				}
+				want := []string{entity.ContentTopic(tt.contentID), entity.LiveTopic(tt.contentID)}
+				if len(hub.topics) != 2 || hub.topics[0] != want[0] || hub.topics[1] != want[1] {
+					t.Fatalf("topics = %v, want %v", hub.topics, want)
+				}
+				return
+			}
+			if !domainErrors.IsNotFoundError(err) {
+				t.Fatalf("SubscribeLive() error = %v, want NotFoundError", err)
+			}
+			if hub.topics != nil {
+				t.Fatalf("subscribed to %v", hub.topics)
+			}
+		})
+	}
+}
+
+func TestStreamService_Subscribe_FiltersInvisibleContents(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   int64
+		userRole string
+		want     []int64
+	}{
+		{name: "他のユーザーは公開済みのみ", userID: streamTestOtherID, userRole: "user", want: []int64{1}},
+		{name: "投稿者は自分のコンテンツすべて", userID: streamTestAuthorID, userRole: "user", want: []int64{1, 2, 3, 4}},
+		{name: "管理者はすべて", userID: streamTestOtherID, userRole: "admin", want: []int64{1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, hub := newStreamTestService()
+			_, err := s.Subscribe(context.Background(), tt.userID, tt.userRole, []int64{1, 2, 3, 4, 99, 1}, 0)
+			if err != nil {
+				t.Fatalf("Subscribe() error = %v", err)
+			}
+
+			want := []string{entity.UserTopic(tt.userID)}
+			for _, id := range tt.want {
+				want = append(want, entity.ContentTopic(id))
+			}
+			if len(hub.topics) != len(want) {
+				t.Fatalf("topics = %v, want %v", hub.topics, want)
+			}
+			for i := range want {
+				if hub.topics[i] != want[i] {
+					t.Fatalf("topics = %v, want %v", hub.topics, want)
+				}
+			}
+		})
+	}
+}
+
+func TestStreamService_Subscribe_LookupError(t *testing.T) {
+	hub := &fakeEventHub{}
+	s := NewStreamService(hub, &fakeStreamContentRepo{findErr: errors.New("db down")})
+
+	if _, err := s.Subscribe(context.Background(), streamTestOtherID, "user", []int64{1}, 0); err == nil {
+		t.Fatal("Subscribe() expected error")
+	}
+	if hub.topics != nil {
+		t.Fatalf("subscribed to %v", hub.topics)
+	}
+}
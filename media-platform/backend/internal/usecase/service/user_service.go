@@ -2,8 +2,12 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
 	"golang.org/x/crypto/bcrypt"
 
@@ -20,22 +24,47 @@ type TokenGenerator interface {
 	ValidateToken(token string) (*entity.User, error)
 }
 
+// FileStorage はアップロードファイルの保存先を抽象化します
+type FileStorage interface {
+	// Save はファイルを保存し、配信用のURLを返します
+	Save(ctx context.Context, key string, data []byte) (string, error)
+	// Delete はURLに対応するファイルを削除します
+	Delete(ctx context.Context, url string) error
+}
+
+// ImageProcessor は画像の解析と変換を行います
+type ImageProcessor interface {
+	// DecodeConfig は画像の形式と寸法を返します
+	DecodeConfig(data []byte) (format string, width, height int, err error)
+	// CropSquare は正方形に切り抜いた画像を各サイズのJPEGで返します
+	CropSquare(data []byte, x, y, size int, outputSizes []int) (map[int][]byte, error)
+}
+
 // ========== Use Case Interactor ==========
 
 // UserService はユーザーに関するアプリケーションサービスを提供します
 type UserService struct {
 	userRepo       repository.UserRepository
 	tokenGenerator TokenGenerator
+	fileStorage    FileStorage
+	imageProcessor ImageProcessor
+	avatarURLPath  string // ファイルストレージの公開パス（アップロードしたアバターの判定に使う）
 }
 
 // NewUserService は新しいUserServiceのインスタンスを生成します
 func NewUserService(
 	userRepo repository.UserRepository,
 	tokenGenerator TokenGenerator,
+	fileStorage FileStorage,
+	imageProcessor ImageProcessor,
+	avatarURLPath string,
 ) *UserService {
 	return &UserService{
 		userRepo:       userRepo,
 		tokenGenerator: tokenGenerator,
+		fileStorage:    fileStorage,
+		imageProcessor: imageProcessor,
+		avatarURLPath:  avatarURLPath,
 	}
 }
 
@@ -106,14 +135,15 @@ func (s *UserService) IsEmailExistsExcluding(ctx context.Context, email string,
 // toUserResponse はEntityをUserResponseに変換します
 func (s *UserService) toUserResponse(user *entity.User) *dto.UserResponse {
 	return &dto.UserResponse{
-		ID:        user.ID,
-		Username:  user.Username,
-		Email:     user.Email,
-		Bio:       user.Bio,
-		Avatar:    user.Avatar,
-		Role:      user.Role,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:         user.ID,
+		Username:   user.Username,
+		Email:      user.Email,
+		Bio:        user.Bio,
+		Avatar:     user.Avatar,
+		AvatarURLs: toAvatarURLs(s.avatarURLPath, user.Avatar),
+		Role:       user.Role,
+		CreatedAt:  user.CreatedAt,
+		UpdatedAt:  user.UpdatedAt,
 	}
 }
 
@@ -167,12 +197,16 @@ func (s *UserService) RegisterUser(ctx context.Context, req *dto.CreateUserReque
 		req.Email,
 		hashedPassword,
 		req.Bio,
-		req.Avatar,
+		"",
 	)
 	if err != nil {
 		log.Printf("❌ [SERVICE 3] Entity creation failed: %v", err)
 		return nil, err
 	}
+	if err := setProfileAvatar(user, req.Avatar); err != nil {
+		log.Printf("❌ [SERVICE 3] Avatar validation failed: %v", err)
+		return nil, err
+	}
 	log.Printf("✅ [SERVICE 3] Entity created")
 
 	// ユーザーの保存
@@ -322,7 +356,7 @@ func (s *UserService) UpdateUser(ctx context.Context, id int64, req *dto.UpdateU
 	}
 
 	if req.Avatar != "" {
-		if err := user.SetAvatar(req.Avatar); err != nil {
+		if err := setProfileAvatar(user, req.Avatar); err != nil {
 			return nil, err
 		}
 	}
@@ -384,3 +418,164 @@ func (s *UserService) GetPublicUsers(ctx context.Context) ([]*dto.UserResponse,
 	// Presenterで公開用に変換するため、ここでは全情報を含める
 	return s.toUserResponseList(users), nil
 }
+
+// UpdateAvatar はアップロードされた画像を切り抜いてアバターに設定します
+func (s *UserService) UpdateAvatar(ctx context.Context, userID int64, req *dto.UpdateAvatarRequest) (*dto.UserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.Find(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user lookup failed: %w", err)
+	}
+	if user == nil {
+		return nil, domainErrors.NewNotFoundError("User", userID)
+	}
+
+	// 形式と寸法の検証（全体をデコードする前に行う）
+	format, width, height, err := s.imageProcessor.DecodeConfig(req.Data)
+	if err != nil {
+		return nil, domainErrors.NewValidationError("画像ファイルを読み込めませんでした")
+	}
+	if err := entity.ValidateAvatarSource(format, width, height); err != nil {
+		return nil, err
+	}
+
+	// 切り抜き範囲の決定（未指定の場合は中央の正方形）
+	crop := entity.NewCenteredAvatarCrop(width, height)
+	if req.CropX != nil && req.CropY != nil && req.CropSize != nil {
+		crop = entity.AvatarCrop{X: *req.CropX, Y: *req.CropY, Size: *req.CropSize}
+	}
+	if err := crop.Validate(width, height); err != nil {
+		return nil, err
+	}
+
+	images, err := s.imageProcessor.CropSquare(req.Data, crop.X, crop.Y, crop.Size, entity.AvatarSizes)
+	if err != nil {
+		return nil, fmt.Errorf("avatar processing failed: %w", err)
+	}
+
+	// 各サイズを保存
+	token, err := newAvatarToken()
+	if err != nil {
+		return nil, fmt.Errorf("avatar token generation failed: %w", err)
+	}
+
+	var savedURLs []string
+	var avatarURL string
+	for _, size := range entity.AvatarSizes {
+		url, err := s.fileStorage.Save(ctx, entity.AvatarFileKey(userID, token, size), images[size])
+		if err != nil {
+			s.deleteAvatarFiles(ctx, savedURLs)
+			return nil, fmt.Errorf("avatar save failed: %w", err)
+		}
+		savedURLs = append(savedURLs, url)
+		if size == entity.AvatarDefaultSize {
+			avatarURL = url
+		}
+	}
+
+	previousAvatar := user.Avatar
+	if err := user.SetAvatar(avatarURL); err != nil {
+		s.deleteAvatarFiles(ctx, savedURLs)
+		return nil, domainErrors.NewValidationError(err.Error())
+	}
+
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.deleteAvatarFiles(ctx, savedURLs)
+		return nil, fmt.Errorf("user update failed: %w", err)
+	}
+
+	// 差し替え前のファイルを削除
+	s.deleteAvatarFiles(ctx, avatarVariantList(s.avatarURLPath, userID, previousAvatar))
+
+	return s.toUserResponse(user), nil
+}
+
+// DeleteAvatar はアバターを削除し、アップロード済みファイルも削除します
+func (s *UserService) DeleteAvatar(ctx context.Context, userID int64) (*dto.UserResponse, error) {
+	user, err := s.userRepo.Find(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user lookup failed: %w", err)
+	}
+	if user == nil {
+		return nil, domainErrors.NewNotFoundError("User", userID)
+	}
+
+	previousAvatar := user.Avatar
+	if err := user.SetAvatar(""); err != nil {
+		return nil, domainErrors.NewValidationError(err.Error())
+	}
+
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("user update failed: %w", err)
+	}
+
+	s.deleteAvatarFiles(ctx, avatarVariantList(s.avatarURLPath, userID, previousAvatar))
+
+	return s.toUserResponse(user), nil
+}
+
+// ========== アバター関連ヘルパー ==========
+
+// deleteAvatarFiles はアバターファイルを削除します（失敗はログ出力のみ）
+func (s *UserService) deleteAvatarFiles(ctx context.Context, urls []string) {
+	for _, url := range urls {
+		if err := s.fileStorage.Delete(ctx, url); err != nil {
+			log.Printf("⚠️  Failed to delete avatar file %s: %v", url, err)
+		}
+	}
+}
+
+// setProfileAvatar はプロフィールの作成・更新で指定されたアバターURLを設定します
+// サーバー上のパスはアップロードAPIでのみ設定でき、ここでは外部URLか現在の値のみ受け付けます
+func setProfileAvatar(user *entity.User, avatar string) error {
+	if avatar == user.Avatar {
+		return nil
+	}
+	if strings.HasPrefix(avatar, "/") {
+		return domainErrors.NewValidationError("アップロードした画像はアバターのアップロードAPIから設定してください")
+	}
+	if err := user.SetAvatar(avatar); err != nil {
+		return domainErrors.NewValidationError(err.Error())
+	}
+	return nil
+}
+
+// avatarVariantList はアバターURLから削除対象となる全サイズのURLを返します
+// ユーザー自身がアップロードしたファイル（{urlPath}/avatars/{userID}/ 配下）でない場合は何も返しません
+func avatarVariantList(urlPath string, userID int64, avatarURL string) []string {
+	if !entity.IsAvatarOwnedBy(urlPath, avatarURL, userID) {
+		return nil
+	}
+	variants := entity.AvatarVariantURLs(urlPath, avatarURL)
+	urls := make([]string, 0, len(variants))
+	for _, url := range variants {
+		urls = append(urls, url)
+	}
+	return urls
+}
+
+// toAvatarURLs はアバターURLをサイズ別URLのマップに変換します（アップロードしたアバター以外はnil）
+func toAvatarURLs(urlPath, avatarURL string) map[string]string {
+	variants := entity.AvatarVariantURLs(urlPath, avatarURL)
+	if variants == nil {
+		return nil
+	}
+
+	urls := make(map[string]string, len(variants))
+	for size, url := range variants {
+		urls[strconv.Itoa(size)] = url
+	}
+	return urls
+}
+
+// newAvatarToken はファイル名用のランダムなトークンを生成します
+func newAvatarToken() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,598 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+	"media-platform/internal/usecase/dto"
+)
+
+// Webhookの配信ワーカーの設定
+const (
+	webhookDeliveryBatch       = 50 // 1回に取得する配信待ちの件数
+	webhookDeliveryConcurrency = 8  // 同時に送信するリクエスト数
+	webhookSecretBytes         = 32 // 共有シークレットのバイト数
+)
+
+// ========== Dependencies (Interfaces) ==========
+
+// WebhookSender はWebhookのペイロードの送信先（HTTPクライアント）を抽象化します
+type WebhookSender interface {
+	// Send はペイロードをPOSTし、応答を返します（応答を受け取れなかった場合のみエラー）
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) (*entity.WebhookEndpointResponse, error)
+}
+
+// ========== Use Case Interactor ==========
+
+// WebhookService はWebhookの登録・イベントの配信・配信ログに関するユースケースを提供します
+// ドメインイベントを購読しているエンドポイントごとに配信ログとして記録し、ワーカー（DeliverDue）が送信・再送します
+type WebhookService struct {
+	webhookRepo repository.WebhookRepository
+	userRepo    repository.UserRepository
+	contentRepo repository.ContentRepository
+	commentRepo repository.CommentRepository
+	sender      WebhookSender
+}
+
+// NewWebhookService は新しいWebhookServiceのインスタンスを生成します
+func NewWebhookService(
+	webhookRepo repository.WebhookRepository,
+	userRepo repository.UserRepository,
+	contentRepo repository.ContentRepository,
+	commentRepo repository.CommentRepository,
+	sender WebhookSender,
+) *WebhookService {
+	return &WebhookService{
+		webhookRepo: webhookRepo,
+		userRepo:    userRepo,
+		contentRepo: contentRepo,
+		commentRepo: commentRepo,
+		sender:      sender,
+	}
+}
+
+// ========== Webhookの管理 ==========
+
+// ListWebhooks はWebhookの一覧を取得します
+func (s *WebhookService) ListWebhooks(ctx context.Context, limit, offset int) (*dto.WebhookListResponse, error) {
+	limit, offset = normalizeWebhookPagination(limit, offset)
+
+	webhooks, err := s.webhookRepo.FindAll(ctx, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("webhooks lookup failed: %w", err)
+	}
+	total, err := s.webhookRepo.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("webhooks count failed: %w", err)
+	}
+
+	responses := make([]*dto.WebhookResponse, len(webhooks))
+	for i, webhook := range webhooks {
+		responses[i] = s.toWebhookResponse(webhook, false)
+	}
+	return &dto.WebhookListResponse{Webhooks: responses, Total: total}, nil
+}
+
+// GetWebhook はWebhookを取得します
+func (s *WebhookService) GetWebhook(ctx context.Context, id int64) (*dto.WebhookResponse, error) {
+	webhook, err := s.findWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.toWebhookResponse(webhook, false), nil
+}
+
+// CreateWebhook はWebhookを登録します（レスポンスには署名の検証に使うシークレットが含まれます）
+func (s *WebhookService) CreateWebhook(ctx context.Context, ownerID int64, req *dto.CreateWebhookRequest) (*dto.WebhookResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := entity.NewWebhook(ownerID, req.URL, toWebhookEventTypes(req.Events), req.Description, secret)
+	if err != nil {
+		return nil, domainErrors.NewValidationError(err.Error())
+	}
+
+	if err := s.webhookRepo.Create(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("webhook creation failed: %w", err)
+	}
+	return s.toWebhookResponse(webhook, true), nil
+}
+
+// UpdateWebhook はWebhookを更新します（指定した項目のみ）
+func (s *WebhookService) UpdateWebhook(ctx context.Context, id int64, req *dto.UpdateWebhookRequest) (*dto.WebhookResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.findWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := webhook.SetURL(*req.URL); err != nil {
+			return nil, domainErrors.NewValidationError(err.Error())
+		}
+	}
+	if req.Events != nil {
+		if err := webhook.SetEvents(toWebhookEventTypes(req.Events)); err != nil {
+			return nil, domainErrors.NewValidationError(err.Error())
+		}
+	}
+	if req.Description != nil {
+		if err := webhook.SetDescription(*req.Description); err != nil {
+			return nil, domainErrors.NewValidationError(err.Error())
+		}
+	}
+	if req.IsActive != nil {
+		webhook.IsActive = *req.IsActive
+		webhook.UpdatedAt = time.Now()
+	}
+
+	if err := s.webhookRepo.Update(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("webhook update failed: %w", err)
+	}
+	return s.toWebhookResponse(webhook, false), nil
+}
+
+// RotateSecret はWebhookのシークレットを再生成します（以降の配信は新しいシークレットで署名されます）
+func (s *WebhookService) RotateSecret(ctx context.Context, id int64) (*dto.WebhookResponse, error) {
+	webhook, err := s.findWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	webhook.Secret = secret
+	webhook.UpdatedAt = time.Now()
+
+	if err := s.webhookRepo.Update(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("webhook secret rotation failed: %w", err)
+	}
+	return s.toWebhookResponse(webhook, true), nil
+}
+
+// DeleteWebhook はWebhookを配信ログとともに削除します
+func (s *WebhookService) DeleteWebhook(ctx context.Context, id int64) error {
+	if err := s.webhookRepo.Delete(ctx, id); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("webhook deletion failed: %w", err)
+	}
+	return nil
+}
+
+// PingWebhook は疎通確認のpingイベントを配信待ちに追加します（購読しているイベントに関わらず送信されます）
+func (s *WebhookService) PingWebhook(ctx context.Context, id int64) (*dto.WebhookDeliveryResponse, error) {
+	webhook, err := s.findWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventID, err := generateEventID()
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.enqueue(ctx, eventID, time.Now(), entity.WebhookEventPing, []*entity.Webhook{webhook}, &dto.WebhookPingData{
+		WebhookID: webhook.ID,
+		Events:    webhookEventStrings(webhook.Events),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.toWebhookDeliveryResponse(deliveries[0]), nil
+}
+
+// ========== 配信ログ ==========
+
+// GetDeliveries はWebhookの配信ログを取得します（statusが空の場合はすべて）
+func (s *WebhookService) GetDeliveries(ctx context.Context, webhookID int64, status string, limit, offset int) (*dto.WebhookDeliveryListResponse, error) {
+	deliveryStatus := entity.WebhookDeliveryStatus(status)
+	if status != "" && !entity.IsValidWebhookDeliveryStatus(deliveryStatus) {
+		return nil, domainErrors.NewValidationError("statusはpending, succeeded, deadのいずれかを指定してください")
+	}
+	if _, err := s.findWebhook(ctx, webhookID); err != nil {
+		return nil, err
+	}
+	limit, offset = normalizeWebhookPagination(limit, offset)
+
+	deliveries, err := s.webhookRepo.FindDeliveries(ctx, webhookID, deliveryStatus, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("webhook deliveries lookup failed: %w", err)
+	}
+	total, err := s.webhookRepo.CountDeliveries(ctx, webhookID, deliveryStatus)
+	if err != nil {
+		return nil, fmt.Errorf("webhook deliveries count failed: %w", err)
+	}
+
+	responses := make([]*dto.WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		responses[i] = s.toWebhookDeliveryResponse(delivery)
+	}
+	return &dto.WebhookDeliveryListResponse{
+		Deliveries: responses,
+		Total:      total,
+		HasMore:    int64(offset+len(deliveries)) < total,
+	}, nil
+}
+
+// RedeliverDelivery はデッドレターまたは成功した配信をもう一度配信待ちに戻します
+func (s *WebhookService) RedeliverDelivery(ctx context.Context, webhookID, deliveryID int64) (*dto.WebhookDeliveryResponse, error) {
+	delivery, err := s.webhookRepo.FindDelivery(ctx, deliveryID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("webhook delivery lookup failed: %w", err)
+	}
+	if delivery.WebhookID != webhookID {
+		return nil, domainErrors.NewNotFoundError("webhook delivery", deliveryID)
+	}
+
+	if err := delivery.Redeliver(); err != nil {
+		return nil, domainErrors.NewConflictError("webhook delivery", err.Error())
+	}
+	if err := s.webhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("webhook delivery update failed: %w", err)
+	}
+	return s.toWebhookDeliveryResponse(delivery), nil
+}
+
+// ========== イベントの発行 ==========
+
+// WebhookDomainEvents はWebhookに配信するために購読するドメインイベントの種類です
+var WebhookDomainEvents = []entity.DomainEventType{
+	entity.DomainEventContentPublished,
+	entity.DomainEventCommentCreated,
+	entity.DomainEventCommentApproved,
+	entity.DomainEventUserFollowed,
+}
+
+// HandleEvent はドメインイベントを購読しているWebhookへの配信待ちに変換します（EventServiceの購読者）
+// WebhookのイベントIDにはドメインイベントのIDを使うため、同じイベントが再配信されても配信は重複しません
+func (s *WebhookService) HandleEvent(ctx context.Context, event *entity.OutboxEvent) error {
+	domainEvent, err := event.Decode()
+	if err != nil {
+		return err
+	}
+
+	switch e := domainEvent.(type) {
+	case *entity.ContentPublished:
+		return s.publish(ctx, event, entity.WebhookEventContentPublished, func() (interface{}, error) {
+			return s.contentData(ctx, e.ContentID)
+		})
+	case *entity.CommentCreated:
+		// 承認待ちのコメントは承認時（CommentApproved）に配信する
+		if e.IsPending {
+			return nil
+		}
+		return s.publish(ctx, event, entity.WebhookEventCommentCreated, func() (interface{}, error) {
+			return s.commentData(ctx, e.CommentID)
+		})
+	case *entity.CommentApproved:
+		return s.publish(ctx, event, entity.WebhookEventCommentCreated, func() (interface{}, error) {
+			return s.commentData(ctx, e.CommentID)
+		})
+	case *entity.UserFollowed:
+		return s.publish(ctx, event, entity.WebhookEventUserFollowed, func() (interface{}, error) {
+			return &dto.WebhookFollowData{
+				FollowerID:        e.FollowerID,
+				FollowerUsername:  s.username(ctx, e.FollowerID),
+				FollowingID:       e.FollowingID,
+				FollowingUsername: s.username(ctx, e.FollowingID),
+				CreatedAt:         event.OccurredAt,
+			}, nil
+		})
+	}
+	return nil
+}
+
+// publish はイベントを購読しているWebhookごとに配信待ちを作成します
+// 購読しているWebhookがない場合はペイロードのデータを読み込みません。対象が削除済みの場合は配信しません
+func (s *WebhookService) publish(ctx context.Context, event *entity.OutboxEvent, eventType entity.WebhookEventType, load func() (interface{}, error)) error {
+	webhooks, err := s.webhookRepo.FindSubscribed(ctx, eventType)
+	if err != nil {
+		return fmt.Errorf("subscribed webhooks lookup failed: %w", err)
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	data, err := load()
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	_, err = s.enqueue(ctx, event.EventID, event.OccurredAt, eventType, webhooks, data)
+	return err
+}
+
+// contentData は content.published のデータを作成します
+func (s *WebhookService) contentData(ctx context.Context, contentID int64) (*dto.WebhookContentData, error) {
+	content, err := s.contentRepo.Find(ctx, contentID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("content lookup failed: %w", err)
+	}
+	if content == nil {
+		return nil, domainErrors.NewNotFoundError("Content", contentID)
+	}
+
+	tags := content.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return &dto.WebhookContentData{
+		ID:          content.ID,
+		Title:       content.Title,
+		Body:        content.Body,
+		Type:        string(content.Type),
+		Genre:       content.Genre,
+		Tags:        tags,
+		AuthorID:    content.AuthorID,
+		AuthorName:  s.username(ctx, content.AuthorID),
+		CategoryID:  content.CategoryID,
+		WorkID:      content.WorkID,
+		ReviewScore: content.ReviewScore,
+		PublishedAt: content.PublishedAt,
+	}, nil
+}
+
+// commentData は comment.created のデータを作成します（削除済みのコメントはNotFound）
+func (s *WebhookService) commentData(ctx context.Context, commentID int64) (*dto.WebhookCommentData, error) {
+	comment, err := s.commentRepo.Find(ctx, commentID)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("comment lookup failed: %w", err)
+	}
+	if comment == nil || comment.IsDeleted() {
+		return nil, domainErrors.NewNotFoundError("Comment", commentID)
+	}
+
+	return &dto.WebhookCommentData{
+		ID:        comment.ID,
+		ContentID: comment.ContentID,
+		ParentID:  comment.ParentID,
+		UserID:    comment.UserID,
+		Username:  s.username(ctx, comment.UserID),
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+	}, nil
+}
+
+// enqueue はイベントのペイロードを作成し、Webhookごとに配信待ちとして保存します
+func (s *WebhookService) enqueue(ctx context.Context, eventID string, occurredAt time.Time, eventType entity.WebhookEventType, webhooks []*entity.Webhook, data interface{}) ([]*entity.WebhookDelivery, error) {
+	payload, err := json.Marshal(&dto.WebhookEventPayload{
+		ID:        eventID,
+		Type:      string(eventType),
+		CreatedAt: occurredAt.UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("webhook payload encoding failed: %w", err)
+	}
+
+	deliveries := make([]*entity.WebhookDelivery, len(webhooks))
+	for i, webhook := range webhooks {
+		deliveries[i] = entity.NewWebhookDelivery(webhook.ID, eventID, eventType, payload)
+	}
+	if err := s.webhookRepo.CreateDeliveries(ctx, deliveries); err != nil {
+		return nil, fmt.Errorf("webhook deliveries creation failed: %w", err)
+	}
+	return deliveries, nil
+}
+
+// ========== 配信ワーカー ==========
+
+// DeliverDue は予定日時を過ぎた配信待ちを送信し、送信を試みた件数を返します（定期ジョブ用）
+// 2xx以外の応答や接続エラーは指数バックオフで再送を予約し、上限に達したらデッドレターにします
+func (s *WebhookService) DeliverDue(ctx context.Context) (int, error) {
+	attempted := 0
+	for {
+		now := time.Now()
+		deliveries, err := s.webhookRepo.ClaimDueDeliveries(ctx, now, now.Add(entity.WebhookDeliveryLease), webhookDeliveryBatch)
+		if err != nil {
+			return attempted, fmt.Errorf("webhook deliveries claim failed: %w", err)
+		}
+		if len(deliveries) == 0 {
+			return attempted, nil
+		}
+
+		webhooks := map[int64]*entity.Webhook{}
+		for _, delivery := range deliveries {
+			if _, ok := webhooks[delivery.WebhookID]; ok {
+				continue
+			}
+			webhook, err := s.webhookRepo.Find(ctx, delivery.WebhookID)
+			if err != nil && !domainErrors.IsNotFoundError(err) {
+				return attempted, fmt.Errorf("webhook lookup failed: %w", err)
+			}
+			webhooks[delivery.WebhookID] = webhook
+		}
+
+		var wg sync.WaitGroup
+		semaphore := make(chan struct{}, webhookDeliveryConcurrency)
+		for _, delivery := range deliveries {
+			webhook := webhooks[delivery.WebhookID]
+			if webhook == nil {
+				continue // 取得後にWebhookが削除された（配信ログも削除済み）
+			}
+
+			wg.Add(1)
+			semaphore <- struct{}{}
+			go func(delivery *entity.WebhookDelivery) {
+				defer wg.Done()
+				defer func() { <-semaphore }()
+				s.deliver(ctx, webhook, delivery)
+			}(delivery)
+			attempted++
+		}
+		wg.Wait()
+
+		if len(deliveries) < webhookDeliveryBatch {
+			return attempted, nil
+		}
+	}
+}
+
+// deliver は1件の配信を送信し、結果を配信ログに保存します
+func (s *WebhookService) deliver(ctx context.Context, webhook *entity.Webhook, delivery *entity.WebhookDelivery) {
+	now := time.Now()
+	if !webhook.IsActive {
+		delivery.DeadLetter("webhook is disabled", now)
+	} else {
+		headers := map[string]string{
+			entity.WebhookHeaderEvent:     string(delivery.EventType),
+			entity.WebhookHeaderDelivery:  strconv.FormatInt(delivery.ID, 10),
+			entity.WebhookHeaderTimestamp: strconv.FormatInt(now.Unix(), 10),
+			entity.WebhookHeaderSignature: webhook.Sign(now, delivery.Payload),
+		}
+
+		response, err := s.sender.Send(ctx, webhook.URL, headers, delivery.Payload)
+		switch {
+		case err != nil:
+			delivery.Fail(nil, "", err.Error(), time.Now())
+		case response.IsSuccess():
+			delivery.Succeed(response.StatusCode, response.Body, time.Now())
+		default:
+			delivery.Fail(&response.StatusCode, response.Body, fmt.Sprintf("unexpected status %d", response.StatusCode), time.Now())
+		}
+	}
+
+	if delivery.Status == entity.WebhookDeliveryDead {
+		log.Printf("⚠️  Webhookの配信をデッドレターにしました: delivery %d to webhook %d: %s", delivery.ID, webhook.ID, delivery.LastError)
+	}
+	if err := s.webhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+		log.Printf("❌ Webhookの配信ログの保存エラー: delivery %d: %v", delivery.ID, err)
+	}
+}
+
+// ========== ヘルパーメソッド ==========
+
+func (s *WebhookService) findWebhook(ctx context.Context, id int64) (*entity.Webhook, error) {
+	webhook, err := s.webhookRepo.Find(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("webhook lookup failed: %w", err)
+	}
+	return webhook, nil
+}
+
+// username はペイロードに含めるユーザー名を取得します（取得できない場合は空）
+func (s *WebhookService) username(ctx context.Context, userID int64) string {
+	user, err := s.userRepo.Find(ctx, userID)
+	if err != nil || user == nil {
+		return ""
+	}
+	return user.Username
+}
+
+// normalizeWebhookPagination はWebhook一覧・配信ログのページネーションのパラメータを正規化します
+func normalizeWebhookPagination(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("webhook secret generation failed: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+func toWebhookEventTypes(events []string) []entity.WebhookEventType {
+	types := make([]entity.WebhookEventType, len(events))
+	for i, event := range events {
+		types[i] = entity.WebhookEventType(event)
+	}
+	return types
+}
+
+func webhookEventStrings(events []entity.WebhookEventType) []string {
+	strs := make([]string, len(events))
+	for i, event := range events {
+		strs[i] = string(event)
+	}
+	return strs
+}
+
+// Entity → DTO 変換（Service層の責務）
+func (s *WebhookService) toWebhookResponse(webhook *entity.Webhook, includeSecret bool) *dto.WebhookResponse {
+	response := &dto.WebhookResponse{
+		ID:          webhook.ID,
+		OwnerID:     webhook.OwnerID,
+		URL:         webhook.URL,
+		Events:      webhookEventStrings(webhook.Events),
+		Description: webhook.Description,
+		IsActive:    webhook.IsActive,
+		CreatedAt:   webhook.CreatedAt,
+		UpdatedAt:   webhook.UpdatedAt,
+	}
+	if includeSecret {
+		response.Secret = webhook.Secret
+	}
+	return response
+}
+
+func (s *WebhookService) toWebhookDeliveryResponse(delivery *entity.WebhookDelivery) *dto.WebhookDeliveryResponse {
+	response := &dto.WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		EventID:        delivery.EventID,
+		EventType:      string(delivery.EventType),
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+		Payload:        json.RawMessage(delivery.Payload),
+	}
+	if delivery.Status == entity.WebhookDeliveryPending {
+		nextAttemptAt := delivery.NextAttemptAt
+		response.NextAttemptAt = &nextAttemptAt
+	}
+	return response
+}
@@ -0,0 +1,424 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"media-platform/internal/domain/entity"
+	domainErrors "media-platform/internal/domain/errors"
+	"media-platform/internal/domain/repository"
+	"media-platform/internal/usecase/dto"
+)
+
+const (
+	// workReviewLimit は作品詳細に含めるレビューの最大件数です
+	workReviewLimit = 50
+
+	// workLookupLimit はルックアップで返す候補・登録済み作品の最大件数です
+	workLookupLimit = 10
+)
+
+// ========== Dependencies (Interfaces) ==========
+
+// MetadataProvider は外部の作品メタデータ取得元（TMDB・MusicBrainzなど）を抽象化します
+type MetadataProvider interface {
+	// Name はプロバイダー名を返します（キャッシュのキーに使用）
+	Name() string
+	// SearchByTitle はタイトルで作品を検索します（workTypeが空の場合は全種類）
+	SearchByTitle(ctx context.Context, workType entity.ContentType, title string, limit int) ([]*entity.WorkMetadata, error)
+	// LookupByID は外部ID（isbn, jan など）で作品を取得します（見つからない場合はnil）
+	LookupByID(ctx context.Context, idType, id string) (*entity.WorkMetadata, error)
+}
+
+// ========== Use Case Interactor ==========
+
+// WorkService は作品カタログに関するユースケースを提供します
+type WorkService struct {
+	workRepo          repository.WorkRepository
+	contentRepo       repository.ContentRepository
+	metadataCacheRepo repository.MetadataCacheRepository
+	scoreAxisRepo     repository.ScoreAxisRepository
+	metadataProvider  MetadataProvider
+}
+
+// NewWorkService は新しいWorkServiceのインスタンスを生成します
+func NewWorkService(
+	workRepo repository.WorkRepository,
+	contentRepo repository.ContentRepository,
+	metadataCacheRepo repository.MetadataCacheRepository,
+	scoreAxisRepo repository.ScoreAxisRepository,
+	metadataProvider MetadataProvider,
+) *WorkService {
+	return &WorkService{
+		workRepo:          workRepo,
+		contentRepo:       contentRepo,
+		metadataCacheRepo: metadataCacheRepo,
+		scoreAxisRepo:     scoreAxisRepo,
+		metadataProvider:  metadataProvider,
+	}
+}
+
+// GetWorkDetail は作品情報と、紐づくレビュー・平均スコア・おすすめ度分布・軸別スコア集計を取得します
+func (s *WorkService) GetWorkDetail(ctx context.Context, id int64) (*dto.WorkDetailResponse, error) {
+	work, err := s.workRepo.Find(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("work lookup failed: %w", err)
+	}
+
+	stats, err := s.workRepo.GetReviewStats(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("work review stats lookup failed: %w", err)
+	}
+
+	axes, err := s.scoreAxisRepo.FindByType(ctx, work.Type)
+	if err != nil {
+		return nil, fmt.Errorf("score axes lookup failed: %w", err)
+	}
+	axisStats, err := s.scoreAxisRepo.GetWorkAxisStats(ctx, id, axes)
+	if err != nil {
+		return nil, fmt.Errorf("work axis stats lookup failed: %w", err)
+	}
+
+	reviews, err := s.contentRepo.FindByWork(ctx, id, workReviewLimit, 0)
+	if err != nil {
+		return nil, fmt.Errorf("work reviews lookup failed: %w", err)
+	}
+
+	reviewResponses := make([]*dto.ContentResponse, len(reviews))
+	for i, review := range reviews {
+		reviewResponses[i] = s.toContentResponse(review)
+	}
+
+	return &dto.WorkDetailResponse{
+		Work:    s.toWorkResponse(work),
+		Stats:   s.toWorkReviewStatsResponse(stats, axes, axisStats),
+		Reviews: reviewResponses,
+	}, nil
+}
+
+// SearchWorks はキーワード・種類で作品を検索します
+func (s *WorkService) SearchWorks(ctx context.Context, query *dto.WorkQuery) ([]*dto.WorkResponse, error) {
+	if query.Limit <= 0 {
+		query.Limit = 20
+	}
+	if query.Limit > 100 {
+		query.Limit = 100
+	}
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+
+	works, err := s.workRepo.Search(ctx, query.Keyword, entity.ContentType(query.Type), query.Limit, query.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("work search failed: %w", err)
+	}
+
+	responses := make([]*dto.WorkResponse, len(works))
+	for i, work := range works {
+		responses[i] = s.toWorkResponse(work)
+	}
+	return responses, nil
+}
+
+// CreateWork は新しい作品を登録します
+func (s *WorkService) CreateWork(ctx context.Context, req *dto.CreateWorkRequest) (*dto.WorkResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	work, err := entity.NewWork(
+		entity.ContentType(req.Type),
+		req.Title,
+		req.AlternateTitles,
+		req.Creator,
+		req.ReleaseYear,
+		req.ExternalIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.workRepo.Create(ctx, work); err != nil {
+		if domainErrors.IsConflictError(err) || domainErrors.IsValidationError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("work creation failed: %w", err)
+	}
+
+	return s.toWorkResponse(work), nil
+}
+
+// UpdateWork は作品情報を更新します
+func (s *WorkService) UpdateWork(ctx context.Context, id int64, req *dto.UpdateWorkRequest) (*dto.WorkResponse, error) {
+	work, err := s.workRepo.Find(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("work lookup failed: %w", err)
+	}
+
+	// 未指定のフィールドは現在の値を維持
+	workType, title, creator := work.Type, work.Title, work.Creator
+	alternateTitles, releaseYear, externalIDs := work.AlternateTitles, work.ReleaseYear, work.ExternalIDs
+	if req.Type != nil {
+		workType = entity.ContentType(*req.Type)
+	}
+	if req.Title != nil {
+		title = *req.Title
+	}
+	if req.AlternateTitles != nil {
+		alternateTitles = *req.AlternateTitles
+	}
+	if req.Creator != nil {
+		creator = *req.Creator
+	}
+	if req.ReleaseYear != nil {
+		releaseYear = req.ReleaseYear
+	}
+	if req.ExternalIDs != nil {
+		externalIDs = *req.ExternalIDs
+	}
+
+	if err := work.Update(workType, title, alternateTitles, creator, releaseYear, externalIDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.workRepo.Update(ctx, work); err != nil {
+		if domainErrors.IsNotFoundError(err) || domainErrors.IsConflictError(err) || domainErrors.IsValidationError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("work update failed: %w", err)
+	}
+
+	return s.toWorkResponse(work), nil
+}
+
+// DeleteWork は作品を削除します（紐づくレビューは残り、作品の紐付けのみ解除されます）
+func (s *WorkService) DeleteWork(ctx context.Context, id int64) error {
+	if err := s.workRepo.Delete(ctx, id); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return err
+		}
+		return fmt.Errorf("work deletion failed: %w", err)
+	}
+	return nil
+}
+
+// LookupWorks はレビュー作成時の入力補完用に作品を検索します
+// カタログ登録済みの作品と、外部メタデータの候補（DBにキャッシュ）を返します
+func (s *WorkService) LookupWorks(ctx context.Context, req *dto.WorkLookupRequest) (*dto.WorkLookupResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	workType := entity.ContentType(req.Type)
+
+	// ISBN・JANの場合は外部IDで検索
+	idType, idValue, isIdentifier := entity.DetectExternalIDType(req.Query)
+
+	// カタログ登録済みの作品
+	var works []*entity.Work
+	if isIdentifier {
+		work, err := s.workRepo.FindByExternalID(ctx, idType, idValue)
+		if err != nil && !domainErrors.IsNotFoundError(err) {
+			return nil, fmt.Errorf("work lookup by external id failed: %w", err)
+		}
+		if work != nil {
+			works = append(works, work)
+		}
+	} else {
+		found, err := s.workRepo.Search(ctx, req.Query, workType, workLookupLimit, 0)
+		if err != nil {
+			return nil, fmt.Errorf("work search failed: %w", err)
+		}
+		works = found
+	}
+
+	// 外部メタデータの候補（キャッシュ優先）
+	var lookupKey string
+	if isIdentifier {
+		lookupKey = idType + ":" + idValue
+	} else {
+		lookupKey = entity.MetadataLookupKey(workType, req.Query)
+	}
+	candidates, cached, err := s.lookupMetadata(ctx, lookupKey, func() ([]*entity.WorkMetadata, error) {
+		if isIdentifier {
+			metadata, err := s.metadataProvider.LookupByID(ctx, idType, idValue)
+			if err != nil || metadata == nil {
+				return nil, err
+			}
+			return []*entity.WorkMetadata{metadata}, nil
+		}
+		return s.metadataProvider.SearchByTitle(ctx, workType, req.Query, workLookupLimit)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.WorkLookupResponse{
+		Query:      req.Query,
+		Cached:     cached,
+		Works:      make([]*dto.WorkResponse, len(works)),
+		Candidates: make([]*dto.WorkCandidateResponse, len(candidates)),
+	}
+	for i, work := range works {
+		response.Works[i] = s.toWorkResponse(work)
+	}
+	for i, candidate := range candidates {
+		response.Candidates[i] = s.toWorkCandidateResponse(candidate)
+	}
+	return response, nil
+}
+
+// lookupMetadata はキャッシュを確認し、なければfetchで取得してキャッシュに保存します
+// キャッシュの読み書きに失敗しても検索自体は継続します
+func (s *WorkService) lookupMetadata(
+	ctx context.Context,
+	lookupKey string,
+	fetch func() ([]*entity.WorkMetadata, error),
+) ([]*entity.WorkMetadata, bool, error) {
+	provider := s.metadataProvider.Name()
+
+	results, found, err := s.metadataCacheRepo.Get(ctx, provider, lookupKey)
+	if err != nil {
+		log.Printf("⚠️  Metadata cache read failed: %v", err)
+	} else if found {
+		return results, true, nil
+	}
+
+	results, err = fetch()
+	if err != nil {
+		return nil, false, fmt.Errorf("metadata lookup failed: %w", err)
+	}
+
+	if err := s.metadataCacheRepo.Put(ctx, provider, lookupKey, results, entity.MetadataLookupTTL); err != nil {
+		log.Printf("⚠️  Metadata cache write failed: %v", err)
+	}
+
+	return results, false, nil
+}
+
+// ========== Entity → DTO 変換 ==========
+
+func (s *WorkService) toWorkCandidateResponse(metadata *entity.WorkMetadata) *dto.WorkCandidateResponse {
+	externalIDs := metadata.ExternalIDs
+	if externalIDs == nil {
+		externalIDs = map[string]string{}
+	}
+
+	return &dto.WorkCandidateResponse{
+		Source:      metadata.Source,
+		SourceID:    metadata.SourceID,
+		Type:        string(metadata.Type),
+		Title:       metadata.Title,
+		Creator:     metadata.Creator,
+		ReleaseYear: metadata.ReleaseYear,
+		CoverURL:    metadata.CoverURL,
+		ExternalIDs: externalIDs,
+	}
+}
+
+func (s *WorkService) toWorkResponse(work *entity.Work) *dto.WorkResponse {
+	alternateTitles := work.AlternateTitles
+	if alternateTitles == nil {
+		alternateTitles = []string{}
+	}
+	externalIDs := work.ExternalIDs
+	if externalIDs == nil {
+		externalIDs = map[string]string{}
+	}
+
+	return &dto.WorkResponse{
+		ID:              work.ID,
+		Type:            string(work.Type),
+		Title:           work.Title,
+		AlternateTitles: alternateTitles,
+		Creator:         work.Creator,
+		ReleaseYear:     work.ReleaseYear,
+		ExternalIDs:     externalIDs,
+		CreatedAt:       work.CreatedAt,
+		UpdatedAt:       work.UpdatedAt,
+	}
+}
+
+func (s *WorkService) toWorkReviewStatsResponse(
+	stats *entity.WorkReviewStats,
+	axes []*entity.ScoreAxis,
+	axisStats []*entity.AxisScoreStats,
+) *dto.WorkReviewStatsResponse {
+	distribution := make(map[string]int, len(stats.RecommendationDistribution))
+	for level, count := range stats.RecommendationDistribution {
+		distribution[string(level)] = count
+	}
+
+	// レーダーチャートには各軸の平均値を使う
+	averages := make(map[int64]float64, len(axisStats))
+	axisStatsResponses := make([]*dto.AxisScoreStatsResponse, len(axisStats))
+	for i, axisStat := range axisStats {
+		if axisStat.Average != nil {
+			averages[axisStat.AxisID] = *axisStat.Average
+		}
+		buckets := make(map[string]int, len(axisStat.Distribution))
+		for bucket, count := range axisStat.Distribution {
+			buckets[strconv.Itoa(bucket)] = count
+		}
+		axisStatsResponses[i] = &dto.AxisScoreStatsResponse{
+			Key:          axisStat.Key,
+			Label:        axisStat.Label,
+			Count:        axisStat.Count,
+			Average:      axisStat.Average,
+			Distribution: buckets,
+		}
+	}
+
+	response := &dto.WorkReviewStatsResponse{
+		ReviewCount:                stats.ReviewCount,
+		ScoredCount:                stats.ScoredCount,
+		AverageScore:               stats.AverageScore,
+		RecommendationDistribution: distribution,
+		AxisStats:                  axisStatsResponses,
+	}
+	if len(axes) > 0 {
+		response.Radar = toRadarChartResponse(entity.NewRadarChart(axes, averages))
+	}
+	return response
+}
+
+// toContentResponse はレビュー（コンテンツ）をContentResponseに変換します
+func (s *WorkService) toContentResponse(content *entity.Content) *dto.ContentResponse {
+	return &dto.ContentResponse{
+		ID:           content.ID,
+		Title:        content.Title,
+		Body:         content.Body,
+		Type:         string(content.Type),
+		Genre:        content.Genre,
+		Status:       string(content.Status),
+		AuthorID:     content.AuthorID,
+		CategoryID:   content.CategoryID,
+		ViewCount:    content.ViewCount,
+		LikeCount:    content.LikeCount,
+		CommentCount: content.CommentCount,
+		CreatedAt:    content.CreatedAt,
+		UpdatedAt:    content.UpdatedAt,
+		PublishedAt:  content.PublishedAt,
+
+		WorkID:              content.WorkID,
+		ReviewScore:         content.ReviewScore,
+		RecommendationLevel: string(content.RecommendationLevel),
+
+		ArtistName:  content.ArtistName,
+		ReleaseYear: content.ReleaseYear,
+		ImageURL:    content.ImageURL,
+		ExternalURL: content.ExternalURL,
+		Tags:        content.Tags,
+
+		CommentPolicy: string(content.CommentPolicy),
+
+		Metadata: content.Metadata,
+	}
+}
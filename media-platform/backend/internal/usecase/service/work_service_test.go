@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"media-platform/internal/domain/entity"
+)
+
+// fakeMetadataCache はメモリ上のMetadataCacheRepositoryです
+type fakeMetadataCache struct {
+	entries  map[string][]*entity.WorkMetadata
+	ttls     map[string]time.Duration
+	getErr   error
+	putErr   error
+	putCalls int
+}
+
+func newFakeMetadataCache() *fakeMetadataCache {
+	return &fakeMetadataCache{
+		entries: map[string][]*entity.WorkMetadata{},
+		ttls:    map[string]time.Duration{},
+	}
+}
+
+func (c *fakeMetadataCache) Get(ctx context.Context, provider, lookupKey string) ([]*entity.WorkMetadata, bool, error) {
+	if c.getErr != nil {
+		return nil, false, c.getErr
+	}
+	results, ok := c.entries[provider+"|"+lookupKey]
+	return results, ok, nil
+}
+
+func (c *fakeMetadataCache) Put(ctx context.Context, provider, lookupKey string, results []*entity.WorkMetadata, ttl time.Duration) error {
+	c.putCalls++
+	if c.putErr != nil {
+		return c.putErr
+	}
+	c.entries[provider+"|"+lookupKey] = results
+	c.ttls[provider+"|"+lookupKey] = ttl
+	return nil
+}
+
+func (c *fakeMetadataCache) DeleteExpired(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+// fakeMetadataProvider は呼び出し回数を数えるMetadataProviderです
+type fakeMetadataProvider struct {
+	results     []*entity.WorkMetadata
+	err         error
+	searchCalls int
+}
+
+func (p *fakeMetadataProvider) Name() string { return "fake" }
+
+func (p *fakeMetadataProvider) SearchByTitle(ctx context.Context, workType entity.ContentType, title string, limit int) ([]*entity.WorkMetadata, error) {
+	p.searchCalls++
+	return p.results, p.err
+}
+
+func (p *fakeMetadataProvider) LookupByID(ctx context.Context, idType, id string) (*entity.WorkMetadata, error) {
+	return nil, p.err
+}
+
+func TestWorkService_LookupMetadataCache(t *testing.T) {
+	fetched := []*entity.WorkMetadata{{Source: "fake", SourceID: "1", Title: "取得した作品"}}
+	cachedResults := []*entity.WorkMetadata{{Source: "fake", SourceID: "2", Title: "キャッシュ済みの作品"}}
+	const lookupKey = "映画:千と千尋"
+
+	tests := []struct {
+		name        string
+		setup       func(cache *fakeMetadataCache, provider *fakeMetadataProvider)
+		wantTitle   string
+		wantCached  bool
+		wantErr     bool
+		wantFetches int
+		wantStored  bool // キャッシュに保存されていること
+	}{
+		{
+			name:        "キャッシュがなければ取得して保存する",
+			wantTitle:   "取得した作品",
+			wantFetches: 1,
+			wantStored:  true,
+		},
+		{
+			name: "キャッシュがあればプロバイダーを呼ばない",
+			setup: func(cache *fakeMetadataCache, provider *fakeMetadataProvider) {
+				cache.entries["fake|"+lookupKey] = cachedResults
+			},
+			wantTitle:  "キャッシュ済みの作品",
+			wantCached: true,
+			wantStored: true,
+		},
+		{
+			name: "別のプロバイダーのキャッシュは使わない",
+			setup: func(cache *fakeMetadataCache, provider *fakeMetadataProvider) {
+				cache.entries["other|"+lookupKey] = cachedResults
+			},
+			wantTitle:   "取得した作品",
+			wantFetches: 1,
+			wantStored:  true,
+		},
+		{
+			name: "キャッシュの読み込みに失敗しても取得する",
+			setup: func(cache *fakeMetadataCache, provider *fakeMetadataProvider) {
+				cache.getErr = errors.New("read failed")
+			},
+			wantTitle:   "取得した作品",
+			wantFetches: 1,
+			wantStored:  true,
+		},
+		{
+			name: "キャッシュの保存に失敗しても結果を返す",
+			setup: func(cache *fakeMetadataCache, provider *fakeMetadataProvider) {
+				cache.putErr = errors.New("write failed")
+			},
+			wantTitle:   "取得した作品",
+			wantFetches: 1,
+		},
+		{
+			name: "取得に失敗した場合はエラーを返し保存しない",
+			setup: func(cache *fakeMetadataCache, provider *fakeMetadataProvider) {
+				provider.err = errors.New("provider down")
+			},
+			wantErr:     true,
+			wantFetches: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := newFakeMetadataCache()
+			provider := &fakeMetadataProvider{results: fetched}
+			if tt.setup != nil {
+				tt.setup(cache, provider)
+			}
+			s := NewWorkService(nil, nil, cache, nil, provider)
+
+			results, cached, err := s.lookupMetadata(context.Background(), lookupKey, func() ([]*entity.WorkMetadata, error) {
+				return provider.SearchByTitle(context.Background(), entity.ContentTypeMovie, "千と千尋", workLookupLimit)
+			})
+
+			if provider.searchCalls != tt.wantFetches {
+				t.Errorf("provider calls = %d, want %d", provider.searchCalls, tt.wantFetches)
+			}
+			if _, stored := cache.entries["fake|"+lookupKey]; stored != tt.wantStored {
+				t.Errorf("stored = %v, want %v", stored, tt.wantStored)
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if cache.putCalls != 0 {
+					t.Errorf("put calls = %d, want 0", cache.putCalls)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("lookupMetadata() error = %v", err)
+			}
+			if cached != tt.wantCached {
+				t.Errorf("cached = %v, want %v", cached, tt.wantCached)
+			}
+			if len(results) != 1 || results[0].Title != tt.wantTitle {
+				t.Errorf("results = %+v, want %q", results, tt.wantTitle)
+			}
+			if ttl, ok := cache.ttls["fake|"+lookupKey]; ok && ttl != entity.MetadataLookupTTL {
+				t.Errorf("ttl = %v, want %v", ttl, entity.MetadataLookupTTL)
+			}
+		})
+	}
+}
+
+func TestWorkService_LookupMetadataCache_SecondLookupIsCached(t *testing.T) {
+	cache := newFakeMetadataCache()
+	provider := &fakeMetadataProvider{results: []*entity.WorkMetadata{{Source: "fake", SourceID: "1", Title: "作品"}}}
+	s := NewWorkService(nil, nil, cache, nil, provider)
+
+	fetch := func() ([]*entity.WorkMetadata, error) {
+		return provider.SearchByTitle(context.Background(), "", "作品", workLookupLimit)
+	}
+	key := entity.MetadataLookupKey("", "作品")
+
+	if _, cached, err := s.lookupMetadata(context.Background(), key, fetch); err != nil || cached {
+		t.Fatalf("first lookup: cached = %v, err = %v", cached, err)
+	}
+	if _, cached, err := s.lookupMetadata(context.Background(), entity.MetadataLookupKey("", "  作品 "), fetch); err != nil || !cached {
+		t.Fatalf("second lookup: cached = %v, err = %v", cached, err)
+	}
+	if provider.searchCalls != 1 {
+		t.Fatalf("provider calls = %d, want 1", provider.searchCalls)
+	}
+}
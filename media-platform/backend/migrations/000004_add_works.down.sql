@@ -0,0 +1,7 @@
+-- ===============================================
+-- 作品カタログのロールバック
+-- ===============================================
+
+DROP INDEX IF EXISTS idx_contents_work_id;
+ALTER TABLE contents DROP COLUMN IF EXISTS work_id;
+DROP TABLE IF EXISTS works;
@@ -0,0 +1,47 @@
+-- ===============================================
+-- 作品カタログの追加（レビュー対象の正規化）
+-- ===============================================
+
+-- 作品テーブル（映画・アルバム・ゲーム・漫画など）
+CREATE TABLE works (
+    id BIGSERIAL PRIMARY KEY,
+    type VARCHAR(20) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    alternate_titles TEXT[] NOT NULL DEFAULT '{}',
+    creator VARCHAR(255) NOT NULL DEFAULT '',
+    release_year INTEGER CHECK (release_year IS NULL OR (release_year >= 1800 AND release_year <= 2100)),
+    external_ids JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_works_type ON works(type);
+CREATE INDEX idx_works_title_lower ON works(LOWER(title));
+CREATE INDEX idx_works_external_ids ON works USING GIN (external_ids);
+
+-- 同じ種類・タイトル・作者の作品は重複登録しない
+CREATE UNIQUE INDEX idx_works_identity ON works(type, LOWER(title), LOWER(creator));
+
+-- コンテンツ（レビュー）と作品の紐付け
+ALTER TABLE contents ADD COLUMN work_id BIGINT REFERENCES works(id) ON DELETE SET NULL;
+CREATE INDEX idx_contents_work_id ON contents(work_id);
+
+-- 既存の自由入力（work_title / artist_name）から作品を作成して紐付け
+INSERT INTO works (type, title, creator, release_year)
+SELECT DISTINCT ON (type, LOWER(TRIM(work_title)), LOWER(COALESCE(TRIM(artist_name), '')))
+    type,
+    TRIM(work_title),
+    COALESCE(TRIM(artist_name), ''),
+    -- contents.release_year には範囲の制約がないため、works のCHECKに反する値は取り込まない
+    CASE WHEN release_year BETWEEN 1800 AND 2100 THEN release_year END
+FROM contents
+WHERE work_title IS NOT NULL AND TRIM(work_title) <> ''
+ORDER BY type, LOWER(TRIM(work_title)), LOWER(COALESCE(TRIM(artist_name), '')), created_at;
+
+UPDATE contents c
+SET work_id = w.id
+FROM works w
+WHERE c.work_title IS NOT NULL
+    AND w.type = c.type
+    AND LOWER(w.title) = LOWER(TRIM(c.work_title))
+    AND LOWER(w.creator) = LOWER(COALESCE(TRIM(c.artist_name), ''));
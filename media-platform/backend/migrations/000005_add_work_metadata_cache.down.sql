@@ -0,0 +1,5 @@
+-- ===============================================
+-- 外部メタデータキャッシュのロールバック
+-- ===============================================
+
+DROP TABLE IF EXISTS work_metadata_cache;
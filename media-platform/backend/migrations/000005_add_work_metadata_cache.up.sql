@@ -0,0 +1,16 @@
+-- ===============================================
+-- 外部メタデータ検索結果のキャッシュ
+-- ===============================================
+
+CREATE TABLE work_metadata_cache (
+    id BIGSERIAL PRIMARY KEY,
+    provider VARCHAR(50) NOT NULL,
+    lookup_key VARCHAR(500) NOT NULL,
+    results JSONB NOT NULL DEFAULT '[]',
+    fetched_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    CONSTRAINT unique_metadata_lookup UNIQUE (provider, lookup_key)
+);
+
+CREATE INDEX idx_work_metadata_cache_expires_at ON work_metadata_cache(expires_at);
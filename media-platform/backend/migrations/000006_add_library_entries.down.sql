@@ -0,0 +1,5 @@
+-- ===============================================
+-- ライブラリ機能のロールバック
+-- ===============================================
+
+DROP TABLE IF EXISTS library_entries;
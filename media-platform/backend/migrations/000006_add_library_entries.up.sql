@@ -0,0 +1,28 @@
+-- ===============================================
+-- ライブラリ（視聴・読書・プレイ状況の記録）
+-- ===============================================
+
+CREATE TABLE library_entries (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    work_id BIGINT REFERENCES works(id) ON DELETE CASCADE,
+    content_id BIGINT REFERENCES contents(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('want', 'in_progress', 'completed', 'dropped')),
+    progress INTEGER NOT NULL DEFAULT 0 CHECK (progress >= 0),
+    progress_total INTEGER CHECK (progress_total IS NULL OR progress_total > 0),
+    progress_unit VARCHAR(20) NOT NULL DEFAULT '' CHECK (progress_unit IN ('episode', 'chapter', 'volume', 'track', '')),
+    started_on DATE,
+    finished_on DATE,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- 作品かコンテンツのどちらか一方のみを対象とする
+    CONSTRAINT check_library_target CHECK ((work_id IS NULL) <> (content_id IS NULL)),
+    CONSTRAINT check_library_dates CHECK (started_on IS NULL OR finished_on IS NULL OR started_on <= finished_on)
+);
+
+CREATE UNIQUE INDEX idx_library_entries_user_work ON library_entries(user_id, work_id) WHERE work_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_library_entries_user_content ON library_entries(user_id, content_id) WHERE content_id IS NOT NULL;
+CREATE INDEX idx_library_entries_user_status ON library_entries(user_id, status);
+CREATE INDEX idx_library_entries_finished_on ON library_entries(user_id, finished_on);
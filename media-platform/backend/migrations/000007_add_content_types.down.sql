@@ -0,0 +1,8 @@
+-- ===============================================
+-- コンテンツタイプのデータ化のロールバック
+-- ===============================================
+
+ALTER TABLE works DROP CONSTRAINT IF EXISTS fk_works_type;
+ALTER TABLE contents DROP CONSTRAINT IF EXISTS fk_contents_type;
+
+DROP TABLE IF EXISTS content_types;
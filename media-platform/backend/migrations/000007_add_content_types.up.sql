@@ -0,0 +1,47 @@
+-- ===============================================
+-- コンテンツタイプのデータ化（ハードコードされた種類の置き換え）
+-- ===============================================
+
+-- コンテンツタイプテーブル（音楽・アニメ・漫画など、管理者が追加可能）
+CREATE TABLE content_types (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(20) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    -- この種類で入力できる追加フィールド（artist_name, release_year など）
+    allowed_fields TEXT[] NOT NULL DEFAULT '{}',
+    default_category_id BIGINT REFERENCES categories(id) ON DELETE SET NULL,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_content_types_sort_order ON content_types(sort_order, id);
+
+-- 初期データ（これまでハードコードされていた5種類、同名カテゴリを既定カテゴリとする）
+INSERT INTO content_types (name, description, allowed_fields, default_category_id, sort_order)
+SELECT t.name, t.description, t.allowed_fields, c.id, t.sort_order
+FROM (VALUES
+    ('音楽', '音楽（アルバム・楽曲・ライブ）', ARRAY['artist_name', 'release_year', 'image_url', 'external_url', 'tags'], 1),
+    ('アニメ', 'アニメ（TVシリーズ・劇場版）', ARRAY['release_year', 'image_url', 'external_url', 'tags'], 2),
+    ('漫画', '漫画（単行本・連載）', ARRAY['release_year', 'image_url', 'external_url', 'tags'], 3),
+    ('映画', '映画', ARRAY['release_year', 'image_url', 'external_url', 'tags'], 4),
+    ('ゲーム', 'ゲーム', ARRAY['release_year', 'image_url', 'external_url', 'tags'], 5)
+) AS t(name, description, allowed_fields, sort_order)
+LEFT JOIN categories c ON c.name = t.name;
+
+-- 既存データに含まれる上記以外の種類も登録しておく（外部キー追加のため）
+INSERT INTO content_types (name, sort_order)
+SELECT DISTINCT type, 100 FROM contents
+UNION
+SELECT DISTINCT type, 100 FROM works
+ON CONFLICT (name) DO NOTHING;
+
+-- コンテンツ・作品の種類はcontent_typesを参照する（名前変更は追従、使用中の種類は削除不可）
+ALTER TABLE contents
+    ADD CONSTRAINT fk_contents_type FOREIGN KEY (type)
+    REFERENCES content_types(name) ON UPDATE CASCADE;
+
+ALTER TABLE works
+    ADD CONSTRAINT fk_works_type FOREIGN KEY (type)
+    REFERENCES content_types(name) ON UPDATE CASCADE;
@@ -0,0 +1,6 @@
+-- ===============================================
+-- 種類別の構造化項目（メタデータ）のロールバック
+-- ===============================================
+
+DROP INDEX IF EXISTS idx_contents_metadata;
+ALTER TABLE contents DROP COLUMN IF EXISTS metadata;
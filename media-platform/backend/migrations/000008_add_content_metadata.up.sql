@@ -0,0 +1,9 @@
+-- ===============================================
+-- 種類別の構造化項目（メタデータ）の追加
+-- ===============================================
+
+-- スキーマはドメイン層（entity.ContentTypeDefinition.MetadataSchema）で管理し、検証済みの値のみ保存する
+ALTER TABLE contents ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}';
+
+-- 一覧APIでの絞り込み（metadata @> ...）用
+CREATE INDEX idx_contents_metadata ON contents USING GIN (metadata jsonb_path_ops);
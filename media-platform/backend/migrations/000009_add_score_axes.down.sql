@@ -0,0 +1,6 @@
+-- ===============================================
+-- 多軸スコアのロールバック
+-- ===============================================
+
+DROP TABLE IF EXISTS content_axis_scores;
+DROP TABLE IF EXISTS score_axes;
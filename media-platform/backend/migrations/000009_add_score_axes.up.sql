@@ -0,0 +1,54 @@
+-- ===============================================
+-- 多軸スコア（ストーリー・映像・音響など）の追加
+-- ===============================================
+
+-- コンテンツタイプごとの評価軸（管理者が設定可能）
+CREATE TABLE score_axes (
+    id BIGSERIAL PRIMARY KEY,
+    content_type VARCHAR(20) NOT NULL REFERENCES content_types(name) ON UPDATE CASCADE ON DELETE CASCADE,
+    key VARCHAR(50) NOT NULL,
+    label VARCHAR(50) NOT NULL,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (content_type, key)
+);
+
+CREATE INDEX idx_score_axes_content_type ON score_axes(content_type, sort_order);
+
+-- レビューごとの軸別スコア（0〜5、小数第1位まで）
+CREATE TABLE content_axis_scores (
+    content_id BIGINT NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
+    axis_id BIGINT NOT NULL REFERENCES score_axes(id) ON DELETE CASCADE,
+    score DECIMAL(2,1) NOT NULL CHECK (score >= 0 AND score <= 5),
+    PRIMARY KEY (content_id, axis_id)
+);
+
+CREATE INDEX idx_content_axis_scores_axis_id ON content_axis_scores(axis_id);
+
+-- 初期データ（登録済みのコンテンツタイプのみ）
+INSERT INTO score_axes (content_type, key, label, sort_order)
+SELECT a.content_type, a.key, a.label, a.sort_order
+FROM (VALUES
+    ('アニメ', 'story', 'ストーリー', 1),
+    ('アニメ', 'visuals', '作画', 2),
+    ('アニメ', 'sound', '音楽・音響', 3),
+    ('アニメ', 'characters', 'キャラクター', 4),
+    ('ゲーム', 'story', 'ストーリー', 1),
+    ('ゲーム', 'graphics', 'グラフィック', 2),
+    ('ゲーム', 'sound', 'サウンド', 3),
+    ('ゲーム', 'gameplay', 'ゲーム性', 4),
+    ('ゲーム', 'replayability', 'やり込み', 5),
+    ('映画', 'story', 'ストーリー', 1),
+    ('映画', 'visuals', '映像', 2),
+    ('映画', 'sound', '音響', 3),
+    ('映画', 'acting', '演技', 4),
+    ('漫画', 'story', 'ストーリー', 1),
+    ('漫画', 'art', '作画', 2),
+    ('漫画', 'characters', 'キャラクター', 3),
+    ('音楽', 'composition', '楽曲', 1),
+    ('音楽', 'lyrics', '歌詞', 2),
+    ('音楽', 'performance', '演奏・歌唱', 3),
+    ('音楽', 'production', 'サウンドプロダクション', 4)
+) AS a(content_type, key, label, sort_order)
+JOIN content_types t ON t.name = a.content_type;
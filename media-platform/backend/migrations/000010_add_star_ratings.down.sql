@@ -0,0 +1,5 @@
+-- ===============================================
+-- 星評価のロールバック
+-- ===============================================
+
+DROP TABLE IF EXISTS star_ratings;
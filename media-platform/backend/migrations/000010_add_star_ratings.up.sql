@@ -0,0 +1,17 @@
+-- ===============================================
+-- 読者による星評価（1〜5）の追加
+-- いいね（ratings）とは別テーブルで管理する
+-- ===============================================
+
+CREATE TABLE star_ratings (
+    id BIGSERIAL PRIMARY KEY,
+    stars SMALLINT NOT NULL CHECK (stars >= 1 AND stars <= 5),
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    content_id BIGINT NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE(user_id, content_id)
+);
+
+CREATE INDEX idx_star_ratings_content_id ON star_ratings(content_id);
+CREATE INDEX idx_star_ratings_created_at ON star_ratings(created_at DESC);
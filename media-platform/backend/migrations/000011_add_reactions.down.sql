@@ -0,0 +1,6 @@
+-- ===============================================
+-- 絵文字リアクションのロールバック
+-- ===============================================
+
+DROP TABLE IF EXISTS reactions;
+DROP TABLE IF EXISTS reaction_kinds;
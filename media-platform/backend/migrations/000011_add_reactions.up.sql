@@ -0,0 +1,40 @@
+-- ===============================================
+-- 絵文字リアクションの追加
+-- パレット（使用できる絵文字）は管理者が設定する
+-- ===============================================
+
+-- リアクションのパレット
+CREATE TABLE reaction_kinds (
+    id BIGSERIAL PRIMARY KEY,
+    key VARCHAR(30) NOT NULL UNIQUE,
+    emoji VARCHAR(16) NOT NULL UNIQUE,
+    label VARCHAR(50) NOT NULL,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO reaction_kinds (key, emoji, label, sort_order) VALUES
+    ('thumbs_up', '👍', 'いいね', 1),
+    ('laugh', '😂', '笑った', 2),
+    ('cry', '😢', '泣いた', 3),
+    ('fire', '🔥', '熱い', 4),
+    ('mind_blown', '🤯', '衝撃', 5);
+
+-- リアクション（コンテンツまたはコメントのどちらか一方に付ける）
+CREATE TABLE reactions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind_id BIGINT NOT NULL REFERENCES reaction_kinds(id) ON DELETE CASCADE,
+    content_id BIGINT REFERENCES contents(id) ON DELETE CASCADE,
+    comment_id BIGINT REFERENCES comments(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK ((content_id IS NULL) <> (comment_id IS NULL)),
+    -- 同じ対象に同じ種類のリアクションは1ユーザー1つまで
+    UNIQUE(user_id, kind_id, content_id),
+    UNIQUE(user_id, kind_id, comment_id)
+);
+
+CREATE INDEX idx_reactions_content_id ON reactions(content_id) WHERE content_id IS NOT NULL;
+CREATE INDEX idx_reactions_comment_id ON reactions(comment_id) WHERE comment_id IS NOT NULL;
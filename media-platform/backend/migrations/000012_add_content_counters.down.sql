@@ -0,0 +1,12 @@
+-- ===============================================
+-- コンテンツのカウンターのロールバック
+-- ===============================================
+
+DROP TRIGGER IF EXISTS trg_comments_comment_count ON comments;
+DROP TRIGGER IF EXISTS trg_ratings_like_count ON ratings;
+DROP FUNCTION IF EXISTS update_content_comment_count();
+DROP FUNCTION IF EXISTS update_content_like_count();
+
+ALTER TABLE contents
+    DROP COLUMN IF EXISTS comment_count,
+    DROP COLUMN IF EXISTS like_count;
@@ -0,0 +1,46 @@
+-- ===============================================
+-- コンテンツのいいね数・コメント数の非正規化カウンター
+-- ratings / comments の追加・削除時にトリガーで更新する
+-- ずれが生じた場合は定期的な再集計ジョブで補正する
+-- ===============================================
+
+ALTER TABLE contents
+    ADD COLUMN like_count BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN comment_count BIGINT NOT NULL DEFAULT 0;
+
+-- 既存データの集計
+UPDATE contents c SET
+    like_count = (SELECT COUNT(*) FROM ratings r WHERE r.content_id = c.id),
+    comment_count = (SELECT COUNT(*) FROM comments m WHERE m.content_id = c.id);
+
+-- いいね数の更新（updated_atは変更しない）
+CREATE OR REPLACE FUNCTION update_content_like_count() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE contents SET like_count = like_count + 1 WHERE id = NEW.content_id;
+    ELSIF TG_OP = 'DELETE' THEN
+        UPDATE contents SET like_count = GREATEST(like_count - 1, 0) WHERE id = OLD.content_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_ratings_like_count
+    AFTER INSERT OR DELETE ON ratings
+    FOR EACH ROW EXECUTE FUNCTION update_content_like_count();
+
+-- コメント数の更新（updated_atは変更しない）
+CREATE OR REPLACE FUNCTION update_content_comment_count() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE contents SET comment_count = comment_count + 1 WHERE id = NEW.content_id;
+    ELSIF TG_OP = 'DELETE' THEN
+        UPDATE contents SET comment_count = GREATEST(comment_count - 1, 0) WHERE id = OLD.content_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_comments_comment_count
+    AFTER INSERT OR DELETE ON comments
+    FOR EACH ROW EXECUTE FUNCTION update_content_comment_count();
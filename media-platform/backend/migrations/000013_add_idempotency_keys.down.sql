@@ -0,0 +1,5 @@
+-- ===============================================
+-- Idempotency-Key のロールバック
+-- ===============================================
+
+DROP TABLE IF EXISTS idempotency_keys;
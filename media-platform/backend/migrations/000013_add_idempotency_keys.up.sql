@@ -0,0 +1,18 @@
+-- ===============================================
+-- Idempotency-Key によるリクエストの重複実行防止
+-- 同じキーの再送には保存したレスポンスをそのまま返す
+-- status_code が NULL の行は処理中を表す
+-- ===============================================
+
+CREATE TABLE idempotency_keys (
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    key VARCHAR(255) NOT NULL,
+    request_hash VARCHAR(64) NOT NULL,
+    status_code INTEGER,
+    content_type VARCHAR(255),
+    response_body BYTEA,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, key)
+);
+
+CREATE INDEX idx_idempotency_keys_created_at ON idempotency_keys(created_at);
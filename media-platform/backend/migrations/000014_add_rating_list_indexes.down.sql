@@ -0,0 +1,6 @@
+-- ===============================================
+-- 評価一覧のページネーション用インデックスのロールバック
+-- ===============================================
+
+DROP INDEX IF EXISTS idx_ratings_user_created;
+DROP INDEX IF EXISTS idx_ratings_content_created;
@@ -0,0 +1,7 @@
+-- ===============================================
+-- 評価一覧のページネーション用インデックス
+-- コンテンツ別・ユーザー別の新着順の取得をインデックスのみで行う
+-- ===============================================
+
+CREATE INDEX idx_ratings_content_created ON ratings(content_id, created_at DESC, id DESC);
+CREATE INDEX idx_ratings_user_created ON ratings(user_id, created_at DESC, id DESC);
@@ -0,0 +1,6 @@
+-- ===============================================
+-- コメントツリー取得用インデックスのロールバック
+-- ===============================================
+
+DROP INDEX IF EXISTS idx_comments_parent_created;
+DROP INDEX IF EXISTS idx_comments_content_roots;
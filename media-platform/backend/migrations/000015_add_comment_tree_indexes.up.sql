@@ -0,0 +1,7 @@
+-- ===============================================
+-- コメントツリー取得用インデックス
+-- ルートコメントの新着順と、返信の古い順の取得をインデックスで行う
+-- ===============================================
+
+CREATE INDEX idx_comments_content_roots ON comments(content_id, created_at DESC, id DESC) WHERE parent_id IS NULL;
+CREATE INDEX idx_comments_parent_created ON comments(parent_id, created_at, id);
@@ -0,0 +1,7 @@
+-- ===============================================
+-- コメントのピン留めのロールバック
+-- ===============================================
+
+DROP INDEX IF EXISTS idx_comments_pinned;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS is_pinned;
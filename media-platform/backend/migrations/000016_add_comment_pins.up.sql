@@ -0,0 +1,8 @@
+-- ===============================================
+-- コメントのピン留め
+-- 投稿者はコンテンツごとに1件のルートコメントを先頭に固定できる
+-- ===============================================
+
+ALTER TABLE comments ADD COLUMN is_pinned BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE UNIQUE INDEX idx_comments_pinned ON comments(content_id) WHERE is_pinned;
@@ -0,0 +1,30 @@
+-- ===============================================
+-- コメントの論理削除のロールバック
+-- 返信を残すため、墓標は削除済みの文言を本文にした通常のコメントに戻す
+-- ===============================================
+
+UPDATE comments SET body = 'このコメントは削除されました' WHERE deleted_at IS NOT NULL;
+
+CREATE OR REPLACE FUNCTION update_content_comment_count() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE contents SET comment_count = comment_count + 1 WHERE id = NEW.content_id;
+    ELSIF TG_OP = 'DELETE' THEN
+        UPDATE contents SET comment_count = GREATEST(comment_count - 1, 0) WHERE id = OLD.content_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_comments_comment_count ON comments;
+CREATE TRIGGER trg_comments_comment_count
+    AFTER INSERT OR DELETE ON comments
+    FOR EACH ROW EXECUTE FUNCTION update_content_comment_count();
+
+DROP INDEX IF EXISTS idx_comments_deleted_at;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS deleted_at;
+
+-- 墓標だったコメントを含めてコメント数を再集計
+UPDATE contents c SET
+    comment_count = (SELECT COUNT(*) FROM comments m WHERE m.content_id = c.id);
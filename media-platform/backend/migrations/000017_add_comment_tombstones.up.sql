@@ -0,0 +1,33 @@
+-- ===============================================
+-- コメントの論理削除（墓標）
+-- 返信があるコメントは削除時に本文を消して墓標として残し、スレッドの構造を保つ
+-- 墓標はコメント数に含めない
+-- ===============================================
+
+ALTER TABLE comments ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+
+-- 墓標の掃除用（返信がなくなった墓標の検索）
+CREATE INDEX idx_comments_deleted_at ON comments(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- コメント数の更新（墓標になった時点で減らし、墓標の物理削除では減らさない）
+CREATE OR REPLACE FUNCTION update_content_comment_count() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE contents SET comment_count = comment_count + 1 WHERE id = NEW.content_id;
+    ELSIF TG_OP = 'DELETE' THEN
+        IF OLD.deleted_at IS NULL THEN
+            UPDATE contents SET comment_count = GREATEST(comment_count - 1, 0) WHERE id = OLD.content_id;
+        END IF;
+    ELSIF TG_OP = 'UPDATE' THEN
+        IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+            UPDATE contents SET comment_count = GREATEST(comment_count - 1, 0) WHERE id = NEW.content_id;
+        END IF;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_comments_comment_count ON comments;
+CREATE TRIGGER trg_comments_comment_count
+    AFTER INSERT OR DELETE OR UPDATE OF deleted_at ON comments
+    FOR EACH ROW EXECUTE FUNCTION update_content_comment_count();
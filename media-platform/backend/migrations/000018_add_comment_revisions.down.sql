@@ -0,0 +1,7 @@
+-- ===============================================
+-- コメントの編集履歴のロールバック
+-- ===============================================
+
+DROP TABLE IF EXISTS comment_revisions;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS edited_at;
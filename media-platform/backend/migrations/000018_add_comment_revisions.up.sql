@@ -0,0 +1,16 @@
+-- ===============================================
+-- コメントの編集履歴
+-- 編集前の本文を保存し、編集済みの表示と履歴の閲覧に使用する
+-- ===============================================
+
+ALTER TABLE comments ADD COLUMN edited_at TIMESTAMP WITH TIME ZONE;
+
+CREATE TABLE comment_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    comment_id BIGINT NOT NULL REFERENCES comments(id) ON DELETE CASCADE,
+    body TEXT NOT NULL, -- 編集前の本文
+    editor_id BIGINT REFERENCES users(id) ON DELETE SET NULL, -- 編集したユーザー（管理者による編集の場合は投稿者と異なる）
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW() -- 編集日時
+);
+
+CREATE INDEX idx_comment_revisions_comment_id ON comment_revisions(comment_id, created_at DESC);
@@ -0,0 +1,5 @@
+-- ===============================================
+-- メンションのロールバック
+-- ===============================================
+
+DROP TABLE IF EXISTS mentions;
@@ -0,0 +1,21 @@
+-- ===============================================
+-- メンションの追加
+-- コンテンツ・コメントの本文中の@usernameで解決できたユーザーを保存する
+-- ===============================================
+
+-- メンション（コンテンツまたはコメントのどちらか一方に属する）
+CREATE TABLE mentions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE, -- メンションされたユーザー
+    content_id BIGINT REFERENCES contents(id) ON DELETE CASCADE,
+    comment_id BIGINT REFERENCES comments(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    notified_at TIMESTAMP WITH TIME ZONE, -- 通知した日時（下書きのメンションは公開されるまでNULL）
+    CHECK ((content_id IS NULL) <> (comment_id IS NULL)),
+    -- 同じ本文で同じユーザーは1回だけ記録する
+    UNIQUE(content_id, user_id),
+    UNIQUE(comment_id, user_id)
+);
+
+CREATE INDEX idx_mentions_user_id ON mentions(user_id, created_at DESC);
+CREATE INDEX idx_mentions_comment_id ON mentions(comment_id) WHERE comment_id IS NOT NULL;
@@ -0,0 +1,34 @@
+-- ===============================================
+-- コンテンツごとのコメント設定のロールバック
+-- 承認待ちのコメントは公開されないよう削除する
+-- ===============================================
+
+DELETE FROM comments WHERE is_pending;
+
+CREATE OR REPLACE FUNCTION update_content_comment_count() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE contents SET comment_count = comment_count + 1 WHERE id = NEW.content_id;
+    ELSIF TG_OP = 'DELETE' THEN
+        IF OLD.deleted_at IS NULL THEN
+            UPDATE contents SET comment_count = GREATEST(comment_count - 1, 0) WHERE id = OLD.content_id;
+        END IF;
+    ELSIF TG_OP = 'UPDATE' THEN
+        IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+            UPDATE contents SET comment_count = GREATEST(comment_count - 1, 0) WHERE id = NEW.content_id;
+        END IF;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_comments_comment_count ON comments;
+CREATE TRIGGER trg_comments_comment_count
+    AFTER INSERT OR DELETE OR UPDATE OF deleted_at ON comments
+    FOR EACH ROW EXECUTE FUNCTION update_content_comment_count();
+
+DROP INDEX IF EXISTS idx_comments_pending;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS is_pending;
+
+ALTER TABLE contents DROP COLUMN IF EXISTS comment_policy;
@@ -0,0 +1,40 @@
+-- ===============================================
+-- コンテンツごとのコメント設定
+-- 誰でも / フォロワーのみ / 承認制 / 受け付けない を投稿者が選択する
+-- 承認制のコメントは承認されるまでコメント数に含めない
+-- ===============================================
+
+ALTER TABLE contents ADD COLUMN comment_policy VARCHAR(20) NOT NULL DEFAULT 'open'
+    CHECK (comment_policy IN ('open', 'followers_only', 'approval_required', 'closed'));
+
+ALTER TABLE comments ADD COLUMN is_pending BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- 投稿者向けの承認待ち一覧用
+CREATE INDEX idx_comments_pending ON comments(content_id, created_at) WHERE is_pending;
+
+-- コメント数の更新（表示されるコメント = 墓標でも承認待ちでもないコメントのみ数える）
+CREATE OR REPLACE FUNCTION update_content_comment_count() RETURNS TRIGGER AS $$
+DECLARE
+    old_counted BOOLEAN := FALSE;
+    new_counted BOOLEAN := FALSE;
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        old_counted := OLD.deleted_at IS NULL AND NOT OLD.is_pending;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        new_counted := NEW.deleted_at IS NULL AND NOT NEW.is_pending;
+    END IF;
+
+    IF old_counted AND NOT new_counted THEN
+        UPDATE contents SET comment_count = GREATEST(comment_count - 1, 0) WHERE id = OLD.content_id;
+    ELSIF new_counted AND NOT old_counted THEN
+        UPDATE contents SET comment_count = comment_count + 1 WHERE id = NEW.content_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_comments_comment_count ON comments;
+CREATE TRIGGER trg_comments_comment_count
+    AFTER INSERT OR DELETE OR UPDATE OF deleted_at, is_pending ON comments
+    FOR EACH ROW EXECUTE FUNCTION update_content_comment_count();
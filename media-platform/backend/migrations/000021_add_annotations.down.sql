@@ -0,0 +1,5 @@
+-- ===============================================
+-- 注釈のロールバック
+-- ===============================================
+
+DROP TABLE IF EXISTS annotations;
@@ -0,0 +1,21 @@
+-- ===============================================
+-- 注釈（インライン注釈）の追加
+-- コンテンツ本文の段落・範囲に付ける注釈を段落の位置と引用文字列（前後の文脈付き）で保存する
+-- ===============================================
+
+CREATE TABLE annotations (
+    id BIGSERIAL PRIMARY KEY,
+    content_id BIGINT NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    block_index INTEGER NOT NULL CHECK (block_index >= 0), -- 空行区切りの段落の位置（0始まり）
+    quote_exact TEXT NOT NULL,                             -- 引用部分
+    quote_prefix TEXT NOT NULL DEFAULT '',                 -- 引用部分の直前の文脈
+    quote_suffix TEXT NOT NULL DEFAULT '',                 -- 引用部分の直後の文脈
+    is_orphaned BOOLEAN NOT NULL DEFAULT FALSE,            -- 本文の編集で引用部分が見つからなくなった場合TRUE
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_annotations_content_id ON annotations(content_id, block_index, created_at);
+CREATE INDEX idx_annotations_user_id ON annotations(user_id);
@@ -0,0 +1,6 @@
+-- ===============================================
+-- 通知のロールバック
+-- ===============================================
+
+DROP TABLE IF EXISTS notification_actors;
+DROP TABLE IF EXISTS notifications;
@@ -0,0 +1,32 @@
+-- ===============================================
+-- 通知の追加
+-- いいね・コメント・返信・フォロー・メンションをユーザーに通知する
+-- 同じ対象への同じ種類の通知は未読の間1件にまとめ、関わったユーザーを notification_actors に記録する
+-- ===============================================
+
+CREATE TABLE notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE, -- 通知を受け取るユーザー
+    type VARCHAR(20) NOT NULL CHECK (type IN ('like', 'comment', 'reply', 'follow', 'mention')),
+    group_key VARCHAR(100) NOT NULL,                                -- まとめる単位（例: content:12）
+    content_id BIGINT REFERENCES contents(id) ON DELETE CASCADE,
+    comment_id BIGINT REFERENCES comments(id) ON DELETE CASCADE,
+    latest_actor_id BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    actor_count INTEGER NOT NULL DEFAULT 0,
+    is_read BOOLEAN NOT NULL DEFAULT FALSE,
+    read_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- 未読の通知は同じ種類・同じ対象で1件にまとめる
+CREATE UNIQUE INDEX idx_notifications_unread_group ON notifications(user_id, type, group_key) WHERE NOT is_read;
+CREATE INDEX idx_notifications_user_id ON notifications(user_id, updated_at DESC);
+
+-- 通知にまとめられたユーザー（同じユーザーは1回だけ数える）
+CREATE TABLE notification_actors (
+    notification_id BIGINT NOT NULL REFERENCES notifications(id) ON DELETE CASCADE,
+    actor_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (notification_id, actor_id)
+);
@@ -0,0 +1,5 @@
+-- ===============================================
+-- メール通知の設定のロールバック
+-- ===============================================
+
+DROP TABLE IF EXISTS notification_preferences;
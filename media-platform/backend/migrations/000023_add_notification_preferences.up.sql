@@ -0,0 +1,15 @@
+-- ===============================================
+-- メール通知の設定の追加
+-- フォロー・返信・メンションのメールと週間ダイジェストを受け取るか、メールの言語をユーザーごとに保存する
+-- 行がないユーザーはすべてのメールを受け取る（デフォルト値と同じ扱い）
+-- ===============================================
+
+CREATE TABLE notification_preferences (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    email_follow BOOLEAN NOT NULL DEFAULT TRUE,
+    email_reply BOOLEAN NOT NULL DEFAULT TRUE,
+    email_mention BOOLEAN NOT NULL DEFAULT TRUE,
+    email_digest BOOLEAN NOT NULL DEFAULT TRUE,
+    locale VARCHAR(10) NOT NULL DEFAULT 'ja' CHECK (locale IN ('ja', 'en')),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
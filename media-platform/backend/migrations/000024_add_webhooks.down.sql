@@ -0,0 +1,6 @@
+-- ===============================================
+-- Webhookのロールバック
+-- ===============================================
+
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
@@ -0,0 +1,43 @@
+-- ===============================================
+-- Webhookの追加
+-- 登録したエンドポイントに content.published / comment.created / user.followed などのイベントを配信する
+-- 配信ごとに webhook_deliveries に記録し、ワーカーが指数バックオフで再送する（上限に達したら dead）
+-- ===============================================
+
+CREATE TABLE webhooks (
+    id BIGSERIAL PRIMARY KEY,
+    owner_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE, -- 登録したユーザー（現在は管理者のみ）
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(100) NOT NULL,                                    -- HMAC-SHA256の署名に使う共有シークレット
+    events TEXT[] NOT NULL,                                          -- 購読するイベント（例: {content.published}）
+    description VARCHAR(200) NOT NULL DEFAULT '',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- 購読しているイベントでの検索用
+CREATE INDEX idx_webhooks_events ON webhooks USING GIN (events) WHERE is_active;
+CREATE INDEX idx_webhooks_owner_id ON webhooks(owner_id);
+
+-- 配信ログ（配信待ち・成功・デッドレター）
+CREATE TABLE webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    webhook_id BIGINT NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_id VARCHAR(64) NOT NULL,                                   -- 同じイベントの配信は同じID
+    event_type VARCHAR(50) NOT NULL,
+    payload TEXT NOT NULL,                                           -- 送信するJSON本文（署名するため送信時と同じバイト列で保持）
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- 配信待ちの場合の次の試行日時（配信中はリース期限）
+    last_attempt_at TIMESTAMP WITH TIME ZONE,
+    response_status INTEGER,
+    response_body TEXT NOT NULL DEFAULT '',
+    last_error TEXT NOT NULL DEFAULT '',
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- ワーカーが配信待ちを取得するためのインデックス
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_webhook_id ON webhook_deliveries(webhook_id, created_at DESC);
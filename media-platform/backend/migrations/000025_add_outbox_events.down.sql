@@ -0,0 +1,6 @@
+-- ===============================================
+-- ドメインイベントのアウトボックスのロールバック
+-- ===============================================
+
+DROP INDEX IF EXISTS idx_webhook_deliveries_webhook_event;
+DROP TABLE IF EXISTS outbox_events;
@@ -0,0 +1,27 @@
+-- ===============================================
+-- ドメインイベントのアウトボックスの追加
+-- サービスは状態の変更と同じトランザクションでイベントを outbox_events に保存し、
+-- ディスパッチャーがプロセス内の購読者に少なくとも1回配信する（失敗したら指数バックオフで再試行、上限に達したら dead）
+-- ===============================================
+
+CREATE TABLE outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL UNIQUE,                            -- 購読者の重複排除に使うイベントのID
+    event_type VARCHAR(50) NOT NULL,                                 -- 例: content.published
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'dispatched', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- 配信待ちの場合の次の試行日時（ディスパッチ中はリース期限）
+    last_error TEXT NOT NULL DEFAULT '',
+    dispatched_at TIMESTAMP WITH TIME ZONE,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- ディスパッチャーが配信待ちを取得するためのインデックス
+CREATE INDEX idx_outbox_events_pending ON outbox_events(next_attempt_at) WHERE status = 'pending';
+-- 配信済みのイベントの削除用
+CREATE INDEX idx_outbox_events_dispatched_at ON outbox_events(dispatched_at) WHERE status = 'dispatched';
+
+-- ドメインイベントは少なくとも1回配信されるため、同じイベントが再配信されても
+-- 同じWebhookへの配信を重複して作成しないよう (webhook_id, event_id) を一意にする
+CREATE UNIQUE INDEX idx_webhook_deliveries_webhook_event ON webhook_deliveries(webhook_id, event_id);
@@ -0,0 +1,20 @@
+-- ===============================================
+-- コンテンツタイプ名の固定と表示名の追加のロールバック
+-- ===============================================
+
+ALTER TABLE score_axes DROP CONSTRAINT IF EXISTS score_axes_content_type_fkey;
+ALTER TABLE score_axes
+    ADD CONSTRAINT score_axes_content_type_fkey FOREIGN KEY (content_type)
+    REFERENCES content_types(name) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE works DROP CONSTRAINT IF EXISTS fk_works_type;
+ALTER TABLE works
+    ADD CONSTRAINT fk_works_type FOREIGN KEY (type)
+    REFERENCES content_types(name) ON UPDATE CASCADE;
+
+ALTER TABLE contents DROP CONSTRAINT IF EXISTS fk_contents_type;
+ALTER TABLE contents
+    ADD CONSTRAINT fk_contents_type FOREIGN KEY (type)
+    REFERENCES content_types(name) ON UPDATE CASCADE;
+
+ALTER TABLE content_types DROP COLUMN IF EXISTS display_name;
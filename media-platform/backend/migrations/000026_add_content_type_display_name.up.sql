@@ -0,0 +1,24 @@
+-- ===============================================
+-- コンテンツタイプ名の固定と表示名の追加
+-- name は contents・works・score_axes と種類別のスキーマが参照する識別子のため作成後は変更しない
+-- 管理者が変更できる名前は display_name とする
+-- ===============================================
+
+ALTER TABLE content_types ADD COLUMN display_name VARCHAR(20) NOT NULL DEFAULT '';
+UPDATE content_types SET display_name = name;
+
+-- 名前の変更を追従させていた外部キーを、名前を変更できないようにする（ON UPDATE RESTRICT）
+ALTER TABLE contents DROP CONSTRAINT fk_contents_type;
+ALTER TABLE contents
+    ADD CONSTRAINT fk_contents_type FOREIGN KEY (type)
+    REFERENCES content_types(name) ON UPDATE RESTRICT;
+
+ALTER TABLE works DROP CONSTRAINT fk_works_type;
+ALTER TABLE works
+    ADD CONSTRAINT fk_works_type FOREIGN KEY (type)
+    REFERENCES content_types(name) ON UPDATE RESTRICT;
+
+ALTER TABLE score_axes DROP CONSTRAINT score_axes_content_type_fkey;
+ALTER TABLE score_axes
+    ADD CONSTRAINT score_axes_content_type_fkey FOREIGN KEY (content_type)
+    REFERENCES content_types(name) ON UPDATE RESTRICT ON DELETE CASCADE;
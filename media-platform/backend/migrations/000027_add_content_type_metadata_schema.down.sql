@@ -0,0 +1,5 @@
+-- ===============================================
+-- コンテンツタイプへの構造化項目のスキーマの紐付けのロールバック
+-- ===============================================
+
+ALTER TABLE content_types DROP COLUMN IF EXISTS metadata_schema;
@@ -0,0 +1,17 @@
+-- ===============================================
+-- コンテンツタイプへの構造化項目のスキーマの紐付け
+-- 種類別のスキーマは種類名ではなく固定のキー（music, game など）で定義し、コンテンツタイプがキーで参照する
+-- 管理者が追加した種類にも既存のスキーマを割り当てられる（空の場合は構造化項目なし）
+-- ===============================================
+
+ALTER TABLE content_types ADD COLUMN metadata_schema VARCHAR(30) NOT NULL DEFAULT '';
+
+-- 初期データの5種類にこれまでと同じスキーマを割り当てる
+UPDATE content_types SET metadata_schema = CASE name
+    WHEN '音楽' THEN 'music'
+    WHEN 'ゲーム' THEN 'game'
+    WHEN 'アニメ' THEN 'anime'
+    WHEN '漫画' THEN 'manga'
+    WHEN '映画' THEN 'movie'
+    ELSE ''
+END;
@@ -0,0 +1,5 @@
+-- ===============================================
+-- 週間ダイジェストの送信記録の追加のロールバック
+-- ===============================================
+
+DROP TABLE IF EXISTS digest_deliveries;
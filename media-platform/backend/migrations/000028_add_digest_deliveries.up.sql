@@ -0,0 +1,14 @@
+-- ===============================================
+-- 週間ダイジェストの送信記録の追加
+-- ユーザーごとに最後にダイジェストを処理した日時を保存し、ジョブは1時間ごとに送信時期になったユーザーだけを処理する
+-- （再起動やデプロイで送信が途切れず、複数のAPIインスタンスでも claimed_until で同じユーザーを重複して処理しない）
+-- ===============================================
+
+CREATE TABLE digest_deliveries (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    last_sent_at TIMESTAMP WITH TIME ZONE, -- 最後にダイジェストを処理した日時（対象の投稿がなく送信しなかった場合を含む）
+    claimed_until TIMESTAMP WITH TIME ZONE -- 処理中のインスタンスのリース期限（期限までは他のインスタンスが取得しない）
+);
+
+-- 送信時期になったユーザーの検索用
+CREATE INDEX idx_digest_deliveries_last_sent_at ON digest_deliveries(last_sent_at);